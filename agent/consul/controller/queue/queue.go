@@ -67,8 +67,15 @@ type queue[T ItemType] struct {
 // RunWorkQueue returns a started WorkQueue that has per-item exponential backoff rate-limiting.
 // When the passed in context is canceled, the queue shuts down.
 func RunWorkQueue[T ItemType](ctx context.Context, baseBackoff, maxBackoff time.Duration) WorkQueue[T] {
+	return RunWorkQueueWithLimiter[T](ctx, NewRateLimiter[T](baseBackoff, maxBackoff))
+}
+
+// RunWorkQueueWithLimiter returns a started WorkQueue whose rate-limited items
+// are delayed by the given Limiter. When the passed in context is canceled, the
+// queue shuts down.
+func RunWorkQueueWithLimiter[T ItemType](ctx context.Context, limiter Limiter[T]) WorkQueue[T] {
 	q := &queue[T]{
-		ratelimiter: NewRateLimiter[T](baseBackoff, maxBackoff),
+		ratelimiter: limiter,
 		dirty:       make(map[string]struct{}),
 		processing:  make(map[string]struct{}),
 		cond:        sync.NewCond(&sync.Mutex{}),
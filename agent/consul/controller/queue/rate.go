@@ -5,6 +5,7 @@ package queue
 
 import (
 	"math"
+	"math/rand"
 	"sync"
 	"time"
 )
@@ -69,3 +70,26 @@ func (r *ratelimiter[T]) Forget(request T) {
 
 	delete(r.failures, request.Key())
 }
+
+// jitterLimiter applies "full jitter" to the backoff of another Limiter.
+type jitterLimiter[T ItemType] struct {
+	Limiter[T]
+}
+
+// NewJitterRateLimiter returns a Limiter that does per-item exponential
+// backoff with full jitter: each retry is delayed by a random duration between
+// zero and the exponential backoff, so that items failing together don't all
+// retry together.
+func NewJitterRateLimiter[T ItemType](base, max time.Duration) Limiter[T] {
+	return jitterLimiter[T]{NewRateLimiter[T](base, max)}
+}
+
+// NextRetry returns the remaining time until the queue should
+// reprocess a Request.
+func (r jitterLimiter[T]) NextRetry(request T) time.Duration {
+	backoff := r.Limiter.NextRetry(request)
+	if backoff <= 0 {
+		return backoff
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
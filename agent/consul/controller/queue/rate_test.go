@@ -67,3 +67,24 @@ func TestRateLimiter_Overflow(t *testing.T) {
 	// make sure we're capped at the passed in max backoff
 	require.Equal(t, 1000*time.Hour, limiter.NextRetry(overflow))
 }
+
+func TestJitterRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewJitterRateLimiter[Request](1*time.Millisecond, 16*time.Millisecond)
+
+	request := Request{Kind: "one"}
+	for i := 0; i < 10; i++ {
+		max := 1 * time.Millisecond << i
+		if max > 16*time.Millisecond {
+			max = 16 * time.Millisecond
+		}
+		delay := limiter.NextRetry(request)
+		require.GreaterOrEqual(t, delay, time.Duration(0))
+		require.LessOrEqual(t, delay, max)
+	}
+
+	// Forgetting the request resets its backoff.
+	limiter.Forget(request)
+	require.LessOrEqual(t, limiter.NextRetry(request), 1*time.Millisecond)
+}
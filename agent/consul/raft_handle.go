@@ -5,7 +5,6 @@ package consul
 
 import (
 	"context"
-	"errors"
 	"net"
 
 	"google.golang.org/grpc"
@@ -13,6 +12,7 @@ import (
 
 	"github.com/hashicorp/consul/agent/pool"
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/internal/storage"
 	"github.com/hashicorp/consul/internal/storage/raft"
 )
 
@@ -38,7 +38,7 @@ func (h *raftHandle) Apply(msg []byte) (any, error) {
 func (h *raftHandle) DialLeader() (*grpc.ClientConn, error) {
 	leaderAddr, _ := h.s.raft.LeaderWithID()
 	if leaderAddr == "" {
-		return nil, errors.New("leader unknown")
+		return nil, storage.ErrNoLeader
 	}
 
 	dc := h.s.config.Datacenter
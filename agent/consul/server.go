@@ -468,6 +468,11 @@ type Server struct {
 
 	registry resource.Registry
 
+	// catalogControllerDeps are the dependencies of the catalog controllers.
+	// The resource service's node health endpoints compute node health with the
+	// same node health options as the node health controller.
+	catalogControllerDeps catalog.ControllerDependencies
+
 	useV2Resources bool
 
 	// useV2Tenancy is tied to the "v2tenancy" feature flag.
@@ -560,6 +565,7 @@ func NewServer(config *Config, flat Deps, externalGRPCServer *grpc.Server,
 		incomingRPCLimiter:      incomingRPCLimiter,
 		routineManager:          routine.NewManager(logger.Named(logging.ConsulServer)),
 		registry:                flat.Registry,
+		catalogControllerDeps:   catalog.DefaultControllerDependencies(),
 		useV2Resources:          flat.UseV2Resources(),
 		useV2Tenancy:            flat.UseV2Tenancy(),
 	}
@@ -843,6 +849,13 @@ func NewServer(config *Config, flat Deps, externalGRPCServer *grpc.Server,
 		return nil, err
 	}
 
+	// The controller manager is created before the external gRPC server so
+	// that the resource service can stream its reconcile events.
+	s.controllerManager = controller.NewManager(
+		s.insecureResourceServiceClient,
+		logger.Named(logging.ControllerRuntime),
+	)
+
 	// Initialize external gRPC server
 	s.setupExternalGRPC(config, flat, logger)
 
@@ -859,10 +872,6 @@ func NewServer(config *Config, flat Deps, externalGRPCServer *grpc.Server,
 	s.grpcHandler = newGRPCHandlerFromConfig(flat, config, s)
 	s.grpcLeaderForwarder = flat.LeaderForwarder
 
-	s.controllerManager = controller.NewManager(
-		s.insecureResourceServiceClient,
-		logger.Named(logging.ControllerRuntime),
-	)
 	if err := s.registerControllers(flat, proxyUpdater); err != nil {
 		return nil, err
 	}
@@ -904,6 +913,7 @@ func NewServer(config *Config, flat Deps, externalGRPCServer *grpc.Server,
 
 	// Start the metrics handlers.
 	go s.updateMetrics()
+	go s.resourceServiceServer.RunCountMetrics(&lib.StopChannelContext{StopCh: s.shutdownCh}, resourcegrpc.DefaultCountMetricsInterval)
 
 	// Now we are setup, configure the HCP manager
 	go s.hcpManager.Run(&lib.StopChannelContext{StopCh: shutdownCh})
@@ -945,7 +955,7 @@ func (s *Server) registerControllers(deps Deps, proxyUpdater ProxyUpdater) error
 	}
 
 	if s.useV2Resources {
-		catalog.RegisterControllers(s.controllerManager, catalog.DefaultControllerDependencies())
+		catalog.RegisterControllers(s.controllerManager, s.catalogControllerDeps)
 		multicluster.RegisterControllers(s.controllerManager)
 		defaultAllow, err := s.config.ACLResolverSettings.IsDefaultAllow()
 		if err != nil {
@@ -1474,14 +1484,24 @@ func (s *Server) setupExternalGRPC(config *Config, deps Deps, logger hclog.Logge
 		tenancyBridge = tenancyBridgeV2.WithClient(s.insecureResourceServiceClient)
 	}
 
+	var nodeHealth resourcegrpc.NodeHealthSource
+	if s.useV2Resources {
+		nodeHealth = catalog.NewNodeHealthSource(s.catalogControllerDeps.NodeHealthOptions...)
+	}
+
 	s.resourceServiceServer = resourcegrpc.NewServer(resourcegrpc.Config{
-		Registry:      deps.Registry,
-		Backend:       s.raftStorageBackend,
-		ACLResolver:   s.ACLResolver,
-		Logger:        logger.Named("grpc-api.resource"),
-		TenancyBridge: tenancyBridge,
-		UseV2Tenancy:  s.useV2Tenancy,
+		Registry:        deps.Registry,
+		Backend:         s.raftStorageBackend,
+		ACLResolver:     s.ACLResolver,
+		Logger:          logger.Named("grpc-api.resource"),
+		TenancyBridge:   tenancyBridge,
+		UseV2Tenancy:    s.useV2Tenancy,
+		ReconcileEvents: s.controllerManager,
+		NodeHealth:      nodeHealth,
 	})
+	// All resource types are registered with deps.Registry before the server is
+	// created.
+	s.resourceServiceServer.MarkRegistryLoaded()
 	s.resourceServiceServer.Register(s.externalGRPCServer)
 
 	reflection.Register(s.externalGRPCServer)
@@ -1506,6 +1526,7 @@ func (s *Server) setupInsecureResourceServiceClient(typeRegistry resource.Regist
 		TenancyBridge: tenancyBridge,
 		UseV2Tenancy:  s.useV2Tenancy,
 	})
+	server.MarkRegistryLoaded()
 
 	conn, err := s.runInProcessGRPCServer(server.Register)
 	if err != nil {
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// DefaultAdmissionTimeout bounds calls to the admission server when
+// AdmissionHook.Timeout is not set.
+const DefaultAdmissionTimeout = 5 * time.Second
+
+// AdmissionHook configures an external admission server, which is called on
+// Write to allow, deny, or mutate resources before they are stored.
+type AdmissionHook struct {
+	// Client calls the admission server.
+	Client pbresource.AdmissionServiceClient
+
+	// Timeout bounds each call to the admission server. DefaultAdmissionTimeout
+	// is used when zero.
+	Timeout time.Duration
+
+	// FailOpen allows writes when the admission server can't be called or
+	// returns an error. By default, such writes fail with Unavailable.
+	FailOpen bool
+}
+
+// admissionExemptKey is the context key marking writes made by the server
+// itself, e.g. to mark a resource for deletion, which must not be blocked by
+// the admission server.
+type admissionExemptKey struct{}
+
+// withAdmissionExempt returns a context whose writes bypass the admission hook.
+func withAdmissionExempt(ctx context.Context) context.Context {
+	return context.WithValue(ctx, admissionExemptKey{}, true)
+}
+
+// admit calls the admission server with the resource being written, and
+// returns the resource to write in its place: either res itself or the
+// admission server's mutation of it.
+//
+// A mutated resource may have a different owner, data or metadata than the one
+// that was authorized, so it is put through the type's mutation, validation,
+// write ACL and reference validation hooks again.
+func (s *Server) admit(ctx context.Context, reg *resource.Registration, authz acl.Authorizer, authzContext *acl.AuthorizerContext, res *pbresource.Resource) (*pbresource.Resource, error) {
+	if s.Admission == nil || ctx.Value(admissionExemptKey{}) != nil {
+		return res, nil
+	}
+
+	timeout := s.Admission.Timeout
+	if timeout == 0 {
+		timeout = DefaultAdmissionTimeout
+	}
+	callCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	rsp, err := s.Admission.Client.Admit(callCtx, &pbresource.AdmitRequest{Resource: res})
+	if err != nil {
+		if s.Admission.FailOpen {
+			s.Logger.Warn("admission hook failed, allowing write",
+				"resource", resource.IDToString(res.Id),
+				"error", err,
+			)
+			return res, nil
+		}
+		return nil, status.Errorf(codes.Unavailable, "admission hook failed: %v", err)
+	}
+
+	if !rsp.Allowed {
+		return nil, status.Errorf(codes.PermissionDenied, "write denied by admission hook: %s", rsp.Message)
+	}
+
+	if rsp.Resource == nil {
+		return res, nil
+	}
+	mutated := rsp.Resource
+	if err := validateAdmissionMutation(reg, res, mutated); err != nil {
+		return nil, status.Errorf(codes.Internal, "admission hook returned an invalid resource: %v", err)
+	}
+
+	if err := reg.Mutate(mutated); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed mutate hook: %v", err.Error())
+	}
+	if err := reg.Validate(mutated); err != nil {
+		return nil, status.Errorf(codes.Internal, "admission hook returned an invalid resource: %v", err)
+	}
+
+	err = reg.ACLs.Write(authz, authzContext, mutated)
+	switch {
+	case acl.IsErrPermissionDenied(err):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed write acl: %v", err)
+	}
+
+	if reg.ValidateReferences != nil {
+		if err := s.validateReferences(ctx, reg, mutated); err != nil {
+			return nil, err
+		}
+	}
+	return mutated, nil
+}
+
+// validateAdmissionMutation checks that the admission server only changed the
+// parts of the resource a writer could.
+func validateAdmissionMutation(reg *resource.Registration, original, mutated *pbresource.Resource) error {
+	switch {
+	case !resource.EqualID(original.Id, mutated.Id):
+		return fmt.Errorf("id cannot be changed")
+	case original.Version != mutated.Version:
+		return fmt.Errorf("version cannot be changed")
+	case original.Generation != mutated.Generation:
+		return fmt.Errorf("generation cannot be changed")
+	case len(mutated.Status) != 0:
+		return fmt.Errorf("status cannot be set")
+	case mutated.Data != nil && !mutated.Data.MessageIs(reg.Proto):
+		return fmt.Errorf("data is of wrong type (expected=%q)", reg.Proto.ProtoReflect().Descriptor().FullName())
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/grpc-external/testutils"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/resource/demo"
+	"github.com/hashicorp/consul/internal/storage"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	pbdemov2 "github.com/hashicorp/consul/proto/private/pbdemo/v2"
+)
+
+func TestWrite_Admission(t *testing.T) {
+	// requireTeam denies writes of resources without a "team" metadata label.
+	requireTeam := func(req *pbresource.AdmitRequest) (*pbresource.AdmitResponse, error) {
+		if req.Resource.Metadata["team"] == "" {
+			return &pbresource.AdmitResponse{Message: "resources must have a team label"}, nil
+		}
+		return &pbresource.AdmitResponse{Allowed: true}, nil
+	}
+
+	setup := func(t *testing.T, hook *AdmissionHook) (*Server, pbresource.ResourceServiceClient) {
+		server := testServer(t)
+		server.Admission = hook
+		demo.RegisterTypes(server.Registry)
+		return server, testClient(t, server)
+	}
+	artist := func(t *testing.T, metadata map[string]string) *pbresource.Resource {
+		res, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		res.Metadata = metadata
+		return res
+	}
+
+	t.Run("allow", func(t *testing.T) {
+		_, client := setup(t, &AdmissionHook{Client: testAdmissionClient(t, requireTeam)})
+
+		rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{
+			Resource: artist(t, map[string]string{"team": "frontend"}),
+		})
+		require.NoError(t, err)
+		require.Equal(t, "frontend", rsp.Resource.Metadata["team"])
+	})
+
+	t.Run("deny", func(t *testing.T) {
+		server, client := setup(t, &AdmissionHook{Client: testAdmissionClient(t, requireTeam)})
+
+		res := artist(t, nil)
+		_, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: res})
+		require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+		require.ErrorContains(t, err, "resources must have a team label")
+
+		_, err = server.Backend.Read(testContext(t), storage.EventualConsistency, res.Id)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("mutate", func(t *testing.T) {
+		addTeam := func(req *pbresource.AdmitRequest) (*pbresource.AdmitResponse, error) {
+			res := clone(req.Resource)
+			res.Metadata = map[string]string{"team": "default"}
+			return &pbresource.AdmitResponse{Allowed: true, Resource: res}, nil
+		}
+		_, client := setup(t, &AdmissionHook{Client: testAdmissionClient(t, addTeam)})
+
+		rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist(t, nil)})
+		require.NoError(t, err)
+		require.Equal(t, "default", rsp.Resource.Metadata["team"])
+	})
+
+	t.Run("invalid mutation", func(t *testing.T) {
+		rename := func(req *pbresource.AdmitRequest) (*pbresource.AdmitResponse, error) {
+			res := clone(req.Resource)
+			res.Id.Name = "renamed"
+			return &pbresource.AdmitResponse{Allowed: true, Resource: res}, nil
+		}
+		_, client := setup(t, &AdmissionHook{Client: testAdmissionClient(t, rename)})
+
+		_, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist(t, nil)})
+		require.Equal(t, codes.Internal.String(), status.Code(err).String())
+		require.ErrorContains(t, err, "id cannot be changed")
+	})
+
+	t.Run("mutation runs the mutate hook", func(t *testing.T) {
+		// The demo Artist mutate hook fills in an unspecified genre.
+		clearGenre := func(req *pbresource.AdmitRequest) (*pbresource.AdmitResponse, error) {
+			res := clone(req.Resource)
+			require.NoError(t, res.Data.MarshalFrom(&pbdemov2.Artist{Name: "cleared"}))
+			return &pbresource.AdmitResponse{Allowed: true, Resource: res}, nil
+		}
+		_, client := setup(t, &AdmissionHook{Client: testAdmissionClient(t, clearGenre)})
+
+		rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist(t, nil)})
+		require.NoError(t, err)
+
+		var written pbdemov2.Artist
+		require.NoError(t, rsp.Resource.Data.UnmarshalTo(&written))
+		require.Equal(t, "cleared", written.Name)
+		require.Equal(t, pbdemov2.Genre_GENRE_DISCO, written.Genre)
+	})
+
+	t.Run("mutation is authorized and validated", func(t *testing.T) {
+		// gatedType's write ACL denies privileged resources, and its reference
+		// validation rejects resources referring to missing ones.
+		gatedType := &pbresource.Type{Group: "demo", GroupVersion: "v2", Kind: "Gated"}
+		register := func(server *Server) {
+			server.Registry.Register(resource.Registration{
+				Type:  gatedType,
+				Proto: &pbdemov2.Artist{},
+				Scope: resource.ScopeNamespace,
+				ACLs: &resource.ACLHooks{
+					Write: func(_ acl.Authorizer, _ *acl.AuthorizerContext, res *pbresource.Resource) error {
+						if res.Metadata["privileged"] == "true" {
+							return acl.PermissionDenied("privileged resources cannot be written")
+						}
+						return nil
+					},
+				},
+				ValidateReferences: func(_ context.Context, _ resource.ResourceReader, res *pbresource.Resource) error {
+					if res.Metadata["ref"] == "missing" {
+						return errors.New("referenced resource does not exist")
+					}
+					return nil
+				},
+			})
+		}
+		gated := func(t *testing.T) *pbresource.Resource {
+			res := artist(t, nil)
+			res.Id.Type = gatedType
+			return res
+		}
+		setMetadata := func(key, value string) func(*pbresource.AdmitRequest) (*pbresource.AdmitResponse, error) {
+			return func(req *pbresource.AdmitRequest) (*pbresource.AdmitResponse, error) {
+				res := clone(req.Resource)
+				res.Metadata = map[string]string{key: value}
+				return &pbresource.AdmitResponse{Allowed: true, Resource: res}, nil
+			}
+		}
+
+		server, client := setup(t, &AdmissionHook{Client: testAdmissionClient(t, setMetadata("privileged", "true"))})
+		register(server)
+		_, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: gated(t)})
+		require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+
+		server.Admission = &AdmissionHook{Client: testAdmissionClient(t, setMetadata("ref", "missing"))}
+		_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: gated(t)})
+		require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+		require.ErrorContains(t, err, "referenced resource does not exist")
+	})
+
+	failing := func(*pbresource.AdmitRequest) (*pbresource.AdmitResponse, error) {
+		return nil, errors.New("policy engine unavailable")
+	}
+
+	t.Run("fail closed", func(t *testing.T) {
+		_, client := setup(t, &AdmissionHook{Client: testAdmissionClient(t, failing)})
+
+		_, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist(t, nil)})
+		require.Equal(t, codes.Unavailable.String(), status.Code(err).String())
+		require.ErrorContains(t, err, "policy engine unavailable")
+	})
+
+	t.Run("fail open", func(t *testing.T) {
+		_, client := setup(t, &AdmissionHook{Client: testAdmissionClient(t, failing), FailOpen: true})
+
+		_, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist(t, nil)})
+		require.NoError(t, err)
+	})
+
+	t.Run("delete is not subject to admission", func(t *testing.T) {
+		server, client := setup(t, nil)
+
+		// Deleting a resource with finalizers writes its deletion timestamp.
+		res := artist(t, nil)
+		resource.AddFinalizer(res, "finalizer1")
+		rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: res})
+		require.NoError(t, err)
+
+		server.Admission = &AdmissionHook{Client: testAdmissionClient(t, requireTeam)}
+		_, err = client.Delete(testContext(t), &pbresource.DeleteRequest{Id: rsp.Resource.Id})
+		require.NoError(t, err)
+
+		read, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: rsp.Resource.Id})
+		require.NoError(t, err)
+		require.True(t, resource.IsMarkedForDeletion(read.Resource))
+	})
+}
+
+// testAdmissionClient runs a fake admission server that responds using admit,
+// and returns a client connected to it.
+func testAdmissionClient(t *testing.T, admit func(*pbresource.AdmitRequest) (*pbresource.AdmitResponse, error)) pbresource.AdmissionServiceClient {
+	t.Helper()
+
+	addr := testutils.RunTestServer(t, &fakeAdmissionServer{admit: admit})
+
+	//nolint:staticcheck
+	conn, err := grpc.DialContext(context.Background(), addr.String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+	})
+
+	return pbresource.NewAdmissionServiceClient(conn)
+}
+
+type fakeAdmissionServer struct {
+	pbresource.UnimplementedAdmissionServiceServer
+
+	admit func(*pbresource.AdmitRequest) (*pbresource.AdmitResponse, error)
+}
+
+func (s *fakeAdmissionServer) Register(grpcServer *grpc.Server) {
+	pbresource.RegisterAdmissionServiceServer(grpcServer, s)
+}
+
+func (s *fakeAdmissionServer) Admit(_ context.Context, req *pbresource.AdmitRequest) (*pbresource.AdmitResponse, error) {
+	return s.admit(req)
+}
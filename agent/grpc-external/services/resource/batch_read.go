@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// maxBatchReadIDs is the number of IDs a single BatchRead may read.
+const maxBatchReadIDs = 1000
+
+// BatchRead reads each of the given IDs as Read would, reporting a status for
+// each rather than failing the whole batch. The batch is rate limited as a
+// single request.
+// - Errors with InvalidArgument if no IDs, or more than maxBatchReadIDs, are given.
+func (s *Server) BatchRead(ctx context.Context, req *pbresource.BatchReadRequest) (*pbresource.BatchReadResponse, error) {
+	if err := s.checkRateLimit(ctx, "BatchRead"); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(req.Ids) == 0:
+		return nil, status.Error(codes.InvalidArgument, "ids is required")
+	case len(req.Ids) > maxBatchReadIDs:
+		return nil, status.Errorf(codes.InvalidArgument, "ids must not contain more than %d entries", maxBatchReadIDs)
+	}
+
+	results := make([]*pbresource.BatchReadResult, 0, len(req.Ids))
+	for _, id := range req.Ids {
+		result := &pbresource.BatchReadResult{Id: id}
+		results = append(results, result)
+
+		// Each entry is read as by Read so that it is validated and authorized
+		// in exactly the same way.
+		rsp, _, err := s.read(ctx, &pbresource.ReadRequest{Id: id})
+		result.Status = rpcStatus(err)
+		if err == nil {
+			result.Resource = rsp.Resource
+		}
+	}
+	return &pbresource.BatchReadResponse{Results: results}, nil
+}
+
+// rpcStatus returns the google.rpc.Status representation of err, which has the
+// OK code when err is nil.
+func rpcStatus(err error) *rpcstatus.Status {
+	if err == nil {
+		return status.New(codes.OK, "").Proto()
+	}
+	return status.Convert(err).Proto()
+}
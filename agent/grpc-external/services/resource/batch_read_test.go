@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/agent/grpc-external/testutils"
+	"github.com/hashicorp/consul/internal/resource/demo"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/prototest"
+)
+
+func TestBatchRead_InputValidation(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	_, err := client.BatchRead(testContext(t), &pbresource.BatchReadRequest{})
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.ErrorContains(t, err, "ids is required")
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	ids := make([]*pbresource.ID, maxBatchReadIDs+1)
+	for i := range ids {
+		ids[i] = artist.Id
+	}
+	_, err = client.BatchRead(testContext(t), &pbresource.BatchReadRequest{Ids: ids})
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.ErrorContains(t, err, "more than 1000 entries")
+}
+
+func TestBatchRead_PartialFailure(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+
+	dr := &dummyACLResolver{
+		result: testutils.ACLsDisabled(t),
+	}
+	server.ACLResolver = dr
+
+	demo.RegisterTypes(server.Registry)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	artist, err = server.Backend.WriteCAS(testContext(t), artist)
+	require.NoError(t, err)
+
+	// Generated names may collide, so give the missing artist a distinct one.
+	missing, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	missing.Id.Name = artist.Id.Name + "-missing"
+
+	executive, err := demo.GenerateV1Executive("marvin", "CEO")
+	require.NoError(t, err)
+	executive, err = server.Backend.WriteCAS(testContext(t), executive)
+	require.NoError(t, err)
+
+	invalid := clone(artist.Id)
+	invalid.Name = ""
+
+	dr.SetResult(AuthorizerFrom(t, demo.ArtistV2ReadPolicy))
+	//nolint:staticcheck
+	ctx := context.WithValue(testContext(t), "x-consul-token", "artist-reader")
+
+	ids := []*pbresource.ID{artist.Id, missing.Id, executive.Id, invalid}
+	rsp, err := client.BatchRead(ctx, &pbresource.BatchReadRequest{Ids: ids})
+	require.NoError(t, err)
+	require.Len(t, rsp.Results, len(ids))
+
+	for idx, result := range rsp.Results {
+		prototest.AssertDeepEqual(t, ids[idx], result.Id)
+	}
+
+	require.Equal(t, int32(codes.OK), rsp.Results[0].Status.Code)
+	prototest.AssertDeepEqual(t, artist, rsp.Results[0].Resource)
+
+	require.Equal(t, int32(codes.NotFound), rsp.Results[1].Status.Code)
+	require.Nil(t, rsp.Results[1].Resource)
+
+	require.Equal(t, int32(codes.PermissionDenied), rsp.Results[2].Status.Code)
+	require.Nil(t, rsp.Results[2].Resource)
+
+	require.Equal(t, int32(codes.InvalidArgument), rsp.Results[3].Status.Code)
+	require.Contains(t, rsp.Results[3].Status.Message, "name")
+	require.Nil(t, rsp.Results[3].Resource)
+}
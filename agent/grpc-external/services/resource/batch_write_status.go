@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// maxBatchWriteStatusRequests is the number of statuses a single
+// BatchWriteStatus may write.
+const maxBatchWriteStatusRequests = 1000
+
+// BatchWriteStatus writes each of the given statuses as WriteStatus would,
+// reporting a status for each rather than failing the whole batch.
+// - Errors with InvalidArgument if no requests, or more than
+// maxBatchWriteStatusRequests, are given.
+func (s *Server) BatchWriteStatus(ctx context.Context, req *pbresource.BatchWriteStatusRequest) (*pbresource.BatchWriteStatusResponse, error) {
+	switch {
+	case len(req.Requests) == 0:
+		return nil, status.Error(codes.InvalidArgument, "requests is required")
+	case len(req.Requests) > maxBatchWriteStatusRequests:
+		return nil, status.Errorf(codes.InvalidArgument, "requests must not contain more than %d entries", maxBatchWriteStatusRequests)
+	}
+
+	results := make([]*pbresource.BatchWriteStatusResult, 0, len(req.Requests))
+	for _, write := range req.Requests {
+		result := &pbresource.BatchWriteStatusResult{}
+		results = append(results, result)
+
+		// Each entry goes through WriteStatus so that it is validated and
+		// authorized in exactly the same way.
+		rsp, err := s.WriteStatus(ctx, write)
+		result.Status = rpcStatus(err)
+		if err == nil {
+			result.Resource = rsp.Resource
+		}
+	}
+	return &pbresource.BatchWriteStatusResponse{Results: results}, nil
+}
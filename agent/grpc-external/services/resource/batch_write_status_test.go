@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/internal/resource/demo"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+func TestBatchWriteStatus_InputValidation(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	_, err := client.BatchWriteStatus(testContext(t), &pbresource.BatchWriteStatusRequest{})
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.ErrorContains(t, err, "requests is required")
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	reqs := make([]*pbresource.WriteStatusRequest, maxBatchWriteStatusRequests+1)
+	for i := range reqs {
+		reqs[i] = validWriteStatusRequest(t, artist)
+	}
+	_, err = client.BatchWriteStatus(testContext(t), &pbresource.BatchWriteStatusRequest{Requests: reqs})
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.ErrorContains(t, err, "more than 1000 entries")
+}
+
+func TestBatchWriteStatus_PartialFailure(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	var artists []*pbresource.Resource
+	for i := 0; i < 2; i++ {
+		artist, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		artist.Id.Name = artist.Id.Name + string(rune('a'+i))
+		rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+		require.NoError(t, err)
+		artists = append(artists, rsp.Resource)
+	}
+
+	missing, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	missing.Id.Name = artists[0].Id.Name + "-missing"
+	missing.Id.Uid = artists[0].Id.Uid
+	missing.Generation = artists[0].Generation
+
+	reqs := []*pbresource.WriteStatusRequest{
+		validWriteStatusRequest(t, artists[0]),
+		validWriteStatusRequest(t, missing),
+		validWriteStatusRequest(t, artists[1]),
+	}
+	rsp, err := client.BatchWriteStatus(testContext(t), &pbresource.BatchWriteStatusRequest{Requests: reqs})
+	require.NoError(t, err)
+	require.Len(t, rsp.Results, len(reqs))
+
+	for _, idx := range []int{0, 2} {
+		result := rsp.Results[idx]
+		require.Equal(t, int32(codes.OK), result.Status.Code)
+		require.Equal(t, reqs[idx].Id.Name, result.Resource.Id.Name)
+		require.Contains(t, result.Resource.Status, reqs[idx].Key)
+
+		read, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: reqs[idx].Id})
+		require.NoError(t, err)
+		require.Contains(t, read.Resource.Status, reqs[idx].Key)
+	}
+
+	require.Equal(t, int32(codes.NotFound), rsp.Results[1].Status.Code)
+	require.Nil(t, rsp.Results[1].Resource)
+}
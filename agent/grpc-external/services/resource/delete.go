@@ -27,14 +27,18 @@ import (
 // - Delete of a previously deleted or non-existent resource is a no-op to support idempotency.
 // - Errors with Aborted if the requested Version does not match the stored Version.
 // - Errors with PermissionDenied if ACL check fails
+// - Errors with FailedPrecondition if a Precondition is given and not met.
 func (s *Server) Delete(ctx context.Context, req *pbresource.DeleteRequest) (*pbresource.DeleteResponse, error) {
+	if err := s.checkRateLimit(ctx, "Delete"); err != nil {
+		return nil, err
+	}
+
 	reg, err := s.ensureDeleteRequestValid(req)
 	if err != nil {
 		return nil, err
 	}
 
-	entMeta := v2TenancyToV1EntMeta(req.Id.Tenancy)
-	authz, authzContext, err := s.getAuthorizer(tokenFromContext(ctx), entMeta)
+	authz, authzContext, err := s.resolveTenancy(tokenFromContext(ctx), reg, req.Id.Tenancy)
 	if err != nil {
 		return nil, err
 	}
@@ -46,9 +50,6 @@ func (s *Server) Delete(ctx context.Context, req *pbresource.DeleteRequest) (*pb
 		consistency = storage.StrongConsistency
 	}
 
-	// Apply defaults when tenancy units empty.
-	v1EntMetaToV2Tenancy(reg, entMeta, req.Id.Tenancy)
-
 	existing, err := s.Backend.Read(ctx, consistency, req.Id)
 	switch {
 	case errors.Is(err, storage.ErrNotFound):
@@ -67,12 +68,22 @@ func (s *Server) Delete(ctx context.Context, req *pbresource.DeleteRequest) (*pb
 		return nil, status.Errorf(codes.Internal, "failed write acl: %v", err)
 	}
 
+	if err := checkDeletePrecondition(req.Precondition, existing); err != nil {
+		return nil, err
+	}
+
+	// Deleting by name when a Version is given still requires it to match.
 	deleteVersion := req.Version
 	deleteId := req.Id
 	if deleteVersion == "" || deleteId.Uid == "" {
-		deleteVersion = existing.Version
 		deleteId = existing.Id
 	}
+	if deleteVersion == "" {
+		deleteVersion = existing.Version
+	}
+	if deleteVersion != existing.Version {
+		return nil, status.Error(codes.Aborted, storage.ErrCASFailure.Error())
+	}
 
 	// Check finalizers for a deferred delete
 	if resource.HasFinalizers(existing) {
@@ -101,20 +112,66 @@ func (s *Server) Delete(ctx context.Context, req *pbresource.DeleteRequest) (*pb
 	}
 }
 
+// checkDeletePrecondition returns a FailedPrecondition error unless the
+// resource has a condition matching the given precondition.
+func checkDeletePrecondition(pre *pbresource.DeletePrecondition, res *pbresource.Resource) error {
+	if pre == nil {
+		return nil
+	}
+
+	for _, cond := range res.Status[pre.StatusKey].GetConditions() {
+		if cond.Type != pre.ConditionType {
+			continue
+		}
+		if cond.State == pre.State && (pre.Reason == "" || cond.Reason == pre.Reason) {
+			return nil
+		}
+		return status.Errorf(
+			codes.FailedPrecondition,
+			"delete precondition not met: condition %q in status %q is %s (reason %q)",
+			pre.ConditionType, pre.StatusKey, cond.State, cond.Reason,
+		)
+	}
+
+	return status.Errorf(
+		codes.FailedPrecondition,
+		"delete precondition not met: condition %q not found in status %q",
+		pre.ConditionType, pre.StatusKey,
+	)
+}
+
 func (s *Server) markForDeletion(ctx context.Context, res *pbresource.Resource) (*pbresource.DeleteResponse, error) {
 	if res.Metadata == nil {
 		res.Metadata = map[string]string{}
 	}
 	res.Metadata[resource.DeletionTimestampKey] = time.Now().Format(time.RFC3339)
 
-	// Write the deletion timestamp
-	_, err := s.Write(ctx, &pbresource.WriteRequest{Resource: res})
+	// Write the deletion timestamp. The Delete request has already been counted
+	// by the rate limiter, and isn't subject to the admission hook.
+	_, err := s.Write(withAdmissionExempt(withRateLimitExempt(ctx)), &pbresource.WriteRequest{Resource: res})
 	if err != nil {
 		return nil, err
 	}
 	return &pbresource.DeleteResponse{}, nil
 }
 
+// deleteFinalized deletes a resource marked for deletion whose finalizers have
+// all been removed. A concurrent write of the resource leaves it in place, as
+// that write is responsible for deleting it if it still has no finalizers.
+func (s *Server) deleteFinalized(ctx context.Context, res *pbresource.Resource) error {
+	if err := s.maybeCreateTombstone(ctx, res.Id); err != nil {
+		return err
+	}
+
+	err := s.Backend.DeleteCAS(ctx, res.Id, res.Version)
+	switch {
+	case err == nil, errors.Is(err, storage.ErrCASFailure):
+		return nil
+	default:
+		return status.Errorf(codes.Internal, "failed delete: %v", err)
+	}
+}
+
 // Create a tombstone to capture the intent to delete child resources.
 // Tombstones are created preemptively to prevent partial failures even though
 // we are currently unaware of the success/failure/no-op of DeleteCAS. In
@@ -180,6 +237,15 @@ func (s *Server) ensureDeleteRequestValid(req *pbresource.DeleteRequest) (*resou
 		return nil, err
 	}
 
+	if req.Precondition != nil {
+		if req.Precondition.StatusKey == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "precondition.status_key is required")
+		}
+		if req.Precondition.ConditionType == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "precondition.condition_type is required")
+		}
+	}
+
 	reg, err := s.resolveType(req.Id.Type)
 	if err != nil {
 		return nil, err
@@ -202,9 +268,12 @@ func (s *Server) ensureDeleteRequestValid(req *pbresource.DeleteRequest) (*resou
 	return reg, nil
 }
 
+// tombstoneNamePrefix begins the names of all tombstones.
+const tombstoneNamePrefix = "tombstone-"
+
 // Maintains a deterministic mapping between a resource and it's tombstone's
 // name by embedding the resources's Uid in the name.
 func tombstoneName(deleteId *pbresource.ID) string {
 	// deleteId.Name is just included for easier identification
-	return fmt.Sprintf("tombstone-%v-%v", deleteId.Name, strings.ToLower(deleteId.Uid))
+	return fmt.Sprintf("%s%v-%v", tombstoneNamePrefix, deleteId.Name, strings.ToLower(deleteId.Uid))
 }
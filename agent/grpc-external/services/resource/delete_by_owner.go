@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// DeleteByOwner deletes the resources owned by the given resource, optionally
+// only those of the given types.
+// - Errors with PermissionDenied if the caller may not delete every resource,
+// in which case nothing is deleted.
+// - Resources with finalizers are marked for deletion rather than deleted.
+// - Resources are deleted one at a time, so a failure part-way through leaves
+// those already deleted deleted. The call can be retried.
+func (s *Server) DeleteByOwner(ctx context.Context, req *pbresource.DeleteByOwnerRequest) (*pbresource.DeleteByOwnerResponse, error) {
+	if err := s.checkRateLimit(ctx, "DeleteByOwner"); err != nil {
+		return nil, err
+	}
+
+	reg, err := s.ensureDeleteByOwnerRequestValid(req)
+	if err != nil {
+		return nil, err
+	}
+
+	token := tokenFromContext(ctx)
+	authz, authzContext, err := s.resolveTenancy(token, reg, req.Owner.Tenancy)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := s.Backend.ListByOwner(ctx, req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed list by owner: %v", err)
+	}
+
+	// Check ACLs for every resource up front so that a denial doesn't leave the
+	// owner's resources partially deleted.
+	toDelete := make([]*pbresource.Resource, 0, len(children))
+	for _, child := range children {
+		if !typeRequested(req.Types, child.Id.Type) {
+			continue
+		}
+
+		childReg, err := s.resolveType(child.Id.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		// Rebuild authorizer if tenancy not identical between owner and child
+		// (child scope may be narrower).
+		childAuthz := authz
+		childAuthzContext := authzContext
+		if !resource.EqualTenancy(req.Owner.Tenancy, child.Id.Tenancy) {
+			childAuthz, childAuthzContext, err = s.getAuthorizer(token, v2TenancyToV1EntMeta(child.Id.Tenancy))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = childReg.ACLs.Write(childAuthz, childAuthzContext, child)
+		switch {
+		case acl.IsErrPermissionDenied(err):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case err != nil:
+			return nil, status.Errorf(codes.Internal, "failed write acl: %v", err)
+		}
+		toDelete = append(toDelete, child)
+	}
+
+	rsp := &pbresource.DeleteByOwnerResponse{Deleted: make(map[string]uint64)}
+	for _, res := range toDelete {
+		if _, err := s.deleteOrMark(ctx, res); err != nil {
+			return nil, err
+		}
+		rsp.Deleted[resource.ToGVK(res.Id.Type)]++
+	}
+	return rsp, nil
+}
+
+// typeRequested reports whether typ is one of types, or types is empty.
+func typeRequested(types []*pbresource.Type, typ *pbresource.Type) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		if resource.EqualType(t, typ) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) ensureDeleteByOwnerRequestValid(req *pbresource.DeleteByOwnerRequest) (*resource.Registration, error) {
+	if req.Owner == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "owner is required")
+	}
+
+	if err := validateId(req.Owner, "owner"); err != nil {
+		return nil, err
+	}
+
+	if req.Owner.Uid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "owner uid is required")
+	}
+
+	reg, err := s.resolveType(req.Owner.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkV2Tenancy(s.UseV2Tenancy, req.Owner.Type); err != nil {
+		return nil, err
+	}
+
+	if err = validateScopedTenancy(reg.Scope, req.Owner.Type, req.Owner.Tenancy); err != nil {
+		return nil, err
+	}
+
+	for _, typ := range req.Types {
+		if _, err := s.resolveType(typ); err != nil {
+			return nil, err
+		}
+	}
+	return reg, nil
+}
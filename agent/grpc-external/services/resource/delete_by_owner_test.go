@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/agent/grpc-external/testutils"
+	"github.com/hashicorp/consul/internal/catalog"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/resource/demo"
+	rtest "github.com/hashicorp/consul/internal/resource/resourcetest"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+func TestDeleteByOwner_InputValidation(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+	owner := rsp.Resource.Id
+
+	testCases := map[string]struct {
+		modFn       func(*pbresource.DeleteByOwnerRequest)
+		errContains string
+	}{
+		"no owner": {
+			modFn:       func(req *pbresource.DeleteByOwnerRequest) { req.Owner = nil },
+			errContains: "owner is required",
+		},
+		"no owner uid": {
+			modFn:       func(req *pbresource.DeleteByOwnerRequest) { req.Owner.Uid = "" },
+			errContains: "owner uid is required",
+		},
+		"unregistered type": {
+			modFn: func(req *pbresource.DeleteByOwnerRequest) {
+				req.Types = []*pbresource.Type{{Group: "foo", GroupVersion: "v1", Kind: "Bar"}}
+			},
+			errContains: "not registered",
+		},
+	}
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			req := &pbresource.DeleteByOwnerRequest{Owner: clone(owner)}
+			tc.modFn(req)
+
+			_, err := client.DeleteByOwner(testContext(t), req)
+			require.Error(t, err)
+			require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+			require.Contains(t, err.Error(), tc.errContains)
+		})
+	}
+}
+
+func TestDeleteByOwner_HealthStatuses(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	writeNode := func(name string) *pbresource.ID {
+		return rtest.Resource(pbcatalog.NodeType, name).
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+			Write(t, client).Id
+	}
+	writeCheck := func(name string, node *pbresource.ID) *pbresource.ID {
+		return rtest.Resource(pbcatalog.HealthStatusType, name).
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_PASSING}).
+			WithOwner(node).
+			Write(t, client).Id
+	}
+
+	node := writeNode("node-1")
+	var checks []*pbresource.ID
+	for i := 0; i < 3; i++ {
+		checks = append(checks, writeCheck(fmt.Sprintf("node-1-check-%d", i), node))
+	}
+	other := writeNode("node-2")
+	unrelated := writeCheck("node-2-check", other)
+
+	rsp, err := client.DeleteByOwner(ctx, &pbresource.DeleteByOwnerRequest{Owner: node})
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{resource.ToGVK(pbcatalog.HealthStatusType): 3}, rsp.Deleted)
+
+	for _, id := range checks {
+		_, err := client.Read(ctx, &pbresource.ReadRequest{Id: id})
+		require.Equal(t, codes.NotFound.String(), status.Code(err).String())
+	}
+
+	// Neither the owner nor resources owned by others are deleted.
+	for _, id := range []*pbresource.ID{node, other, unrelated} {
+		_, err := client.Read(ctx, &pbresource.ReadRequest{Id: id})
+		require.NoError(t, err)
+	}
+}
+
+func TestDeleteByOwner_Types(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	artist, album, childArtist := writeOwnedDemoResources(t, client)
+
+	rsp, err := client.DeleteByOwner(ctx, &pbresource.DeleteByOwnerRequest{
+		Owner: artist,
+		Types: []*pbresource.Type{demo.TypeV2Album},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{resource.ToGVK(demo.TypeV2Album): 1}, rsp.Deleted)
+
+	_, err = client.Read(ctx, &pbresource.ReadRequest{Id: album})
+	require.Equal(t, codes.NotFound.String(), status.Code(err).String())
+
+	_, err = client.Read(ctx, &pbresource.ReadRequest{Id: childArtist})
+	require.NoError(t, err)
+}
+
+func TestDeleteByOwner_ACLs(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	artist, album, childArtist := writeOwnedDemoResources(t, client)
+
+	// The caller may delete artists but not albums, so nothing is deleted.
+	dr := &dummyACLResolver{result: AuthorizerFrom(t, demo.ArtistV2WritePolicy)}
+	server.ACLResolver = dr
+
+	_, err := client.DeleteByOwner(testContext(t), &pbresource.DeleteByOwnerRequest{Owner: artist})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+
+	dr.SetResult(testutils.ACLsDisabled(t))
+	for _, id := range []*pbresource.ID{album, childArtist} {
+		_, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: id})
+		require.NoError(t, err)
+	}
+}
+
+// writeOwnedDemoResources writes an artist owning an album and another artist,
+// and returns their IDs.
+func writeOwnedDemoResources(t *testing.T, client pbresource.ResourceServiceClient) (artist, album, childArtist *pbresource.ID) {
+	t.Helper()
+
+	write := func(res *pbresource.Resource) *pbresource.ID {
+		rsp, err := client.Write(context.Background(), &pbresource.WriteRequest{Resource: res})
+		require.NoError(t, err)
+		return rsp.Resource.Id
+	}
+
+	res, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	res.Id.Name = "owner"
+	artist = write(res)
+
+	res, err = demo.GenerateV2Album(artist)
+	require.NoError(t, err)
+	album = write(res)
+
+	res, err = demo.GenerateV2Artist()
+	require.NoError(t, err)
+	res.Id.Name = "child"
+	res.Owner = artist
+	childArtist = write(res)
+
+	return artist, album, childArtist
+}
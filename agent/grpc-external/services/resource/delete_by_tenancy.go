@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/storage"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// DeleteByTenancy deletes all resources of the given types in a namespace.
+// - Errors with InvalidArgument if Confirmation does not match the namespace name.
+// - Errors with FailedPrecondition if the namespace is not marked for deletion.
+// - Errors with PermissionDenied if the caller may not delete every resource,
+// in which case nothing is deleted.
+// - Resources with finalizers are marked for deletion rather than deleted, and
+// counted separately.
+func (s *Server) DeleteByTenancy(ctx context.Context, req *pbresource.DeleteByTenancyRequest) (*pbresource.DeleteByTenancyResponse, error) {
+	regs, err := s.ensureDeleteByTenancyRequestValid(req)
+	if err != nil {
+		return nil, err
+	}
+
+	authz, authzContext, err := s.getAuthorizer(tokenFromContext(ctx), v2TenancyToV1EntMeta(req.Tenancy))
+	if err != nil {
+		return nil, err
+	}
+
+	// Check ACLs for every resource up front so that a denial doesn't leave the
+	// namespace partially deleted.
+	toDelete := make([][]*pbresource.Resource, len(req.Types))
+	for i, typ := range req.Types {
+		resources, err := s.Backend.List(ctx, storage.StrongConsistency, storage.UnversionedTypeFrom(typ), req.Tenancy, "")
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed list: %v", err)
+		}
+
+		for _, res := range resources {
+			if res.Id.Type.GroupVersion != typ.GroupVersion {
+				continue
+			}
+
+			err = regs[i].ACLs.Write(authz, authzContext, res)
+			switch {
+			case acl.IsErrPermissionDenied(err):
+				return nil, status.Error(codes.PermissionDenied, err.Error())
+			case err != nil:
+				return nil, status.Errorf(codes.Internal, "failed write acl: %v", err)
+			}
+			toDelete[i] = append(toDelete[i], res)
+		}
+	}
+
+	// Check the namespace is being deleted after the ACL checks so as not to
+	// leak whether it is to unauthorized callers.
+	deleting, err := s.TenancyBridge.IsNamespaceMarkedForDeletion(req.Tenancy.Partition, req.Tenancy.Namespace)
+	switch {
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed tenancy check: %v", err)
+	case !deleting:
+		return nil, status.Errorf(codes.FailedPrecondition, "namespace is not marked for deletion: %s", req.Tenancy.Namespace)
+	}
+
+	rsp := &pbresource.DeleteByTenancyResponse{
+		Deleted: make(map[string]uint64, len(req.Types)),
+		Marked:  make(map[string]uint64, len(req.Types)),
+	}
+	for i, typ := range req.Types {
+		for _, res := range toDelete[i] {
+			marked, err := s.deleteOrMark(ctx, res)
+			if err != nil {
+				return nil, err
+			}
+			if marked {
+				rsp.Marked[resource.ToGVK(typ)]++
+			} else {
+				rsp.Deleted[resource.ToGVK(typ)]++
+			}
+		}
+	}
+	return rsp, nil
+}
+
+// deleteOrMark deletes a resource, or marks it for deletion if it has
+// finalizers. Callers must already have authorized the deletion. Unlike
+// Delete, the deletion timestamp is written to the backend directly, bypassing
+// Write's checks, which would reject resources in tenancies marked for deletion
+// when called by DeleteByTenancy. It returns whether the resource was only
+// marked for deletion (or already was).
+func (s *Server) deleteOrMark(ctx context.Context, res *pbresource.Resource) (bool, error) {
+	if resource.HasFinalizers(res) {
+		if resource.IsMarkedForDeletion(res) {
+			return true, nil
+		}
+
+		res = clone(res)
+		if res.Metadata == nil {
+			res.Metadata = map[string]string{}
+		}
+		res.Metadata[resource.DeletionTimestampKey] = time.Now().Format(time.RFC3339)
+		res.Generation = ulid.Make().String()
+
+		_, err := s.Backend.WriteCAS(ctx, res)
+		switch {
+		case err == nil:
+			return true, nil
+		case errors.Is(err, storage.ErrCASFailure):
+			return false, status.Error(codes.Aborted, err.Error())
+		default:
+			return false, status.Errorf(codes.Internal, "failed write: %v", err)
+		}
+	}
+
+	if err := s.maybeCreateTombstone(ctx, res.Id); err != nil {
+		return false, err
+	}
+
+	err := s.Backend.DeleteCAS(ctx, res.Id, res.Version)
+	switch {
+	case err == nil:
+		return false, nil
+	case errors.Is(err, storage.ErrCASFailure):
+		return false, status.Error(codes.Aborted, err.Error())
+	default:
+		return false, status.Errorf(codes.Internal, "failed delete: %v", err)
+	}
+}
+
+func (s *Server) ensureDeleteByTenancyRequestValid(req *pbresource.DeleteByTenancyRequest) ([]*resource.Registration, error) {
+	var field string
+	switch {
+	case len(req.Types) == 0:
+		field = "types"
+	case req.Tenancy == nil:
+		field = "tenancy"
+	case req.Tenancy.Partition == "":
+		field = "tenancy.partition"
+	case req.Tenancy.Namespace == "":
+		field = "tenancy.namespace"
+	}
+	if field != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is required", field)
+	}
+
+	if req.Tenancy.Partition == storage.Wildcard || req.Tenancy.Namespace == storage.Wildcard {
+		return nil, status.Error(codes.InvalidArgument, "tenancy cannot contain wildcards")
+	}
+
+	if req.Confirmation != req.Tenancy.Namespace {
+		return nil, status.Errorf(codes.InvalidArgument, "confirmation must be set to the namespace name %q", req.Tenancy.Namespace)
+	}
+
+	regs := make([]*resource.Registration, len(req.Types))
+	for i, typ := range req.Types {
+		reg, err := s.resolveType(typ)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = checkV2Tenancy(s.UseV2Tenancy, typ); err != nil {
+			return nil, err
+		}
+
+		if reg.Scope != resource.ScopeNamespace {
+			return nil, status.Errorf(codes.InvalidArgument, "type %s is not namespace scoped", resource.ToGVK(typ))
+		}
+		regs[i] = reg
+	}
+	return regs, nil
+}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/resource/demo"
+	"github.com/hashicorp/consul/internal/storage"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+func TestDeleteByTenancy_InputValidation(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	validRequest := func() *pbresource.DeleteByTenancyRequest {
+		return &pbresource.DeleteByTenancyRequest{
+			Types:        []*pbresource.Type{demo.TypeV2Artist},
+			Tenancy:      &pbresource.Tenancy{Partition: resource.DefaultPartitionName, Namespace: "doomed"},
+			Confirmation: "doomed",
+		}
+	}
+
+	testCases := map[string]struct {
+		modFn       func(*pbresource.DeleteByTenancyRequest)
+		errContains string
+	}{
+		"no types": {
+			modFn:       func(req *pbresource.DeleteByTenancyRequest) { req.Types = nil },
+			errContains: "types is required",
+		},
+		"no tenancy": {
+			modFn:       func(req *pbresource.DeleteByTenancyRequest) { req.Tenancy = nil },
+			errContains: "tenancy is required",
+		},
+		"no namespace": {
+			modFn:       func(req *pbresource.DeleteByTenancyRequest) { req.Tenancy.Namespace = "" },
+			errContains: "tenancy.namespace is required",
+		},
+		"wildcard namespace": {
+			modFn: func(req *pbresource.DeleteByTenancyRequest) {
+				req.Tenancy.Namespace = storage.Wildcard
+				req.Confirmation = storage.Wildcard
+			},
+			errContains: "cannot contain wildcards",
+		},
+		"no confirmation": {
+			modFn:       func(req *pbresource.DeleteByTenancyRequest) { req.Confirmation = "" },
+			errContains: "confirmation must be set",
+		},
+		"wrong confirmation": {
+			modFn:       func(req *pbresource.DeleteByTenancyRequest) { req.Confirmation = "default" },
+			errContains: "confirmation must be set",
+		},
+		"partition scoped type": {
+			modFn:       func(req *pbresource.DeleteByTenancyRequest) { req.Types = append(req.Types, demo.TypeV1RecordLabel) },
+			errContains: "is not namespace scoped",
+		},
+	}
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			req := validRequest()
+			tc.modFn(req)
+
+			_, err := client.DeleteByTenancy(testContext(t), req)
+			require.Error(t, err)
+			require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+			require.Contains(t, err.Error(), tc.errContains)
+		})
+	}
+}
+
+func TestDeleteByTenancy_NotMarkedForDeletion(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+
+	_, err = client.DeleteByTenancy(testContext(t), &pbresource.DeleteByTenancyRequest{
+		Types:        []*pbresource.Type{demo.TypeV2Artist},
+		Tenancy:      resource.DefaultNamespacedTenancy(),
+		Confirmation: resource.DefaultNamespaceName,
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition.String(), status.Code(err).String())
+	require.Contains(t, err.Error(), "not marked for deletion")
+
+	_, err = client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id})
+	require.NoError(t, err)
+}
+
+func TestDeleteByTenancy_ACLs(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+
+	// Unauthorized callers are denied before learning whether the namespace is
+	// being deleted.
+	server.ACLResolver = &dummyACLResolver{result: AuthorizerFrom(t, demo.ArtistV2ReadPolicy)}
+	_, err = client.DeleteByTenancy(testContext(t), &pbresource.DeleteByTenancyRequest{
+		Types:        []*pbresource.Type{demo.TypeV2Artist},
+		Tenancy:      resource.DefaultNamespacedTenancy(),
+		Confirmation: resource.DefaultNamespaceName,
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+}
+
+func TestDeleteByTenancy_Success(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	doomed := resource.DefaultNamespacedTenancy()
+	mockTenancyBridge := &MockTenancyBridge{}
+	mockTenancyBridge.On("PartitionExists", resource.DefaultPartitionName).Return(true, nil)
+	mockTenancyBridge.On("NamespaceExists", resource.DefaultPartitionName, resource.DefaultNamespaceName).Return(true, nil)
+	mockTenancyBridge.On("IsPartitionMarkedForDeletion", resource.DefaultPartitionName).Return(false, nil)
+	mockTenancyBridge.On("IsNamespaceMarkedForDeletion", resource.DefaultPartitionName, resource.DefaultNamespaceName).Return(true, nil)
+	server.TenancyBridge = mockTenancyBridge
+
+	// A partition scoped resource survives the namespace being deleted.
+	survivor, err := demo.GenerateV1RecordLabel("looney-tunes")
+	require.NoError(t, err)
+	_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: survivor})
+	require.NoError(t, err)
+
+	// Fixtures in the doomed namespace are written to the backend directly
+	// because the Write endpoint refuses tenancies marked for deletion.
+	writeFixture := func(res *pbresource.Resource) *pbresource.Resource {
+		res.Id.Uid = ulid.Make().String()
+		res.Generation = ulid.Make().String()
+		written, err := server.Backend.WriteCAS(testContext(t), res)
+		require.NoError(t, err)
+		return written
+	}
+
+	var artists, albums []*pbresource.Resource
+	for i := 0; i < 3; i++ {
+		artist, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		artist.Id.Name = fmt.Sprintf("artist-%d", i)
+		artist = writeFixture(artist)
+		artists = append(artists, artist)
+
+		album, err := demo.GenerateV2Album(artist.Id)
+		require.NoError(t, err)
+		album.Id.Name = fmt.Sprintf("album-%d", i)
+		albums = append(albums, writeFixture(album))
+	}
+
+	finalized, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	finalized.Id.Name = "finalized"
+	resource.AddFinalizer(finalized, "finalizer1")
+	finalized = writeFixture(finalized)
+
+	rsp, err := client.DeleteByTenancy(testContext(t), &pbresource.DeleteByTenancyRequest{
+		Types:        []*pbresource.Type{demo.TypeV2Artist, demo.TypeV2Album},
+		Tenancy:      doomed,
+		Confirmation: doomed.Namespace,
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{
+		resource.ToGVK(demo.TypeV2Artist): 3,
+		resource.ToGVK(demo.TypeV2Album):  3,
+	}, rsp.Deleted)
+	require.Equal(t, map[string]uint64{resource.ToGVK(demo.TypeV2Artist): 1}, rsp.Marked)
+
+	for _, res := range append(artists, albums...) {
+		_, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: res.Id})
+		require.Equal(t, codes.NotFound.String(), status.Code(err).String())
+	}
+
+	// Resources with finalizers are only marked for deletion.
+	readRsp, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: finalized.Id})
+	require.NoError(t, err)
+	require.True(t, resource.IsMarkedForDeletion(readRsp.Resource))
+
+	_, err = client.Read(testContext(t), &pbresource.ReadRequest{Id: survivor.Id})
+	require.NoError(t, err)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/consul/internal/resource"
 	"github.com/hashicorp/consul/internal/resource/demo"
 	rtest "github.com/hashicorp/consul/internal/resource/resourcetest"
+	"github.com/hashicorp/consul/internal/storage"
 	"github.com/hashicorp/consul/proto-public/pbresource"
 	pbdemo "github.com/hashicorp/consul/proto/private/pbdemo/v1"
 )
@@ -315,6 +316,134 @@ func TestDelete_VersionMismatch(t *testing.T) {
 	require.ErrorContains(t, err, "CAS operation failed")
 }
 
+func TestDelete_Version(t *testing.T) {
+	t.Parallel()
+
+	server, client, ctx := testDeps(t)
+	demo.RegisterTypes(server.Registry)
+
+	write := func() *pbresource.Resource {
+		artist, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		rsp, err := client.Write(ctx, &pbresource.WriteRequest{Resource: artist})
+		require.NoError(t, err)
+		return rsp.Resource
+	}
+	idFns := map[string]func(*pbresource.ID) *pbresource.ID{
+		"by uid": func(id *pbresource.ID) *pbresource.ID { return id },
+		"by name": func(id *pbresource.ID) *pbresource.ID {
+			id = clone(id)
+			id.Uid = ""
+			return id
+		},
+	}
+
+	t.Run("matching version", func(t *testing.T) {
+		for desc, idFn := range idFns {
+			artist := write()
+			_, err := client.Delete(ctx, &pbresource.DeleteRequest{Id: idFn(artist.Id), Version: artist.Version})
+			require.NoError(t, err, desc)
+
+			_, err = client.Read(ctx, &pbresource.ReadRequest{Id: artist.Id})
+			require.Equal(t, codes.NotFound.String(), status.Code(err).String(), desc)
+		}
+	})
+
+	t.Run("mismatched version", func(t *testing.T) {
+		for desc, idFn := range idFns {
+			artist := write()
+			stale := artist.Version
+			updated := clone(artist)
+			updated.Metadata = map[string]string{"updated": "true"}
+			rsp, err := client.Write(ctx, &pbresource.WriteRequest{Resource: updated})
+			require.NoError(t, err)
+			artist = rsp.Resource
+
+			_, err = client.Delete(ctx, &pbresource.DeleteRequest{Id: idFn(artist.Id), Version: stale})
+			require.Equal(t, codes.Aborted.String(), status.Code(err).String(), desc)
+
+			// The concurrent update isn't clobbered.
+			_, err = client.Read(ctx, &pbresource.ReadRequest{Id: artist.Id})
+			require.NoError(t, err, desc)
+		}
+	})
+
+	t.Run("missing resource", func(t *testing.T) {
+		artist := write()
+		_, err := client.Delete(ctx, &pbresource.DeleteRequest{Id: artist.Id})
+		require.NoError(t, err)
+
+		// Deletes stay idempotent when a version is given.
+		_, err = client.Delete(ctx, &pbresource.DeleteRequest{Id: artist.Id, Version: artist.Version})
+		require.NoError(t, err)
+	})
+}
+
+func TestDelete_Precondition(t *testing.T) {
+	t.Parallel()
+
+	server, client, ctx := testDeps(t)
+	demo.RegisterTypes(server.Registry)
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	rsp, err := client.Write(ctx, &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+	artist = rsp.Resource
+
+	precondition := &pbresource.DeletePrecondition{
+		StatusKey:     "consul.io/artist-controller",
+		ConditionType: "Ready",
+		State:         pbresource.Condition_STATE_TRUE,
+		Reason:        "AllGood",
+	}
+
+	_, err = client.Delete(ctx, &pbresource.DeleteRequest{Id: artist.Id, Precondition: &pbresource.DeletePrecondition{ConditionType: "Ready"}})
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.ErrorContains(t, err, "precondition.status_key is required")
+
+	_, err = client.Delete(ctx, &pbresource.DeleteRequest{Id: artist.Id, Precondition: &pbresource.DeletePrecondition{StatusKey: "consul.io/artist-controller"}})
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.ErrorContains(t, err, "precondition.condition_type is required")
+
+	// No status has been written yet.
+	_, err = client.Delete(ctx, &pbresource.DeleteRequest{Id: artist.Id, Precondition: precondition})
+	require.Equal(t, codes.FailedPrecondition.String(), status.Code(err).String())
+	require.ErrorContains(t, err, "not found")
+
+	writeStatus := func(state pbresource.Condition_State, reason string) {
+		_, err := client.WriteStatus(ctx, &pbresource.WriteStatusRequest{
+			Id:  artist.Id,
+			Key: precondition.StatusKey,
+			Status: &pbresource.Status{
+				ObservedGeneration: artist.Generation,
+				Conditions: []*pbresource.Condition{
+					{Type: precondition.ConditionType, State: state, Reason: reason},
+				},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	writeStatus(pbresource.Condition_STATE_FALSE, "AllGood")
+	_, err = client.Delete(ctx, &pbresource.DeleteRequest{Id: artist.Id, Precondition: precondition})
+	require.Equal(t, codes.FailedPrecondition.String(), status.Code(err).String())
+
+	writeStatus(pbresource.Condition_STATE_TRUE, "SomethingElse")
+	_, err = client.Delete(ctx, &pbresource.DeleteRequest{Id: artist.Id, Precondition: precondition})
+	require.Equal(t, codes.FailedPrecondition.String(), status.Code(err).String())
+
+	// The resource must not have been deleted by the failed attempts.
+	_, err = server.Backend.Read(ctx, storage.StrongConsistency, artist.Id)
+	require.NoError(t, err)
+
+	writeStatus(pbresource.Condition_STATE_TRUE, "AllGood")
+	_, err = client.Delete(ctx, &pbresource.DeleteRequest{Id: artist.Id, Precondition: precondition})
+	require.NoError(t, err)
+
+	_, err = server.Backend.Read(ctx, storage.StrongConsistency, artist.Id)
+	require.ErrorIs(t, err, storage.ErrNotFound)
+}
+
 func TestDelete_MarkedForDeletionWhenFinalizersPresent(t *testing.T) {
 	server, client, ctx := testDeps(t)
 	demo.RegisterTypes(server.Registry)
@@ -377,6 +506,61 @@ func TestDelete_ImmediatelyDeletedAfterFinalizersRemoved(t *testing.T) {
 	require.Equal(t, codes.NotFound.String(), status.Code(err).String())
 }
 
+func TestDelete_FinalizersRemovedByWrite(t *testing.T) {
+	server, client, ctx := testDeps(t)
+	demo.RegisterTypes(server.Registry)
+
+	res := rtest.Resource(demo.TypeV1Artist, "manwithnoname").
+		WithTenancy(resource.DefaultClusteredTenancy()).
+		WithData(t, &pbdemo.Artist{Name: "Man With No Name"}).
+		Write(t, client)
+
+	read := func() *pbresource.Resource {
+		rsp, err := client.Read(ctx, &pbresource.ReadRequest{Id: res.Id})
+		require.NoError(t, err)
+		return rsp.Resource
+	}
+	write := func(res *pbresource.Resource) {
+		_, err := client.Write(ctx, &pbresource.WriteRequest{Resource: res})
+		require.NoError(t, err)
+	}
+
+	// Add finalizers.
+	res = read()
+	resource.AddFinalizer(res, "finalizer1")
+	resource.AddFinalizer(res, "finalizer2")
+	write(res)
+
+	// Delete marks the resource for deletion.
+	_, err := client.Delete(ctx, &pbresource.DeleteRequest{Id: res.Id})
+	require.NoError(t, err)
+	res = read()
+	require.True(t, resource.IsMarkedForDeletion(res))
+
+	// Writes can't unmark the resource, and it remains while it has finalizers.
+	resource.RemoveFinalizer(res, "finalizer1")
+	delete(res.Metadata, resource.DeletionTimestampKey)
+	write(res)
+	res = read()
+	require.True(t, resource.IsMarkedForDeletion(res))
+	require.True(t, resource.HasFinalizer(res, "finalizer2"))
+
+	// Removing the last finalizer deletes it.
+	resource.RemoveFinalizer(res, "finalizer2")
+	write(res)
+	_, err = client.Read(ctx, &pbresource.ReadRequest{Id: res.Id})
+	require.Equal(t, codes.NotFound.String(), status.Code(err).String())
+
+	_, err = client.Read(ctx, &pbresource.ReadRequest{
+		Id: &pbresource.ID{
+			Name:    tombstoneName(res.Id),
+			Type:    resource.TypeV1Tombstone,
+			Tenancy: res.Id.Tenancy,
+		},
+	})
+	require.NoError(t, err, "expected tombstone to be found")
+}
+
 func testDeps(t *testing.T) (*Server, pbresource.ResourceServiceClient, context.Context) {
 	server := testServer(t)
 	client := testClient(t, server)
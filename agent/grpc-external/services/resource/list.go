@@ -5,6 +5,10 @@ package resource
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"sort"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,15 +20,24 @@ import (
 )
 
 func (s *Server) List(ctx context.Context, req *pbresource.ListRequest) (*pbresource.ListResponse, error) {
+	if err := s.checkRateLimit(ctx, "List"); err != nil {
+		return nil, err
+	}
+
 	reg, err := s.ensureListRequestValid(req)
 	if err != nil {
 		return nil, err
 	}
 
-	// v1 ACL subsystem is "wildcard" aware so just pass on through.
-	entMeta := v2TenancyToV1EntMeta(req.Tenancy)
+	var filter *resource.DataFilter
+	if req.Filter != "" {
+		if filter, err = resource.NewDataFilter(req.Filter, reg.Proto); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "filter: %v", err)
+		}
+	}
+
 	token := tokenFromContext(ctx)
-	authz, authzContext, err := s.getAuthorizer(token, entMeta)
+	authz, authzContext, err := s.resolveTenancy(token, reg, req.Tenancy)
 	if err != nil {
 		return nil, err
 	}
@@ -38,12 +51,10 @@ func (s *Server) List(ctx context.Context, req *pbresource.ListRequest) (*pbreso
 		return nil, status.Errorf(codes.Internal, "failed list acl: %v", err)
 	}
 
-	// Ensure we're defaulting correctly when request tenancy units are empty.
-	v1EntMetaToV2Tenancy(reg, entMeta, req.Tenancy)
-
+	consistency := readConsistencyFrom(ctx, reg)
 	resources, err := s.Backend.List(
 		ctx,
-		readConsistencyFrom(ctx),
+		consistency,
 		storage.UnversionedTypeFrom(req.Type),
 		req.Tenancy,
 		req.NamePrefix,
@@ -62,8 +73,7 @@ func (s *Server) List(ctx context.Context, req *pbresource.ListRequest) (*pbreso
 		// Need to rebuild authorizer per resource since wildcard inputs may
 		// result in different tenancies. Consider caching per tenancy if this
 		// is deemed expensive.
-		entMeta = v2TenancyToV1EntMeta(resource.Id.Tenancy)
-		authz, authzContext, err = s.getAuthorizer(token, entMeta)
+		authz, authzContext, err = s.getAuthorizer(token, v2TenancyToV1EntMeta(resource.Id.Tenancy))
 		if err != nil {
 			return nil, err
 		}
@@ -76,9 +86,194 @@ func (s *Server) List(ctx context.Context, req *pbresource.ListRequest) (*pbreso
 		case err != nil:
 			return nil, status.Errorf(codes.Internal, "failed read acl: %v", err)
 		}
+
+		if filter != nil {
+			match, err := filter.Matches(resource)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed filter: %v", err)
+			}
+			if !match {
+				continue
+			}
+		}
 		result = append(result, resource)
 	}
-	return &pbresource.ListResponse{Resources: result}, nil
+
+	if req.OrphanedOnly {
+		if result, err = s.filterOrphaned(ctx, consistency, result); err != nil {
+			return nil, err
+		}
+	}
+	if req.CountOnly {
+		return &pbresource.ListResponse{Count: uint64(len(result))}, nil
+	}
+	paged := req.PageSize > 0 || req.PageToken != ""
+	if paged || req.OrderBy != pbresource.ListOrderBy_LIST_ORDER_BY_UNSPECIFIED || req.Descending {
+		sort.SliceStable(result, func(i, j int) bool {
+			return listOrderLess(req, listOrderKey(req.OrderBy, result[i].Id), listOrderKey(req.OrderBy, result[j].Id))
+		})
+	}
+
+	rsp := &pbresource.ListResponse{Resources: result}
+	if paged {
+		if err := paginate(req, rsp); err != nil {
+			return nil, err
+		}
+	}
+	if req.IncludeDeleted {
+		if rsp.Tombstones, err = s.listTombstones(ctx, consistency, token, req); err != nil {
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// paginate reduces the resources in rsp, which are sorted in the order
+// requested by req, to the requested page and populates the pagination fields.
+func paginate(req *pbresource.ListRequest, rsp *pbresource.ListResponse) error {
+	var after string
+	if req.PageToken != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "page_token is invalid: %v", err)
+		}
+		after = string(decoded)
+	}
+
+	resources := rsp.Resources
+	start := 0
+	if req.PageToken != "" {
+		start = sort.Search(len(resources), func(i int) bool {
+			return listOrderLess(req, after, listOrderKey(req.OrderBy, resources[i].Id))
+		})
+	}
+	page := resources[start:]
+	hasMore := req.PageSize > 0 && len(page) > int(req.PageSize)
+	if hasMore {
+		page = page[:req.PageSize]
+		rsp.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(listOrderKey(req.OrderBy, page[len(page)-1].Id)))
+	}
+
+	rsp.Resources = page
+	rsp.Pagination = &pbresource.ListPagination{
+		HasMore:          hasMore,
+		ApproximateTotal: uint64(len(resources)),
+		PageSize:         uint32(len(page)),
+	}
+	return nil
+}
+
+// pageKey returns the key by which List results are ordered by default.
+func pageKey(id *pbresource.ID) string {
+	return strings.Join([]string{id.Tenancy.Partition, id.Tenancy.PeerName, id.Tenancy.Namespace, id.Name}, "\x00")
+}
+
+// listOrderKey returns the key by which List results are sorted in the given
+// order, which is also used as the page token.
+func listOrderKey(orderBy pbresource.ListOrderBy, id *pbresource.ID) string {
+	switch orderBy {
+	case pbresource.ListOrderBy_LIST_ORDER_BY_NAME:
+		return strings.Join([]string{id.Name, id.Tenancy.Partition, id.Tenancy.PeerName, id.Tenancy.Namespace}, "\x00")
+	case pbresource.ListOrderBy_LIST_ORDER_BY_CREATE_INDEX:
+		// Uids are ULIDs, which sort by creation time.
+		return id.Uid + "\x00" + pageKey(id)
+	default:
+		return pageKey(id)
+	}
+}
+
+// listOrderLess reports whether the resource with order key a comes before the
+// one with order key b in the order requested by req.
+func listOrderLess(req *pbresource.ListRequest, a, b string) bool {
+	if req.Descending {
+		return a > b
+	}
+	return a < b
+}
+
+// listTombstones returns the tombstones of deleted resources of the requested
+// type, tenancy and name prefix that the caller would have been allowed to
+// read.
+func (s *Server) listTombstones(ctx context.Context, consistency storage.ReadConsistency, token string, req *pbresource.ListRequest) ([]*pbresource.Resource, error) {
+	reg, err := s.resolveType(req.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tombstone names begin with the name of the deleted resource.
+	tombstones, err := s.Backend.List(
+		ctx,
+		consistency,
+		storage.UnversionedTypeFrom(resource.TypeV1Tombstone),
+		req.Tenancy,
+		tombstoneNamePrefix+req.NamePrefix,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed list: %v", err)
+	}
+
+	result := make([]*pbresource.Resource, 0)
+	for _, tombstone := range tombstones {
+		var data pbresource.Tombstone
+		if err := tombstone.Data.UnmarshalTo(&data); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal tombstone: %v", err)
+		}
+
+		deleted := data.Owner
+		if !resource.EqualType(deleted.Type, req.Type) || !strings.HasPrefix(deleted.Name, req.NamePrefix) {
+			continue
+		}
+
+		// The deleted resource's data is gone, so types whose read ACLs
+		// require it are filtered out.
+		authz, authzContext, err := s.getAuthorizer(token, v2TenancyToV1EntMeta(deleted.Tenancy))
+		if err != nil {
+			return nil, err
+		}
+		err = reg.ACLs.Read(authz, authzContext, deleted, nil)
+		switch {
+		case acl.IsErrPermissionDenied(err), errors.Is(err, resource.ErrNeedResource):
+			continue
+		case err != nil:
+			return nil, status.Errorf(codes.Internal, "failed read acl: %v", err)
+		}
+		result = append(result, tombstone)
+	}
+	return result, nil
+}
+
+// filterOrphaned returns the resources whose owner can't be found. Owners are
+// only read from the backend once, however many resources they own.
+func (s *Server) filterOrphaned(ctx context.Context, consistency storage.ReadConsistency, resources []*pbresource.Resource) ([]*pbresource.Resource, error) {
+	ownerExists := make(map[string]bool)
+
+	result := make([]*pbresource.Resource, 0)
+	for _, res := range resources {
+		if res.Owner == nil {
+			continue
+		}
+
+		key := resource.IDToString(res.Owner)
+		exists, ok := ownerExists[key]
+		if !ok {
+			_, err := s.Backend.Read(ctx, consistency, res.Owner)
+			switch {
+			case err == nil, errors.As(err, &storage.GroupVersionMismatchError{}):
+				// The owner still exists, albeit possibly at a different version.
+				exists = true
+			case errors.Is(err, storage.ErrNotFound):
+				exists = false
+			default:
+				return nil, status.Errorf(codes.Internal, "failed read: %v", err)
+			}
+			ownerExists[key] = exists
+		}
+
+		if !exists {
+			result = append(result, res)
+		}
+	}
+	return result, nil
 }
 
 func (s *Server) ensureListRequestValid(req *pbresource.ListRequest) (*resource.Registration, error) {
@@ -108,14 +303,30 @@ func (s *Server) ensureListRequestValid(req *pbresource.ListRequest) (*resource.
 		return nil, err
 	}
 
-	// Error when partition scoped and namespace not empty.
-	if reg.Scope == resource.ScopePartition && req.Tenancy.Namespace != "" {
-		return nil, status.Errorf(
-			codes.InvalidArgument,
-			"partition scoped type %s cannot have a namespace. got: %s",
-			resource.ToGVK(req.Type),
-			req.Tenancy.Namespace,
-		)
+	if _, ok := pbresource.ListOrderBy_name[int32(req.OrderBy)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "order_by is invalid: %v", req.OrderBy)
+	}
+
+	// Tombstones are listed separately from resources and would be repeated on
+	// every page, so listing them is only supported unpaged.
+	if req.IncludeDeleted && (req.PageSize > 0 || req.PageToken != "") {
+		return nil, status.Error(codes.InvalidArgument, "include_deleted cannot be combined with pagination")
+	}
+
+	if req.CountOnly {
+		switch {
+		case req.PageSize > 0 || req.PageToken != "":
+			return nil, status.Error(codes.InvalidArgument, "count_only cannot be combined with pagination")
+		case req.IncludeDeleted:
+			return nil, status.Error(codes.InvalidArgument, "count_only cannot be combined with include_deleted")
+		}
+	}
+
+	// Wildcards only make sense for the tenancy units the type is scoped to, so
+	// e.g. a wildcard namespace is rejected for partition and cluster scoped
+	// types like any other namespace.
+	if err = validateScopedTenancy(reg.Scope, req.Type, req.Tenancy); err != nil {
+		return nil, err
 	}
 
 	return reg, nil
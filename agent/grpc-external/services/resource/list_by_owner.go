@@ -5,6 +5,8 @@ package resource
 
 import (
 	"context"
+	"encoding/base64"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,19 +22,12 @@ func (s *Server) ListByOwner(ctx context.Context, req *pbresource.ListByOwnerReq
 		return nil, err
 	}
 
-	// Convert v2 request tenancy to v1 for ACL subsystem.
-	entMeta := v2TenancyToV1EntMeta(req.Owner.Tenancy)
 	token := tokenFromContext(ctx)
-
-	// Fill entMeta with token tenancy when empty.
-	authz, authzContext, err := s.getAuthorizer(token, entMeta)
+	authz, authzContext, err := s.resolveTenancy(token, reg, req.Owner.Tenancy)
 	if err != nil {
 		return nil, err
 	}
 
-	// Handle defaulting empty tenancy units from request.
-	v1EntMetaToV2Tenancy(reg, entMeta, req.Owner.Tenancy)
-
 	// Check list ACL before verifying tenancy exists to not leak tenancy existence.
 	err = reg.ACLs.List(authz, authzContext)
 	switch {
@@ -47,18 +42,12 @@ func (s *Server) ListByOwner(ctx context.Context, req *pbresource.ListByOwnerReq
 		return nil, err
 	}
 
-	// Get owned resources.
-	children, err := s.Backend.ListByOwner(ctx, req.Owner)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed list by owner: %v", err)
-	}
-
-	result := make([]*pbresource.Resource, 0)
-	for _, child := range children {
+	// Filter out children that fail real ACL.
+	readable := func(child *pbresource.Resource) (bool, error) {
 		// Retrieve child type's registration to access read ACL hook.
 		childReg, err := s.resolveType(child.Id.Type)
 		if err != nil {
-			return nil, err
+			return false, err
 		}
 
 		// Rebuild authorizer if tenancy not identical between owner and child (child scope
@@ -69,24 +58,132 @@ func (s *Server) ListByOwner(ctx context.Context, req *pbresource.ListByOwnerReq
 			childEntMeta := v2TenancyToV1EntMeta(child.Id.Tenancy)
 			childAuthz, childAuthzContext, err = s.getAuthorizer(token, childEntMeta)
 			if err != nil {
-				return nil, err
+				return false, err
 			}
 		}
 
-		// Filter out children that fail real ACL.
 		err = childReg.ACLs.Read(childAuthz, childAuthzContext, child.Id, child)
 		switch {
 		case acl.IsErrPermissionDenied(err):
-			continue
+			return false, nil
 		case err != nil:
-			return nil, status.Errorf(codes.Internal, "failed read acl: %v", err)
+			return false, status.Errorf(codes.Internal, "failed read acl: %v", err)
 		}
+		return true, nil
+	}
+
+	if req.PageSize > 0 || req.PageToken != "" {
+		return s.listOwnedPage(ctx, req, readable)
+	}
+
+	// Get owned resources.
+	children, err := s.Backend.ListByOwner(ctx, req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed list by owner: %v", err)
+	}
 
-		result = append(result, child)
+	result := make([]*pbresource.Resource, 0)
+	for _, child := range children {
+		ok, err := readable(child)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, child)
+		}
 	}
 	return &pbresource.ListByOwnerResponse{Resources: result}, nil
 }
 
+// listOwnedPage returns the page of the owned resources requested by req, in
+// order of type, tenancy and name. The page is read from the backend starting
+// at the page token, so only the resources on the page (and any that aren't
+// readable among them) are listed and authorized. Page tokens are prefixed
+// with the owner's ID so that they can't be used to page through another
+// owner's resources.
+func (s *Server) listOwnedPage(ctx context.Context, req *pbresource.ListByOwnerRequest, readable func(*pbresource.Resource) (bool, error)) (*pbresource.ListByOwnerResponse, error) {
+	owner := resource.IDToString(req.Owner)
+
+	var after *pbresource.ID
+	if req.PageToken != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page_token is invalid: %v", err)
+		}
+		tokenOwner, key, ok := strings.Cut(string(decoded), "\x00")
+		if !ok || tokenOwner != owner {
+			return nil, status.Errorf(codes.InvalidArgument, "page_token is not valid for owner %s", owner)
+		}
+		if after, ok = ownedPageCursor(key); !ok {
+			return nil, status.Error(codes.InvalidArgument, "page_token is invalid")
+		}
+	}
+
+	// One more resource than the page holds is read, to know whether there's
+	// another page.
+	var limit int
+	if req.PageSize > 0 {
+		limit = int(req.PageSize) + 1
+	}
+
+	page := make([]*pbresource.Resource, 0, limit)
+	for {
+		children, err := s.Backend.ListByOwnerPage(ctx, req.Owner, after, limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed list by owner: %v", err)
+		}
+
+		for _, child := range children {
+			ok, err := readable(child)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			if req.PageSize > 0 && len(page) == int(req.PageSize) {
+				next := owner + "\x00" + ownedPageKey(page[len(page)-1].Id)
+				return &pbresource.ListByOwnerResponse{
+					Resources:     page,
+					NextPageToken: base64.RawURLEncoding.EncodeToString([]byte(next)),
+				}, nil
+			}
+			page = append(page, child)
+		}
+
+		if limit == 0 || len(children) < limit {
+			return &pbresource.ListByOwnerResponse{Resources: page}, nil
+		}
+		after = children[len(children)-1].Id
+	}
+}
+
+// ownedPageKey returns the key by which ListByOwner results are ordered when
+// paging, which is also that of the storage backend. Owned resources may be of
+// several types, so the type is included.
+func ownedPageKey(id *pbresource.ID) string {
+	return strings.Join([]string{id.Type.Group, id.Type.Kind, pageKey(id)}, "\x00")
+}
+
+// ownedPageCursor returns the ID from which the storage backend lists the owned
+// resources after the one with the given ownedPageKey.
+func ownedPageCursor(key string) (*pbresource.ID, bool) {
+	parts := strings.Split(key, "\x00")
+	if len(parts) != 6 {
+		return nil, false
+	}
+	return &pbresource.ID{
+		Type: &pbresource.Type{Group: parts[0], Kind: parts[1]},
+		Tenancy: &pbresource.Tenancy{
+			Partition: parts[2],
+			PeerName:  parts[3],
+			Namespace: parts[4],
+		},
+		Name: parts[5],
+	}, true
+}
+
 func (s *Server) ensureListByOwnerRequestValid(req *pbresource.ListByOwnerRequest) (*resource.Registration, error) {
 	if req.Owner == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "owner is required")
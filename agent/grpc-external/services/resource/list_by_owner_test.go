@@ -5,13 +5,17 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/internal/catalog"
 	"github.com/hashicorp/consul/internal/resource"
 	"github.com/hashicorp/consul/internal/resource/demo"
+	rtest "github.com/hashicorp/consul/internal/resource/resourcetest"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
 	"github.com/hashicorp/consul/proto-public/pbresource"
 	"github.com/hashicorp/consul/proto/private/prototest"
 	"github.com/oklog/ulid/v2"
@@ -195,6 +199,133 @@ func TestListByOwner_Many(t *testing.T) {
 	prototest.AssertElementsMatch(t, albums, rsp3.Resources)
 }
 
+func TestListByOwner_Paging(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	writeNode := func(name string) *pbresource.Resource {
+		return rtest.Resource(pbcatalog.NodeType, name).
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+			Write(t, client)
+	}
+	node := writeNode("node-1")
+	other := writeNode("node-2")
+
+	checks := make(map[string]struct{})
+	for i := 0; i < 50; i++ {
+		check := rtest.Resource(pbcatalog.HealthStatusType, fmt.Sprintf("check-%d", i)).
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_PASSING}).
+			WithOwner(node.Id).
+			Write(t, client)
+		checks[check.Id.Name] = struct{}{}
+	}
+
+	var (
+		listed    = make(map[string]int)
+		pageToken string
+		pages     int
+	)
+	for {
+		rsp, err := client.ListByOwner(ctx, &pbresource.ListByOwnerRequest{
+			Owner:     node.Id,
+			PageSize:  7,
+			PageToken: pageToken,
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(rsp.Resources), 7)
+
+		for _, res := range rsp.Resources {
+			listed[res.Id.Name]++
+		}
+		pages++
+		if rsp.NextPageToken == "" {
+			break
+		}
+		pageToken = rsp.NextPageToken
+
+		// Tokens can't be replayed against another owner.
+		_, err = client.ListByOwner(ctx, &pbresource.ListByOwnerRequest{
+			Owner:     other.Id,
+			PageSize:  7,
+			PageToken: pageToken,
+		})
+		require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	}
+	require.Equal(t, 8, pages)
+	require.Len(t, listed, len(checks))
+	for name, count := range listed {
+		require.Contains(t, checks, name)
+		require.Equal(t, 1, count, "listed %s more than once", name)
+	}
+
+	_, err := client.ListByOwner(ctx, &pbresource.ListByOwnerRequest{
+		Owner:     node.Id,
+		PageToken: "not a token!",
+	})
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+}
+
+func TestListByOwner_PagingSeeks(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	node := rtest.Resource(pbcatalog.NodeType, "node-1").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+		Write(t, client)
+	for i := 0; i < 50; i++ {
+		rtest.Resource(pbcatalog.HealthStatusType, fmt.Sprintf("check-%02d", i)).
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_PASSING}).
+			WithOwner(node.Id).
+			Write(t, client)
+	}
+
+	backend := &pageCountingBackend{Backend: server.Backend}
+	server.Backend = backend
+
+	var pageToken string
+	for {
+		backend.listed = 0
+		rsp, err := client.ListByOwner(ctx, &pbresource.ListByOwnerRequest{
+			Owner:     node.Id,
+			PageSize:  7,
+			PageToken: pageToken,
+		})
+		require.NoError(t, err)
+
+		// Only the page, and one more resource to know whether there's another
+		// page, is read from the backend.
+		require.LessOrEqual(t, backend.listed, 8)
+		if rsp.NextPageToken == "" {
+			break
+		}
+		pageToken = rsp.NextPageToken
+	}
+}
+
+type pageCountingBackend struct {
+	Backend
+
+	listed int
+}
+
+func (b *pageCountingBackend) ListByOwner(context.Context, *pbresource.ID) ([]*pbresource.Resource, error) {
+	return nil, errors.New("ListByOwner should not be called when paging")
+}
+
+func (b *pageCountingBackend) ListByOwnerPage(ctx context.Context, id, after *pbresource.ID, limit int) ([]*pbresource.Resource, error) {
+	res, err := b.Backend.ListByOwnerPage(ctx, id, after, limit)
+	b.listed += len(res)
+	return res, err
+}
+
 func TestListByOwner_OwnerTenancyDoesNotExist(t *testing.T) {
 	type testCase struct {
 		modFn       func(artistId, recordlabelId *pbresource.ID) *pbresource.ID
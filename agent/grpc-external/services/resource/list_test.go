@@ -12,9 +12,12 @@ import (
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/grpc-external/testutils"
+	"github.com/hashicorp/consul/internal/catalog"
 	"github.com/hashicorp/consul/internal/resource"
 	"github.com/hashicorp/consul/internal/resource/demo"
+	rtest "github.com/hashicorp/consul/internal/resource/resourcetest"
 	"github.com/hashicorp/consul/internal/storage"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
 	"github.com/hashicorp/consul/proto-public/pbresource"
 	"github.com/hashicorp/consul/proto/private/prototest"
 
@@ -75,6 +78,29 @@ func TestList_InputValidation(t *testing.T) {
 			},
 			errContains: "cannot have a namespace",
 		},
+		"partitioned resource provides wildcard namespace": {
+			modReqFn: func(req *pbresource.ListRequest) {
+				req.Type = demo.TypeV1RecordLabel
+				req.Tenancy.Namespace = storage.Wildcard
+			},
+			errContains: "cannot have a namespace",
+		},
+		"cluster scoped resource provides wildcard namespace": {
+			modReqFn: func(req *pbresource.ListRequest) {
+				req.Type = demo.TypeV1Executive
+				req.Tenancy.Partition = ""
+				req.Tenancy.Namespace = storage.Wildcard
+			},
+			errContains: "cannot have a namespace",
+		},
+		"cluster scoped resource provides wildcard partition": {
+			modReqFn: func(req *pbresource.ListRequest) {
+				req.Type = demo.TypeV1Executive
+				req.Tenancy.Partition = storage.Wildcard
+				req.Tenancy.Namespace = ""
+			},
+			errContains: "cannot have a partition",
+		},
 	}
 	for desc, tc := range testCases {
 		t.Run(desc, func(t *testing.T) {
@@ -156,6 +182,152 @@ func TestList_Many(t *testing.T) {
 	}
 }
 
+func TestList_Pagination(t *testing.T) {
+	server := testServer(t)
+	demo.RegisterTypes(server.Registry)
+	client := testClient(t, server)
+	ctx := testContext(t)
+
+	resources := make([]*pbresource.Resource, 5)
+	for i := 0; i < len(resources); i++ {
+		artist, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		artist.Id.Name = fmt.Sprintf("artist-%d", i)
+
+		rsp, err := client.Write(ctx, &pbresource.WriteRequest{Resource: artist})
+		require.NoError(t, err)
+		resources[i] = rsp.Resource
+	}
+
+	// Without paging there is no pagination metadata.
+	rsp, err := client.List(ctx, &pbresource.ListRequest{
+		Type:    demo.TypeV2Artist,
+		Tenancy: resource.DefaultNamespacedTenancy(),
+	})
+	require.NoError(t, err)
+	require.Nil(t, rsp.Pagination)
+	require.Empty(t, rsp.NextPageToken)
+
+	var (
+		listed    []*pbresource.Resource
+		pageToken string
+		hasMore   []bool
+	)
+	for {
+		rsp, err := client.List(ctx, &pbresource.ListRequest{
+			Type:      demo.TypeV2Artist,
+			Tenancy:   resource.DefaultNamespacedTenancy(),
+			PageSize:  2,
+			PageToken: pageToken,
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(resources)), rsp.Pagination.ApproximateTotal)
+		require.Equal(t, uint32(len(rsp.Resources)), rsp.Pagination.PageSize)
+		require.Equal(t, rsp.Pagination.HasMore, rsp.NextPageToken != "")
+
+		listed = append(listed, rsp.Resources...)
+		hasMore = append(hasMore, rsp.Pagination.HasMore)
+		if !rsp.Pagination.HasMore {
+			break
+		}
+		pageToken = rsp.NextPageToken
+	}
+	require.Equal(t, []bool{true, true, false}, hasMore)
+	prototest.AssertDeepEqual(t, resources, listed)
+
+	_, err = client.List(ctx, &pbresource.ListRequest{
+		Type:      demo.TypeV2Artist,
+		Tenancy:   resource.DefaultNamespacedTenancy(),
+		PageToken: "not a token!",
+	})
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+}
+
+func TestList_OrderBy(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	// Created in an order that differs from that of their names.
+	var created []*pbresource.Resource
+	for _, name := range []string{"cher", "abba", "elvis", "bono", "dido"} {
+		artist, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		artist.Id.Name = name
+
+		rsp, err := client.Write(ctx, &pbresource.WriteRequest{Resource: artist})
+		require.NoError(t, err)
+		created = append(created, rsp.Resource)
+	}
+
+	listNames := func(t *testing.T, orderBy pbresource.ListOrderBy, descending bool) []string {
+		var (
+			names     []string
+			pageToken string
+		)
+		for {
+			rsp, err := client.List(ctx, &pbresource.ListRequest{
+				Type:       demo.TypeV2Artist,
+				Tenancy:    resource.DefaultNamespacedTenancy(),
+				OrderBy:    orderBy,
+				Descending: descending,
+				PageSize:   2,
+				PageToken:  pageToken,
+			})
+			require.NoError(t, err)
+			for _, res := range rsp.Resources {
+				names = append(names, res.Id.Name)
+			}
+			if rsp.NextPageToken == "" {
+				return names
+			}
+			pageToken = rsp.NextPageToken
+		}
+	}
+
+	t.Run("name ascending", func(t *testing.T) {
+		require.Equal(t, []string{"abba", "bono", "cher", "dido", "elvis"}, listNames(t, pbresource.ListOrderBy_LIST_ORDER_BY_NAME, false))
+	})
+
+	t.Run("name descending", func(t *testing.T) {
+		require.Equal(t, []string{"elvis", "dido", "cher", "bono", "abba"}, listNames(t, pbresource.ListOrderBy_LIST_ORDER_BY_NAME, true))
+	})
+
+	t.Run("create index ascending", func(t *testing.T) {
+		require.Equal(t, []string{"cher", "abba", "elvis", "bono", "dido"}, listNames(t, pbresource.ListOrderBy_LIST_ORDER_BY_CREATE_INDEX, false))
+	})
+
+	t.Run("create index descending", func(t *testing.T) {
+		require.Equal(t, []string{"dido", "bono", "elvis", "abba", "cher"}, listNames(t, pbresource.ListOrderBy_LIST_ORDER_BY_CREATE_INDEX, true))
+	})
+
+	t.Run("unpaged", func(t *testing.T) {
+		rsp, err := client.List(ctx, &pbresource.ListRequest{
+			Type:       demo.TypeV2Artist,
+			Tenancy:    resource.DefaultNamespacedTenancy(),
+			OrderBy:    pbresource.ListOrderBy_LIST_ORDER_BY_CREATE_INDEX,
+			Descending: true,
+		})
+		require.NoError(t, err)
+		require.Nil(t, rsp.Pagination)
+		require.Len(t, rsp.Resources, len(created))
+		for i, res := range rsp.Resources {
+			prototest.AssertDeepEqual(t, created[len(created)-1-i], res)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := client.List(ctx, &pbresource.ListRequest{
+			Type:    demo.TypeV2Artist,
+			Tenancy: resource.DefaultNamespacedTenancy(),
+			OrderBy: pbresource.ListOrderBy(42),
+		})
+		require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+		require.ErrorContains(t, err, "order_by is invalid")
+	})
+}
+
 func TestList_NamePrefix(t *testing.T) {
 	for desc, tc := range listTestCases() {
 		t.Run(desc, func(t *testing.T) {
@@ -257,6 +429,304 @@ func TestList_GroupVersionMismatch(t *testing.T) {
 	}
 }
 
+func TestList_OrphanedOnly(t *testing.T) {
+	server := testServer(t)
+	catalog.RegisterTypes(server.Registry)
+	client := testClient(t, server)
+	ctx := testContext(t)
+
+	writeNode := func(name string) *pbresource.Resource {
+		return rtest.Resource(pbcatalog.NodeType, name).
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+			Write(t, client)
+	}
+	writeStatus := func(name string, owner *pbresource.ID) *pbresource.Resource {
+		return rtest.Resource(pbcatalog.HealthStatusType, name).
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_PASSING}).
+			WithOwner(owner).
+			Write(t, client)
+	}
+
+	doomed := writeNode("doomed")
+	survivor := writeNode("survivor")
+	orphans := []*pbresource.Resource{
+		writeStatus("doomed-1", doomed.Id),
+		writeStatus("doomed-2", doomed.Id),
+	}
+	writeStatus("survivor-1", survivor.Id)
+
+	req := &pbresource.ListRequest{
+		Type:         pbcatalog.HealthStatusType,
+		Tenancy:      resource.DefaultNamespacedTenancy(),
+		OrphanedOnly: true,
+	}
+
+	// Nothing is orphaned while both nodes exist.
+	rsp, err := client.List(ctx, req)
+	require.NoError(t, err)
+	require.Empty(t, rsp.Resources)
+
+	_, err = client.Delete(ctx, &pbresource.DeleteRequest{Id: doomed.Id})
+	require.NoError(t, err)
+
+	rsp, err = client.List(ctx, req)
+	require.NoError(t, err)
+	prototest.AssertElementsMatch(t, orphans, rsp.Resources)
+}
+
+func TestList_Filter(t *testing.T) {
+	server := testServer(t)
+	catalog.RegisterTypes(server.Registry)
+	client := testClient(t, server)
+	ctx := testContext(t)
+
+	writeNode := func(name, region, host string) *pbresource.Resource {
+		return rtest.Resource(pbcatalog.NodeType, name).
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithMeta("region", region).
+			WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: host}}}).
+			Write(t, client)
+	}
+	east1 := writeNode("east-1", "us-east", "198.18.0.1")
+	east2 := writeNode("east-2", "us-east", "198.18.0.2")
+	writeNode("west-1", "us-west", "198.18.0.3")
+
+	list := func(filter string) (*pbresource.ListResponse, error) {
+		return client.List(ctx, &pbresource.ListRequest{
+			Type:    pbcatalog.NodeType,
+			Tenancy: resource.DefaultNamespacedTenancy(),
+			Filter:  filter,
+		})
+	}
+
+	t.Run("metadata", func(t *testing.T) {
+		rsp, err := list(`metadata.region == "us-east"`)
+		require.NoError(t, err)
+		prototest.AssertElementsMatch(t, []*pbresource.Resource{east1, east2}, rsp.Resources)
+	})
+
+	t.Run("data", func(t *testing.T) {
+		rsp, err := list(`"198.18.0.2" in data.Addresses.Host`)
+		require.NoError(t, err)
+		prototest.AssertElementsMatch(t, []*pbresource.Resource{east2}, rsp.Resources)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := list(`metadata.region ==`)
+		require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := list(`data.Rack == "R1"`)
+		require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	})
+}
+
+func TestList_CountOnly(t *testing.T) {
+	server := testServer(t)
+	catalog.RegisterTypes(server.Registry)
+	client := testClient(t, server)
+	ctx := testContext(t)
+
+	for i, region := range []string{"us-east", "us-east", "us-east", "us-west"} {
+		rtest.Resource(pbcatalog.NodeType, fmt.Sprintf("node-%d", i)).
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithMeta("region", region).
+			WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+			Write(t, client)
+	}
+
+	count := func(req *pbresource.ListRequest) (*pbresource.ListResponse, error) {
+		req.Type = pbcatalog.NodeType
+		req.Tenancy = resource.DefaultNamespacedTenancy()
+		req.CountOnly = true
+		return client.List(ctx, req)
+	}
+
+	t.Run("all", func(t *testing.T) {
+		rsp, err := count(&pbresource.ListRequest{})
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), rsp.Count)
+		require.Empty(t, rsp.Resources)
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		rsp, err := count(&pbresource.ListRequest{Filter: `metadata.region == "us-east"`})
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), rsp.Count)
+	})
+
+	t.Run("name prefix", func(t *testing.T) {
+		rsp, err := count(&pbresource.ListRequest{NamePrefix: "node-3"})
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), rsp.Count)
+	})
+
+	t.Run("other tenancy", func(t *testing.T) {
+		rsp, err := client.List(ctx, &pbresource.ListRequest{
+			Type:      pbcatalog.NodeType,
+			Tenancy:   &pbresource.Tenancy{Partition: "default", Namespace: "other"},
+			CountOnly: true,
+		})
+		require.NoError(t, err)
+		require.Zero(t, rsp.Count)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		_, err := count(&pbresource.ListRequest{PageSize: 2})
+		require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+		require.ErrorContains(t, err, "count_only cannot be combined with pagination")
+	})
+
+	t.Run("include deleted", func(t *testing.T) {
+		_, err := count(&pbresource.ListRequest{IncludeDeleted: true})
+		require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+		require.ErrorContains(t, err, "count_only cannot be combined with include_deleted")
+	})
+}
+
+func TestList_CountOnly_ACLs(t *testing.T) {
+	server := testServer(t)
+	demo.RegisterTypes(server.Registry)
+	client := testClient(t, server)
+	ctx := testContext(t)
+
+	for i := 0; i < 2; i++ {
+		artist, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		_, err = server.Backend.WriteCAS(ctx, artist)
+		require.NoError(t, err)
+	}
+
+	count := func(t *testing.T, authz acl.Authorizer) uint64 {
+		mockACLResolver := &MockACLResolver{}
+		mockACLResolver.On("ResolveTokenAndDefaultMeta", mock.Anything, mock.Anything, mock.Anything).
+			Return(authz, nil)
+		server.ACLResolver = mockACLResolver
+
+		rsp, err := client.List(ctx, &pbresource.ListRequest{
+			Type:      demo.TypeV2Artist,
+			Tenancy:   resource.DefaultNamespacedTenancy(),
+			CountOnly: true,
+		})
+		require.NoError(t, err)
+		return rsp.Count
+	}
+
+	// Resources the caller can't read aren't counted.
+	require.Equal(t, uint64(2), count(t, AuthorizerFrom(t, demo.ArtistV2ListPolicy, demo.ArtistV2ReadPolicy)))
+	require.Zero(t, count(t, AuthorizerFrom(t, demo.ArtistV2ListPolicy,
+		`key_prefix "resource/demo.v2.Artist/" { policy = "deny" }`)))
+}
+
+func TestList_WildcardNamespace(t *testing.T) {
+	server := testServer(t)
+	catalog.RegisterTypes(server.Registry)
+	client := testClient(t, server)
+	ctx := testContext(t)
+
+	// The test tenancy bridge only knows about the default namespace, so write
+	// directly to the backend.
+	writeHealthStatus := func(namespace string) *pbresource.Resource {
+		tenancy := &pbresource.Tenancy{Partition: "default", PeerName: "local", Namespace: namespace}
+		res := rtest.Resource(pbcatalog.HealthStatusType, "check-"+namespace).
+			WithTenancy(tenancy).
+			WithOwner(rtest.Resource(pbcatalog.NodeType, "node-"+namespace).WithTenancy(tenancy).ID()).
+			WithData(t, &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_PASSING}).
+			Build()
+		res, err := server.Backend.WriteCAS(ctx, res)
+		require.NoError(t, err)
+		return res
+	}
+	inDefault := writeHealthStatus("default")
+	inOther := writeHealthStatus("other")
+
+	rsp, err := client.List(ctx, &pbresource.ListRequest{
+		Type:    pbcatalog.HealthStatusType,
+		Tenancy: &pbresource.Tenancy{Partition: "default", Namespace: storage.Wildcard},
+	})
+	require.NoError(t, err)
+
+	// Each resource carries its own tenancy.
+	prototest.AssertElementsMatch(t, []*pbresource.Resource{inDefault, inOther}, rsp.Resources)
+	for _, res := range rsp.Resources {
+		require.NotEqual(t, storage.Wildcard, res.Id.Tenancy.Namespace)
+	}
+
+	// Listing a single namespace only returns its resources.
+	rsp, err = client.List(ctx, &pbresource.ListRequest{
+		Type:    pbcatalog.HealthStatusType,
+		Tenancy: &pbresource.Tenancy{Partition: "default", Namespace: "other"},
+	})
+	require.NoError(t, err)
+	prototest.AssertElementsMatch(t, []*pbresource.Resource{inOther}, rsp.Resources)
+}
+
+func TestList_IncludeDeleted(t *testing.T) {
+	server := testServer(t)
+	catalog.RegisterTypes(server.Registry)
+	client := testClient(t, server)
+	ctx := testContext(t)
+
+	writeNode := func(name string) *pbresource.Resource {
+		return rtest.Resource(pbcatalog.NodeType, name).
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+			Write(t, client)
+	}
+	deleted := writeNode("deleted")
+	live := writeNode("live")
+
+	_, err := client.Delete(ctx, &pbresource.DeleteRequest{Id: deleted.Id})
+	require.NoError(t, err)
+
+	// A normal List omits the deleted node.
+	req := &pbresource.ListRequest{
+		Type:    pbcatalog.NodeType,
+		Tenancy: resource.DefaultNamespacedTenancy(),
+	}
+	rsp, err := client.List(ctx, req)
+	require.NoError(t, err)
+	prototest.AssertElementsMatch(t, []*pbresource.Resource{live}, rsp.Resources)
+	require.Empty(t, rsp.Tombstones)
+
+	// An include-deleted List also returns its tombstone.
+	req.IncludeDeleted = true
+	rsp, err = client.List(ctx, req)
+	require.NoError(t, err)
+	prototest.AssertElementsMatch(t, []*pbresource.Resource{live}, rsp.Resources)
+	require.Len(t, rsp.Tombstones, 1)
+	var tombstone pbresource.Tombstone
+	require.NoError(t, rsp.Tombstones[0].Data.UnmarshalTo(&tombstone))
+	prototest.AssertDeepEqual(t, deleted.Id, tombstone.Owner)
+
+	// Tombstones are filtered by name prefix and type.
+	req.NamePrefix = "live"
+	rsp, err = client.List(ctx, req)
+	require.NoError(t, err)
+	require.Empty(t, rsp.Tombstones)
+
+	rsp, err = client.List(ctx, &pbresource.ListRequest{
+		Type:           pbcatalog.HealthStatusType,
+		Tenancy:        resource.DefaultNamespacedTenancy(),
+		IncludeDeleted: true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, rsp.Tombstones)
+
+	// Tombstones aren't paged, so include-deleted Lists can't be either.
+	for _, paged := range []*pbresource.ListRequest{{PageSize: 1}, {PageToken: "bGl2ZQ"}} {
+		paged.Type = pbcatalog.NodeType
+		paged.Tenancy = resource.DefaultNamespacedTenancy()
+		paged.IncludeDeleted = true
+		_, err = client.List(ctx, paged)
+		require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+		require.ErrorContains(t, err, "include_deleted cannot be combined with pagination")
+	}
+}
+
 func TestList_VerifyReadConsistencyArg(t *testing.T) {
 	// Uses a mockBackend instead of the inmem Backend to verify the ReadConsistency argument is set correctly.
 	for desc, tc := range listTestCases() {
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// scopes maps a registration's scope to its API representation.
+var scopes = map[resource.Scope]pbresource.Scope{
+	resource.ScopeUndefined: pbresource.Scope_SCOPE_UNDEFINED,
+	resource.ScopeCluster:   pbresource.Scope_SCOPE_CLUSTER,
+	resource.ScopePartition: pbresource.Scope_SCOPE_PARTITION,
+	resource.ScopeNamespace: pbresource.Scope_SCOPE_NAMESPACE,
+}
+
+// ListTypes returns the registered resource types, sorted by GVK, so tooling
+// can discover which kinds the server supports. It requires operator:read.
+func (s *Server) ListTypes(ctx context.Context, _ *pbresource.ListTypesRequest) (*pbresource.ListTypesResponse, error) {
+	authz, authzContext, err := s.getAuthorizer(tokenFromContext(ctx), acl.DefaultEnterpriseMeta())
+	if err != nil {
+		return nil, err
+	}
+
+	err = authz.ToAllowAuthorizer().OperatorReadAllowed(authzContext)
+	switch {
+	case acl.IsErrPermissionDenied(err):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed operator acl: %v", err)
+	}
+
+	regs := s.Registry.Types()
+	types := make([]*pbresource.RegisteredType, 0, len(regs))
+	for _, reg := range regs {
+		types = append(types, &pbresource.RegisteredType{
+			Type:        reg.Type,
+			Scope:       scopes[reg.Scope],
+			HasAclHooks: reg.HasACLHooks(),
+		})
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return resource.ToGVK(types[i].Type) < resource.ToGVK(types[j].Type)
+	})
+	return &pbresource.ListTypesResponse{Types: types}, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/agent/grpc-external/testutils"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/resource/demo"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/prototest"
+)
+
+func TestListTypes_ACLs(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	dr := &dummyACLResolver{result: AuthorizerFrom(t, demo.ArtistV2ReadPolicy)}
+	server.ACLResolver = dr
+
+	_, err := client.ListTypes(testContext(t), &pbresource.ListTypesRequest{})
+	require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+
+	dr.SetResult(testutils.ACLOperatorRead(t))
+	_, err = client.ListTypes(testContext(t), &pbresource.ListTypesRequest{})
+	require.NoError(t, err)
+}
+
+func TestListTypes_Success(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	// Registered without ACL hooks of its own.
+	noACLsType := &pbresource.Type{Group: "demo", GroupVersion: "v2", Kind: "NoACLs"}
+	server.Registry.Register(resource.Registration{
+		Type:  noACLsType,
+		Proto: &pbresource.Resource{},
+		Scope: resource.ScopePartition,
+	})
+
+	rsp, err := client.ListTypes(testContext(t), &pbresource.ListTypesRequest{})
+	require.NoError(t, err)
+
+	require.True(t, sort.SliceIsSorted(rsp.Types, func(i, j int) bool {
+		return resource.ToGVK(rsp.Types[i].Type) < resource.ToGVK(rsp.Types[j].Type)
+	}))
+
+	byGVK := make(map[string]*pbresource.RegisteredType, len(rsp.Types))
+	for _, typ := range rsp.Types {
+		byGVK[resource.ToGVK(typ.Type)] = typ
+	}
+	require.Len(t, byGVK, len(server.Registry.Types()))
+
+	expected := []*pbresource.RegisteredType{
+		{Type: demo.TypeV1Executive, Scope: pbresource.Scope_SCOPE_CLUSTER, HasAclHooks: true},
+		{Type: demo.TypeV1RecordLabel, Scope: pbresource.Scope_SCOPE_PARTITION, HasAclHooks: true},
+		{Type: demo.TypeV2Artist, Scope: pbresource.Scope_SCOPE_NAMESPACE, HasAclHooks: true},
+		{Type: noACLsType, Scope: pbresource.Scope_SCOPE_PARTITION},
+	}
+	for _, want := range expected {
+		got, ok := byGVK[resource.ToGVK(want.Type)]
+		require.True(t, ok, "type %s not listed", resource.ToGVK(want.Type))
+		prototest.AssertDeepEqual(t, want, got)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/armon/go-metrics/prometheus"
+
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/storage"
+	"github.com/hashicorp/consul/internal/telemetry"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+var (
+	metricReadResolveType   = []string{"resource", "read", "resolve_type"}
+	metricReadAuthorize     = []string{"resource", "read", "authorize"}
+	metricReadTenancyExists = []string{"resource", "read", "tenancy_exists"}
+
+	metricResourceCount = []string{"resource", "count"}
+)
+
+// DefaultCountMetricsInterval is how often RunCountMetrics emits the resource
+// count gauges when no interval is given.
+const DefaultCountMetricsInterval = 30 * time.Second
+
+var CountGauges = []prometheus.GaugeDefinition{
+	{
+		Name: metricResourceCount,
+		Help: "Tracks the number of resources of each type in each partition and namespace.",
+	},
+}
+
+var ReadSummaries = []prometheus.SummaryDefinition{
+	{
+		Name: metricReadResolveType,
+		Help: "Measures the time it takes to resolve the type of a resource being read in the type registry.",
+	},
+	{
+		Name: metricReadAuthorize,
+		Help: "Measures the time it takes to resolve the authorizer for a resource being read.",
+	},
+	{
+		Name: metricReadTenancyExists,
+		Help: "Measures the time it takes the tenancy bridge to check that the tenancy of a resource being read exists.",
+	},
+}
+
+// measurePhase records the time since start in the given phase histogram,
+// labeled with the type of the resource.
+func (s *Server) measurePhase(key []string, typ *pbresource.Type, start time.Time) {
+	telemetry.OrDefault(s.Metrics).MeasureSince(key, start, []metrics.Label{
+		{Name: "type", Value: resource.ToGVK(typ)},
+	})
+}
+
+// RunCountMetrics periodically emits the number of resources of each type in
+// each partition and namespace, until ctx is canceled. Resources are counted
+// from the local storage backend using eventually consistent lists, bypassing
+// ACL checks, so that it is cheap enough to run on an interval.
+func (s *Server) RunCountMetrics(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultCountMetricsInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var emitted map[countKey]struct{}
+	for {
+		emitted = s.emitCountMetrics(ctx, emitted)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// countKey identifies one of the resource count gauges.
+type countKey struct {
+	gvk       string
+	partition string
+	namespace string
+}
+
+// emitCountMetrics sets the resource count gauges and returns the ones it set.
+// Gauges that were previously emitted but have no resources anymore are set to
+// zero, so that they don't keep reporting stale counts.
+func (s *Server) emitCountMetrics(ctx context.Context, previous map[countKey]struct{}) map[countKey]struct{} {
+	wildcard := &pbresource.Tenancy{
+		Partition: storage.Wildcard,
+		PeerName:  storage.Wildcard,
+		Namespace: storage.Wildcard,
+	}
+
+	counts := make(map[countKey]int)
+	for _, reg := range s.Registry.Types() {
+		if resource.EqualType(reg.Type, resource.TypeV1Tombstone) {
+			continue
+		}
+
+		resources, err := s.Backend.List(ctx, storage.EventualConsistency, storage.UnversionedTypeFrom(reg.Type), wildcard, "")
+		if err != nil {
+			s.Logger.Warn("failed to count resources", "type", resource.ToGVK(reg.Type), "error", err)
+			continue
+		}
+		for _, res := range resources {
+			// Filter out non-matching GroupVersion.
+			if res.Id.Type.GroupVersion != reg.Type.GroupVersion {
+				continue
+			}
+			counts[countKey{
+				gvk:       resource.ToGVK(reg.Type),
+				partition: res.Id.Tenancy.Partition,
+				namespace: res.Id.Tenancy.Namespace,
+			}]++
+		}
+	}
+
+	sink := telemetry.OrDefault(s.Metrics)
+	emitted := make(map[countKey]struct{}, len(counts))
+	for key, count := range counts {
+		sink.SetGauge(metricResourceCount, float32(count), key.labels())
+		emitted[key] = struct{}{}
+	}
+	for key := range previous {
+		if _, ok := emitted[key]; !ok {
+			sink.SetGauge(metricResourceCount, 0, key.labels())
+		}
+	}
+	return emitted
+}
+
+func (k countKey) labels() []metrics.Label {
+	return []metrics.Label{
+		{Name: "type", Value: k.gvk},
+		{Name: "partition", Value: k.partition},
+		{Name: "namespace", Value: k.namespace},
+	}
+}
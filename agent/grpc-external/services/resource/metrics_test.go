@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/resource/demo"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+func TestServer_CountMetrics(t *testing.T) {
+	sink := &gaugeSink{gauges: make(map[string]float32)}
+	server := testServer(t)
+	server.Metrics = sink
+	demo.RegisterTypes(server.Registry)
+
+	write := func(res *pbresource.Resource) *pbresource.Resource {
+		written, err := server.Backend.WriteCAS(testContext(t), res)
+		require.NoError(t, err)
+		return written
+	}
+
+	artist1, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	artist1 = write(artist1)
+
+	artist2, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	artist2.Id.Tenancy.Namespace = "other"
+	write(artist2)
+
+	album, err := demo.GenerateV2Album(artist1.Id)
+	require.NoError(t, err)
+	album = write(album)
+
+	artistKey := func(namespace string) string {
+		return gaugeSinkKey("resource.count", resource.ToGVK(demo.TypeV2Artist), "default", namespace)
+	}
+	albumKey := gaugeSinkKey("resource.count", resource.ToGVK(demo.TypeV2Album), "default", "default")
+
+	emitted := server.emitCountMetrics(testContext(t), nil)
+	require.Len(t, emitted, 3)
+	require.Equal(t, map[string]float32{
+		artistKey("default"): 1,
+		artistKey("other"):   1,
+		albumKey:             1,
+	}, sink.snapshot())
+
+	// Gauges of tenancies without resources anymore are reset to zero.
+	require.NoError(t, server.Backend.DeleteCAS(testContext(t), album.Id, album.Version))
+	emitted = server.emitCountMetrics(testContext(t), emitted)
+	require.Len(t, emitted, 2)
+	require.Equal(t, map[string]float32{
+		artistKey("default"): 1,
+		artistKey("other"):   1,
+		albumKey:             0,
+	}, sink.snapshot())
+}
+
+// gaugeSink is a telemetry.Sink that records the last value of each gauge,
+// keyed by name and label values.
+type gaugeSink struct {
+	mu     sync.Mutex
+	gauges map[string]float32
+}
+
+func (s *gaugeSink) MeasureSince([]string, time.Time, []metrics.Label) {}
+
+func (s *gaugeSink) IncrCounter([]string, float32, []metrics.Label) {}
+
+func (s *gaugeSink) SetGauge(key []string, val float32, labels []metrics.Label) {
+	values := make([]string, len(labels))
+	for i, l := range labels {
+		values[i] = l.Value
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gauges[gaugeSinkKey(strings.Join(key, "."), values...)] = val
+}
+
+func (s *gaugeSink) snapshot() map[string]float32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	gauges := make(map[string]float32, len(s.gauges))
+	for k, v := range s.gauges {
+		gauges[k] = v
+	}
+	return gauges
+}
+
+func gaugeSinkKey(name string, labelValues ...string) string {
+	return name + ";" + strings.Join(labelValues, ";")
+}
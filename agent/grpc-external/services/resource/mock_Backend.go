@@ -82,6 +82,32 @@ func (_m *MockBackend) ListByOwner(ctx context.Context, id *pbresource.ID) ([]*p
 	return r0, r1
 }
 
+// ListByOwnerPage provides a mock function with given fields: ctx, id, after, limit
+func (_m *MockBackend) ListByOwnerPage(ctx context.Context, id *pbresource.ID, after *pbresource.ID, limit int) ([]*pbresource.Resource, error) {
+	ret := _m.Called(ctx, id, after, limit)
+
+	var r0 []*pbresource.Resource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pbresource.ID, *pbresource.ID, int) ([]*pbresource.Resource, error)); ok {
+		return rf(ctx, id, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pbresource.ID, *pbresource.ID, int) []*pbresource.Resource); ok {
+		r0 = rf(ctx, id, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pbresource.Resource)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pbresource.ID, *pbresource.ID, int) error); ok {
+		r1 = rf(ctx, id, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Read provides a mock function with given fields: ctx, consistency, id
 func (_m *MockBackend) Read(ctx context.Context, consistency storage.ReadConsistency, id *pbresource.ID) (*pbresource.Resource, error) {
 	ret := _m.Called(ctx, consistency, id)
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// maxBulkNodeHealthIDs is the number of nodes a single BulkNodeHealth may
+// compute the health of.
+const maxBulkNodeHealthIDs = 1000
+
+// BulkNodeHealth computes the health of each of the given nodes with the
+// configured NodeHealthSource.
+// - Errors with InvalidArgument if no IDs, or more than maxBulkNodeHealthIDs, are given.
+// - Errors with Unimplemented if no NodeHealthSource is configured.
+func (s *Server) BulkNodeHealth(ctx context.Context, req *pbresource.BulkNodeHealthRequest) (*pbresource.BulkNodeHealthResponse, error) {
+	if err := s.checkRateLimit(ctx, "BulkNodeHealth"); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(req.Ids) == 0:
+		return nil, status.Error(codes.InvalidArgument, "ids is required")
+	case len(req.Ids) > maxBulkNodeHealthIDs:
+		return nil, status.Errorf(codes.InvalidArgument, "ids must not contain more than %d entries", maxBulkNodeHealthIDs)
+	}
+
+	if s.NodeHealth == nil {
+		return nil, status.Error(codes.Unimplemented, "node health is not available on this server")
+	}
+	return s.NodeHealth.BulkNodeHealth(ctx, newCallerClient(s), req)
+}
+
+// NodeGroupHealth aggregates the health of the nodes with the given label value
+// with the configured NodeHealthSource.
+// - Errors with InvalidArgument if no label is given.
+// - Errors with Unimplemented if no NodeHealthSource is configured.
+func (s *Server) NodeGroupHealth(ctx context.Context, req *pbresource.NodeGroupHealthRequest) (*pbresource.NodeGroupHealthResponse, error) {
+	if err := s.checkRateLimit(ctx, "NodeGroupHealth"); err != nil {
+		return nil, err
+	}
+
+	if req.Label == "" {
+		return nil, status.Error(codes.InvalidArgument, "label is required")
+	}
+
+	if s.NodeHealth == nil {
+		return nil, status.Error(codes.Unimplemented, "node health is not available on this server")
+	}
+	return s.NodeHealth.NodeGroupHealth(ctx, newCallerClient(s), req)
+}
+
+// callerClient is a pbresource.ResourceServiceClient that calls the server's
+// endpoints directly with the caller's context, so that the requests it makes
+// are authorized with the caller's token as BatchRead's are. They are rate
+// limited as part of the node health request rather than individually, and
+// don't set response headers. Requests are cloned, as they would be sent over
+// the wire, because the endpoints normalize them in place. Only the read
+// endpoints needed to compute a node's health are implemented; the others fail
+// with Unimplemented.
+type callerClient struct {
+	pbresource.ResourceServiceClient
+
+	s *Server
+}
+
+func newCallerClient(s *Server) callerClient {
+	return callerClient{
+		ResourceServiceClient: pbresource.NewResourceServiceClient(unimplementedConn{}),
+		s:                     s,
+	}
+}
+
+func (c callerClient) Read(ctx context.Context, in *pbresource.ReadRequest, _ ...grpc.CallOption) (*pbresource.ReadResponse, error) {
+	rsp, _, err := c.s.read(ctx, proto.Clone(in).(*pbresource.ReadRequest))
+	return rsp, err
+}
+
+func (c callerClient) List(ctx context.Context, in *pbresource.ListRequest, _ ...grpc.CallOption) (*pbresource.ListResponse, error) {
+	return c.s.List(withRateLimitExempt(ctx), proto.Clone(in).(*pbresource.ListRequest))
+}
+
+func (c callerClient) ListByOwner(ctx context.Context, in *pbresource.ListByOwnerRequest, _ ...grpc.CallOption) (*pbresource.ListByOwnerResponse, error) {
+	return c.s.ListByOwner(withRateLimitExempt(ctx), proto.Clone(in).(*pbresource.ListByOwnerRequest))
+}
+
+// unimplementedConn is a grpc.ClientConnInterface on which every call fails
+// with Unimplemented.
+type unimplementedConn struct{}
+
+func (unimplementedConn) Invoke(_ context.Context, method string, _, _ any, _ ...grpc.CallOption) error {
+	return status.Errorf(codes.Unimplemented, "%s is not available to node health sources", method)
+}
+
+func (unimplementedConn) NewStream(_ context.Context, _ *grpc.StreamDesc, method string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Errorf(codes.Unimplemented, "%s is not available to node health sources", method)
+}
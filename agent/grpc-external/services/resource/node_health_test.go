@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/agent/grpc-external/testutils"
+	"github.com/hashicorp/consul/internal/catalog"
+	"github.com/hashicorp/consul/internal/resource"
+	rtest "github.com/hashicorp/consul/internal/resource/resourcetest"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/prototest"
+)
+
+func TestBulkNodeHealth_InputValidation(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	node := rtest.Resource(pbcatalog.NodeType, "node-1").
+		WithTenancy(resource.DefaultPartitionedTenancy()).
+		ID()
+
+	// The endpoint is unavailable without a source of node health.
+	_, err := client.BulkNodeHealth(ctx, &pbresource.BulkNodeHealthRequest{Ids: []*pbresource.ID{node}})
+	require.Equal(t, codes.Unimplemented.String(), status.Code(err).String())
+
+	server.NodeHealth = catalog.NewNodeHealthSource()
+
+	_, err = client.BulkNodeHealth(ctx, &pbresource.BulkNodeHealthRequest{})
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.ErrorContains(t, err, "ids is required")
+
+	ids := make([]*pbresource.ID, maxBulkNodeHealthIDs+1)
+	for i := range ids {
+		ids[i] = node
+	}
+	_, err = client.BulkNodeHealth(ctx, &pbresource.BulkNodeHealthRequest{Ids: ids})
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.ErrorContains(t, err, "more than 1000 entries")
+
+	workload := rtest.Resource(pbcatalog.WorkloadType, "workload-1").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		ID()
+	_, err = client.BulkNodeHealth(ctx, &pbresource.BulkNodeHealthRequest{Ids: []*pbresource.ID{node, workload}})
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+}
+
+func TestBulkNodeHealth(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+	server.NodeHealth = catalog.NewNodeHealthSource()
+
+	dr := &dummyACLResolver{result: testutils.ACLsDisabled(t)}
+	server.ACLResolver = dr
+
+	writeNode := func(name string, checks ...pbcatalog.Health) *pbresource.ID {
+		node := rtest.Resource(pbcatalog.NodeType, name).
+			WithTenancy(resource.DefaultPartitionedTenancy()).
+			WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+			Write(t, client)
+		for i, health := range checks {
+			rtest.Resource(pbcatalog.HealthStatusType, name+"-check-"+string(rune('a'+i))).
+				WithTenancy(resource.DefaultNamespacedTenancy()).
+				WithData(t, &pbcatalog.HealthStatus{Type: "tcp", Status: health}).
+				WithOwner(node.Id).
+				Write(t, client)
+		}
+		return node.Id
+	}
+	critical := writeNode("node-critical", pbcatalog.Health_HEALTH_PASSING, pbcatalog.Health_HEALTH_CRITICAL)
+	passing := writeNode("node-passing", pbcatalog.Health_HEALTH_PASSING)
+	missing := rtest.Resource(pbcatalog.NodeType, "node-missing").
+		WithTenancy(resource.DefaultPartitionedTenancy()).
+		ID()
+
+	t.Cleanup(func() { dr.SetResult(testutils.ACLsDisabled(t)) })
+
+	// Nodes are authorized individually, so one the caller can't read is
+	// reported without failing the call.
+	dr.SetResult(AuthorizerFrom(t, `node "node-critical" { policy = "read" } node "node-missing" { policy = "read" }`))
+	//nolint:staticcheck
+	ctx := context.WithValue(testContext(t), "x-consul-token", "node-reader")
+
+	ids := []*pbresource.ID{critical, passing, missing}
+	rsp, err := client.BulkNodeHealth(ctx, &pbresource.BulkNodeHealthRequest{Ids: ids})
+	require.NoError(t, err)
+	require.Len(t, rsp.Results, len(ids))
+	for idx, result := range rsp.Results {
+		prototest.AssertDeepEqual(t, ids[idx], result.Id)
+	}
+
+	require.Equal(t, int32(codes.OK), rsp.Results[0].Status.Code)
+	require.Equal(t, pbcatalog.Health_HEALTH_CRITICAL.String(), rsp.Results[0].Health)
+	require.Equal(t, map[string]uint32{
+		pbcatalog.Health_HEALTH_PASSING.String():  1,
+		pbcatalog.Health_HEALTH_CRITICAL.String(): 1,
+	}, rsp.Results[0].CheckCounts)
+
+	require.Equal(t, int32(codes.PermissionDenied), rsp.Results[1].Status.Code)
+	require.Empty(t, rsp.Results[1].Health)
+
+	// Missing nodes are PASSING by default, as a node without checks is.
+	require.Equal(t, int32(codes.OK), rsp.Results[2].Status.Code)
+	require.False(t, rsp.Results[2].NotFound)
+	require.Equal(t, pbcatalog.Health_HEALTH_PASSING.String(), rsp.Results[2].Health)
+
+	rsp, err = client.BulkNodeHealth(ctx, &pbresource.BulkNodeHealthRequest{Ids: ids, ReportMissingNodes: true})
+	require.NoError(t, err)
+	require.True(t, rsp.Results[2].NotFound)
+	require.Empty(t, rsp.Results[2].Health)
+	require.Equal(t, pbcatalog.Health_HEALTH_CRITICAL.String(), rsp.Results[0].Health)
+}
+
+func TestNodeGroupHealth_InputValidation(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	req := &pbresource.NodeGroupHealthRequest{
+		Tenancy: resource.DefaultPartitionedTenancy(),
+		Label:   "rack",
+		Value:   "R1",
+	}
+
+	// The endpoint is unavailable without a source of node health.
+	_, err := client.NodeGroupHealth(ctx, req)
+	require.Equal(t, codes.Unimplemented.String(), status.Code(err).String())
+
+	server.NodeHealth = catalog.NewNodeHealthSource()
+
+	req.Label = ""
+	_, err = client.NodeGroupHealth(ctx, req)
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.ErrorContains(t, err, "label is required")
+}
+
+func TestNodeGroupHealth(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+	server.NodeHealth = catalog.NewNodeHealthSource()
+
+	dr := &dummyACLResolver{result: testutils.ACLsDisabled(t)}
+	server.ACLResolver = dr
+
+	writeNode := func(name, rack string, health pbcatalog.Health) *pbresource.ID {
+		node := rtest.Resource(pbcatalog.NodeType, name).
+			WithTenancy(resource.DefaultPartitionedTenancy()).
+			WithMeta("rack", rack).
+			WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+			Write(t, client)
+		rtest.Resource(pbcatalog.HealthStatusType, name+"-check").
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.HealthStatus{Type: "tcp", Status: health}).
+			WithOwner(node.Id).
+			Write(t, client)
+		return node.Id
+	}
+	r1Passing := writeNode("r1-a", "R1", pbcatalog.Health_HEALTH_PASSING)
+	writeNode("r1-b", "R1", pbcatalog.Health_HEALTH_CRITICAL)
+	writeNode("r2-a", "R2", pbcatalog.Health_HEALTH_PASSING)
+	writeNode("r2-b", "R2", pbcatalog.Health_HEALTH_PASSING)
+
+	groupHealth := func(ctx context.Context, rack string) *pbresource.NodeGroupHealthResponse {
+		t.Helper()
+		rsp, err := client.NodeGroupHealth(ctx, &pbresource.NodeGroupHealthRequest{
+			Tenancy: resource.DefaultPartitionedTenancy(),
+			Label:   "rack",
+			Value:   rack,
+		})
+		require.NoError(t, err)
+		return rsp
+	}
+
+	ctx := testContext(t)
+	r1 := groupHealth(ctx, "R1")
+	require.Equal(t, pbcatalog.Health_HEALTH_CRITICAL.String(), r1.Health)
+	require.Len(t, r1.Nodes, 2)
+
+	r2 := groupHealth(ctx, "R2")
+	require.Equal(t, pbcatalog.Health_HEALTH_PASSING.String(), r2.Health)
+	require.Len(t, r2.Nodes, 2)
+
+	t.Cleanup(func() { dr.SetResult(testutils.ACLsDisabled(t)) })
+
+	// Nodes the caller can't read are excluded from the group.
+	dr.SetResult(AuthorizerFrom(t, `node "r1-a" { policy = "read" }`))
+	//nolint:staticcheck
+	ctx = context.WithValue(testContext(t), "x-consul-token", "node-reader")
+
+	r1 = groupHealth(ctx, "R1")
+	require.Equal(t, pbcatalog.Health_HEALTH_PASSING.String(), r1.Health)
+	require.Len(t, r1.Nodes, 1)
+	prototest.AssertDeepEqual(t, r1Passing, r1.Nodes[0].Id)
+}
+
+func TestRead_IncludeLiveHealth(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	node := rtest.Resource(pbcatalog.NodeType, "node-1").
+		WithTenancy(resource.DefaultPartitionedTenancy()).
+		WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+		Write(t, client)
+
+	// The option is unavailable without a source of node health.
+	_, err := client.Read(ctx, &pbresource.ReadRequest{Id: node.Id, IncludeLiveHealth: true})
+	require.Equal(t, codes.Unimplemented.String(), status.Code(err).String())
+
+	server.NodeHealth = catalog.NewNodeHealthSource()
+
+	// The node was reconciled as passing...
+	_, err = client.WriteStatus(ctx, &pbresource.WriteStatusRequest{
+		Id:      node.Id,
+		Version: node.Version,
+		Key:     catalog.NodeHealthStatusKey,
+		Status: &pbresource.Status{
+			ObservedGeneration: node.Generation,
+			Conditions:         []*pbresource.Condition{catalog.NodeHealthConditions[pbcatalog.Health_HEALTH_PASSING]},
+		},
+	})
+	require.NoError(t, err)
+
+	// ...but has since had a critical check written.
+	rtest.Resource(pbcatalog.HealthStatusType, "node-1-check").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		WithData(t, &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_CRITICAL}).
+		WithOwner(node.Id).
+		Write(t, client)
+
+	rsp, err := client.Read(ctx, &pbresource.ReadRequest{Id: node.Id, IncludeLiveHealth: true})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.LiveHealth)
+	require.Equal(t, pbcatalog.Health_HEALTH_CRITICAL.String(), rsp.LiveHealth.LiveHealth)
+	require.Equal(t, pbcatalog.Health_HEALTH_CRITICAL.String(), rsp.LiveHealth.LiveCondition.Reason)
+	require.Equal(t, pbcatalog.Health_HEALTH_PASSING.String(), rsp.LiveHealth.StoredCondition.Reason)
+	require.False(t, rsp.LiveHealth.StoredConditionStale)
+
+	// Live health isn't returned unless it's requested.
+	rsp, err = client.Read(ctx, &pbresource.ReadRequest{Id: node.Id})
+	require.NoError(t, err)
+	require.Nil(t, rsp.LiveHealth)
+
+	// Only nodes have a live health.
+	check := rtest.Resource(pbcatalog.HealthStatusType, "node-1-check").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		ID()
+	_, err = client.Read(ctx, &pbresource.ReadRequest{Id: check, IncludeLiveHealth: true})
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+}
+
+func TestBulkNodeHealth_RateLimit(t *testing.T) {
+	server := testServer(t)
+	server.RateLimiter = NewTokenBucketRateLimiter(map[string]TokenBucketLimit{
+		"BulkNodeHealth": {Burst: 1},
+		"Read":           {Burst: 1},
+		"List":           {Burst: 1},
+	}, false)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+	server.NodeHealth = catalog.NewNodeHealthSource()
+	ctx := testContext(t)
+
+	var ids []*pbresource.ID
+	for _, name := range []string{"node-1", "node-2"} {
+		node := rtest.Resource(pbcatalog.NodeType, name).
+			WithTenancy(resource.DefaultPartitionedTenancy()).
+			WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+			Write(t, client)
+		ids = append(ids, node.Id)
+	}
+
+	// The nodes are read and their checks listed without taking tokens from the
+	// Read and List buckets.
+	rsp, err := client.BulkNodeHealth(ctx, &pbresource.BulkNodeHealthRequest{Ids: ids})
+	require.NoError(t, err)
+	for _, result := range rsp.Results {
+		require.Equal(t, int32(codes.OK), result.Status.Code)
+	}
+	_, err = client.BulkNodeHealth(ctx, &pbresource.BulkNodeHealthRequest{Ids: ids})
+	require.Equal(t, codes.ResourceExhausted.String(), status.Code(err).String())
+
+	_, err = client.Read(ctx, &pbresource.ReadRequest{Id: ids[0]})
+	require.NoError(t, err)
+}
+
+func TestCallerClient_Unimplemented(t *testing.T) {
+	client := newCallerClient(testServer(t))
+
+	_, err := client.Write(testContext(t), &pbresource.WriteRequest{})
+	require.Equal(t, codes.Unimplemented.String(), status.Code(err).String())
+
+	_, err = client.WatchList(testContext(t), &pbresource.WatchListRequest{})
+	require.Equal(t, codes.Unimplemented.String(), status.Code(err).String())
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+
+	lru "github.com/hashicorp/golang-lru"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RateLimiter decides whether requests to the resource service's endpoints may
+// proceed. Requests that aren't allowed fail with ResourceExhausted.
+type RateLimiter interface {
+	// Allow reports whether a request to the given endpoint (the name of the
+	// RPC, e.g. "Read") made with the given ACL token may proceed now.
+	Allow(endpoint, token string) bool
+}
+
+// TokenBucketLimit is the token bucket through which requests to an endpoint
+// are admitted: at most Burst requests at once, refilled at Rate per second.
+type TokenBucketLimit struct {
+	Rate  rate.Limit
+	Burst int
+}
+
+// maxRateLimitedTokens is the number of ACL tokens a TokenBucketRateLimiter
+// keyed by token tracks buckets for. The buckets of the least recently seen
+// tokens are discarded beyond it.
+const maxRateLimitedTokens = 4096
+
+// TokenBucketRateLimiter is a RateLimiter with a token bucket per endpoint.
+// Endpoints without a configured limit are unlimited.
+type TokenBucketRateLimiter struct {
+	limits map[string]TokenBucketLimit
+
+	// shared holds the buckets of each endpoint when not keyed by token.
+	shared map[string]*rate.Limiter
+
+	// perToken holds the buckets of each endpoint and token when keyed by token.
+	perToken *lru.Cache
+}
+
+// NewTokenBucketRateLimiter creates a TokenBucketRateLimiter with the given
+// limits, keyed by endpoint name. When perToken is true, each ACL token gets
+// its own bucket per endpoint, so that one client can't exhaust the limit of
+// the others.
+func NewTokenBucketRateLimiter(limits map[string]TokenBucketLimit, perToken bool) *TokenBucketRateLimiter {
+	l := &TokenBucketRateLimiter{limits: limits}
+	if perToken {
+		// lru.New only fails when given a non-positive size.
+		l.perToken, _ = lru.New(maxRateLimitedTokens)
+	} else {
+		l.shared = make(map[string]*rate.Limiter, len(limits))
+		for endpoint, limit := range limits {
+			l.shared[endpoint] = rate.NewLimiter(limit.Rate, limit.Burst)
+		}
+	}
+	return l
+}
+
+// Allow implements RateLimiter.
+func (l *TokenBucketRateLimiter) Allow(endpoint, token string) bool {
+	limit, ok := l.limits[endpoint]
+	if !ok {
+		return true
+	}
+	if l.perToken == nil {
+		return l.shared[endpoint].Allow()
+	}
+
+	key := rateLimitKey{endpoint: endpoint, token: token}
+	bucket := rate.NewLimiter(limit.Rate, limit.Burst)
+	if existing, ok, _ := l.perToken.PeekOrAdd(key, bucket); ok {
+		bucket = existing.(*rate.Limiter)
+		// Mark the bucket as recently used.
+		l.perToken.Get(key)
+	}
+	return bucket.Allow()
+}
+
+type rateLimitKey struct {
+	endpoint string
+	token    string
+}
+
+// rateLimitExemptKey is the context key marking requests made by the server to
+// itself on behalf of a request that has already passed the rate limiter.
+type rateLimitExemptKey struct{}
+
+// withRateLimitExempt returns a context whose requests bypass the rate limiter.
+func withRateLimitExempt(ctx context.Context) context.Context {
+	return context.WithValue(ctx, rateLimitExemptKey{}, true)
+}
+
+// checkRateLimit returns a ResourceExhausted error if the request to the given
+// endpoint isn't allowed by the configured RateLimiter.
+func (s *Server) checkRateLimit(ctx context.Context, endpoint string) error {
+	if s.RateLimiter == nil || ctx.Value(rateLimitExemptKey{}) != nil {
+		return nil
+	}
+	if s.RateLimiter.Allow(endpoint, tokenFromContext(ctx)) {
+		return nil
+	}
+	return status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s", endpoint)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/internal/resource/demo"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+func TestServer_RateLimit(t *testing.T) {
+	// A zero rate means buckets never refill, so that the tests are
+	// deterministic.
+	limits := map[string]TokenBucketLimit{
+		"Read":          {Burst: 2},
+		"BatchRead":     {Burst: 1},
+		"List":          {Burst: 1},
+		"Delete":        {Burst: 1},
+		"DeleteByOwner": {Burst: 1},
+	}
+
+	setup := func(t *testing.T, perToken bool) (pbresource.ResourceServiceClient, *pbresource.Resource) {
+		server := testServer(t)
+		server.RateLimiter = NewTokenBucketRateLimiter(limits, perToken)
+		demo.RegisterTypes(server.Registry)
+		client := testClient(t, server)
+
+		artist, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+		require.NoError(t, err)
+		return client, rsp.Resource
+	}
+	withToken := func(ctx context.Context, token string) context.Context {
+		return metadata.AppendToOutgoingContext(ctx, "x-consul-token", token)
+	}
+	requireExhausted := func(t *testing.T, err error) {
+		t.Helper()
+		require.Error(t, err)
+		require.Equal(t, codes.ResourceExhausted.String(), status.Code(err).String())
+	}
+
+	t.Run("reads past the limit are exhausted", func(t *testing.T) {
+		client, artist := setup(t, false)
+
+		for i := 0; i < 2; i++ {
+			_, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id})
+			require.NoError(t, err)
+		}
+		_, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id})
+		requireExhausted(t, err)
+
+		// Endpoints have separate buckets.
+		_, err = client.List(testContext(t), &pbresource.ListRequest{Type: demo.TypeV2Artist, Tenancy: artist.Id.Tenancy})
+		require.NoError(t, err)
+		_, err = client.List(testContext(t), &pbresource.ListRequest{Type: demo.TypeV2Artist, Tenancy: artist.Id.Tenancy})
+		requireExhausted(t, err)
+
+		// Endpoints without a limit are unlimited.
+		for i := 0; i < 5; i++ {
+			rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+			require.NoError(t, err)
+			artist = rsp.Resource
+		}
+	})
+
+	t.Run("batch reads take a single token", func(t *testing.T) {
+		client, artist := setup(t, false)
+
+		ids := []*pbresource.ID{artist.Id, artist.Id, artist.Id}
+		rsp, err := client.BatchRead(testContext(t), &pbresource.BatchReadRequest{Ids: ids})
+		require.NoError(t, err)
+		for _, result := range rsp.Results {
+			require.Equal(t, int32(codes.OK), result.Status.Code)
+		}
+		_, err = client.BatchRead(testContext(t), &pbresource.BatchReadRequest{Ids: ids})
+		requireExhausted(t, err)
+
+		// The batch's entries don't take tokens from the Read bucket.
+		for i := 0; i < 2; i++ {
+			_, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id})
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("bulk deletes are rate limited", func(t *testing.T) {
+		client, artist := setup(t, false)
+
+		_, err := client.DeleteByOwner(testContext(t), &pbresource.DeleteByOwnerRequest{Owner: artist.Id})
+		require.NoError(t, err)
+		_, err = client.DeleteByOwner(testContext(t), &pbresource.DeleteByOwnerRequest{Owner: artist.Id})
+		requireExhausted(t, err)
+	})
+
+	t.Run("buckets are shared by all tokens", func(t *testing.T) {
+		client, artist := setup(t, false)
+
+		for i := 0; i < 2; i++ {
+			_, err := client.Read(withToken(testContext(t), "token-a"), &pbresource.ReadRequest{Id: artist.Id})
+			require.NoError(t, err)
+		}
+		_, err := client.Read(withToken(testContext(t), "token-b"), &pbresource.ReadRequest{Id: artist.Id})
+		requireExhausted(t, err)
+	})
+
+	t.Run("buckets keyed by token", func(t *testing.T) {
+		client, artist := setup(t, true)
+
+		for i := 0; i < 2; i++ {
+			_, err := client.Read(withToken(testContext(t), "token-a"), &pbresource.ReadRequest{Id: artist.Id})
+			require.NoError(t, err)
+		}
+		_, err := client.Read(withToken(testContext(t), "token-a"), &pbresource.ReadRequest{Id: artist.Id})
+		requireExhausted(t, err)
+
+		// Another token has its own bucket.
+		_, err = client.Read(withToken(testContext(t), "token-b"), &pbresource.ReadRequest{Id: artist.Id})
+		require.NoError(t, err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		client, artist := setup(t, false)
+
+		_, err := client.Delete(testContext(t), &pbresource.DeleteRequest{Id: artist.Id})
+		require.NoError(t, err)
+		_, err = client.Delete(testContext(t), &pbresource.DeleteRequest{Id: artist.Id})
+		requireExhausted(t, err)
+	})
+}
@@ -5,10 +5,25 @@ package resource
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"sort"
+	"time"
 
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/internal/resource"
@@ -17,32 +32,55 @@ import (
 )
 
 func (s *Server) Read(ctx context.Context, req *pbresource.ReadRequest) (*pbresource.ReadResponse, error) {
+	if err := s.checkRateLimit(ctx, "Read"); err != nil {
+		return nil, err
+	}
+
+	rsp, groupVersion, err := s.read(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if groupVersion != "" {
+		// Failing to set the header only happens once the response has already
+		// been sent (or outside of a gRPC call), so it's safe to ignore.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(groupVersionHeader, groupVersion))
+	}
+	return rsp, nil
+}
+
+// read reads a resource as Read does, without rate limiting the request or
+// setting the response headers, so that a single request can read many
+// resources with it. Along with the response, it returns the GroupVersion of
+// the resource read, which is empty unless the caller is authorized to read it.
+func (s *Server) read(ctx context.Context, req *pbresource.ReadRequest) (*pbresource.ReadResponse, string, error) {
 	// Light first pass validation based on what user passed in and not much more.
 	reg, err := s.ensureReadRequestValid(req)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	if req.IncludeLiveHealth && s.NodeHealth == nil {
+		return nil, "", status.Error(codes.Unimplemented, "node health is not available on this server")
 	}
 
-	// acl.EnterpriseMeta acl.AuthorizerContext follow rules for V1 resources since they integrate with the V1 acl subsystem.
-	// pbresource.Tenacy follows rules for V2 resources and the Resource service.
-	// Example:
-	//
-	//    A CE namespace scoped resource:
-	//      V1: EnterpriseMeta{}
-	//      V2: Tenancy {Partition: "default", Namespace: "default"}
-	//
-	//   An ENT namespace scoped resource:
-	//      V1: EnterpriseMeta{Partition: "default", Namespace: "default"}
-	//      V2: Tenancy {Partition: "default", Namespace: "default"}
-	//
-	// It is necessary to convert back and forth depending on which component supports which version, V1 or V2.
-	entMeta := v2TenancyToV1EntMeta(req.Id.Tenancy)
-	authz, authzContext, err := s.getAuthorizer(tokenFromContext(ctx), entMeta)
+	token := tokenFromContext(ctx)
+	start := time.Now()
+	authz, authzContext, err := s.resolveTenancy(token, reg, req.Id.Tenancy)
+	s.measurePhase(metricReadAuthorize, req.Id.Type, start)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	v1EntMetaToV2Tenancy(reg, entMeta, req.Id.Tenancy)
+	// Explaining authorization decisions reveals details of the ACL policies,
+	// so it is restricted to operators.
+	if req.ExplainAuthorization {
+		err = authz.ToAllowAuthorizer().OperatorWriteAllowed(authzContext)
+		switch {
+		case acl.IsErrPermissionDenied(err):
+			return nil, "", status.Error(codes.PermissionDenied, err.Error())
+		case err != nil:
+			return nil, "", status.Errorf(codes.Internal, "failed operator acl: %v", err)
+		}
+	}
 
 	// ACL check usually comes before tenancy existence checks to not leak
 	// tenancy "existence", unless the ACL check requires the data payload
@@ -54,37 +92,369 @@ func (s *Server) Read(ctx context.Context, req *pbresource.ReadRequest) (*pbreso
 		authzNeedsData = true
 		err = nil
 	case acl.IsErrPermissionDenied(err):
-		return nil, status.Error(codes.PermissionDenied, err.Error())
+		if req.ExplainAuthorization {
+			return &pbresource.ReadResponse{AuthorizationExplanation: explainAuthorization(req.Id, err)}, "", nil
+		}
+		return nil, "", status.Error(codes.PermissionDenied, err.Error())
 	case err != nil:
-		return nil, status.Errorf(codes.Internal, "failed read acl: %v", err)
+		return nil, "", status.Errorf(codes.Internal, "failed read acl: %v", err)
 	}
 
-	// Check tenancy exists for the V2 resource.
-	if err = tenancyExists(reg, s.TenancyBridge, req.Id.Tenancy, codes.NotFound); err != nil {
-		return nil, err
+	// Check tenancy exists for the V2 resource. When a strongly consistent read
+	// was requested, the existence check must be too, otherwise reading from a
+	// freshly created tenancy may fail on a follower that hasn't caught up yet.
+	consistency := readConsistencyFor(ctx, reg, req.Consistency)
+	start = time.Now()
+	err = tenancyExists(reg, s.tenancyBridgeFor(consistency), req.Id.Tenancy, codes.NotFound)
+	s.measurePhase(metricReadTenancyExists, req.Id.Type, start)
+	if err != nil {
+		return nil, "", err
+	}
+	if s.FailReadsInDeletingTenancy {
+		if err = tenancyMarkedForDeletion(reg, s.tenancyBridgeFor(consistency), req.Id.Tenancy, codes.FailedPrecondition); err != nil {
+			return nil, "", err
+		}
 	}
 
-	resource, err := s.Backend.Read(ctx, readConsistencyFrom(ctx), req.Id)
+	resource, err := s.Backend.Read(ctx, consistency, req.Id)
+	var mismatchErr storage.GroupVersionMismatchError
+	if errors.As(err, &mismatchErr) && reg.Migrate != nil {
+		resource, err = migrate(reg, mismatchErr.Stored)
+		if err != nil {
+			return nil, "", err
+		}
+	}
 	switch {
 	case errors.Is(err, storage.ErrNotFound):
-		return nil, status.Error(codes.NotFound, err.Error())
-	case errors.As(err, &storage.GroupVersionMismatchError{}):
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		// The read ACL has already been checked against the ID, unless it
+		// needs the data which a deleted resource no longer has.
+		if req.IncludeTombstone && !authzNeedsData {
+			tombstone, terr := s.readTombstone(ctx, consistency, req.Id)
+			if terr != nil {
+				return nil, "", terr
+			}
+			if tombstone != nil {
+				return &pbresource.ReadResponse{Tombstone: tombstone}, "", nil
+			}
+		}
+		return nil, "", status.Error(codes.NotFound, err.Error())
+	case errors.As(err, &mismatchErr):
+		return nil, "", status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, storage.ErrNoLeader):
+		return nil, "", status.Error(codes.Unavailable, err.Error())
 	case err != nil:
-		return nil, status.Errorf(codes.Internal, "failed read: %v", err)
+		return nil, "", status.Errorf(codes.Internal, "failed read: %v", err)
 	}
 
 	if authzNeedsData {
 		err = reg.ACLs.Read(authz, authzContext, req.Id, resource)
 		switch {
 		case acl.IsErrPermissionDenied(err):
-			return nil, status.Error(codes.PermissionDenied, err.Error())
+			if req.ExplainAuthorization {
+				return &pbresource.ReadResponse{AuthorizationExplanation: explainAuthorization(req.Id, err)}, "", nil
+			}
+			return nil, "", status.Error(codes.PermissionDenied, err.Error())
 		case err != nil:
-			return nil, status.Errorf(codes.Internal, "failed read acl: %v", err)
+			return nil, "", status.Errorf(codes.Internal, "failed read acl: %v", err)
 		}
 	}
 
-	return &pbresource.ReadResponse{Resource: resource}, nil
+	// Only reveal whether the resource is unchanged once the caller has been
+	// authorized to read it.
+	if req.IfVersionNotMatch != "" && req.IfVersionNotMatch == resource.Version {
+		return &pbresource.ReadResponse{NotModified: true}, resource.Id.Type.GroupVersion, nil
+	}
+
+	rsp := &pbresource.ReadResponse{Resource: resource}
+	if req.DecodeData {
+		if rsp.DecodedData, err = decodeData(reg, resource); err != nil {
+			return nil, "", err
+		}
+	}
+	if req.CanonicalEncoding {
+		if rsp.CanonicalData, rsp.CanonicalDigest, err = canonicalEncoding(reg, resource); err != nil {
+			return nil, "", err
+		}
+	}
+	if req.IncludeDerivedFields {
+		if rsp.DerivedFields, err = derivedFields(reg, resource); err != nil {
+			return nil, "", err
+		}
+	}
+	if req.IncludeStatusRollup {
+		rsp.StatusRollup = statusRollup(resource)
+	}
+	if req.ExplainAuthorization {
+		rsp.AuthorizationExplanation = explainAuthorization(resource.Id, nil)
+	}
+	if req.IncludeLiveHealth {
+		// The node's HealthStatus resources are read on behalf of the caller.
+		if rsp.LiveHealth, err = s.NodeHealth.LiveNodeHealth(ctx, newCallerClient(s), resource); err != nil {
+			return nil, "", err
+		}
+	}
+	if req.IncludeSize {
+		rsp.Size = uint64(proto.Size(resource))
+		if rsp.OwnedSize, err = s.ownedSize(ctx, token, resource.Id, authz, authzContext); err != nil {
+			return nil, "", err
+		}
+	}
+	if req.RelativeReferences {
+		rsp.Resource = relativeReferences(resource)
+	}
+	if req.Minimal {
+		rsp.Resource = minimalProjection(rsp.Resource)
+	}
+	if len(req.FieldMask.GetPaths()) > 0 {
+		rsp.Resource = fieldMaskProjection(rsp.Resource, req.FieldMask)
+	}
+	return rsp, resource.Id.Type.GroupVersion, nil
+}
+
+// readTombstone returns the record of the most recent deletion of the resource
+// with the given ID, or nil if there is no tombstone for it. When the ID has no
+// Uid, deletions of resources with any Uid match.
+func (s *Server) readTombstone(ctx context.Context, consistency storage.ReadConsistency, id *pbresource.ID) (*pbresource.DeletedResource, error) {
+	// Tombstone names are the deleted resource's name followed by its Uid.
+	tombstones, err := s.Backend.List(
+		ctx,
+		consistency,
+		storage.UnversionedTypeFrom(resource.TypeV1Tombstone),
+		id.Tenancy,
+		tombstoneNamePrefix+id.Name+"-",
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed list: %v", err)
+	}
+
+	var latest *pbresource.DeletedResource
+	for _, tombstone := range tombstones {
+		var data pbresource.Tombstone
+		if err := tombstone.Data.UnmarshalTo(&data); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal tombstone: %v", err)
+		}
+
+		deleted := data.Owner
+		if storage.UnversionedTypeFrom(deleted.Type) != storage.UnversionedTypeFrom(id.Type) ||
+			deleted.Name != id.Name ||
+			(id.Uid != "" && deleted.Uid != id.Uid) {
+			continue
+		}
+
+		// Tombstones are given a ULID when the resource is deleted.
+		uid, err := ulid.Parse(tombstone.Id.Uid)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to parse tombstone uid: %v", err)
+		}
+		deletedAt := ulid.Time(uid.Time())
+		if latest == nil || deletedAt.After(latest.DeletedAt.AsTime()) {
+			latest = &pbresource.DeletedResource{
+				Id:        deleted,
+				DeletedAt: timestamppb.New(deletedAt),
+			}
+		}
+	}
+	return latest, nil
+}
+
+// explainAuthorization describes the outcome of the read ACL check on id, where
+// err is the error returned by the check.
+func explainAuthorization(id *pbresource.ID, err error) *pbresource.AuthorizationExplanation {
+	if err == nil {
+		return &pbresource.AuthorizationExplanation{
+			Id:      id,
+			Allowed: true,
+			Reason:  "read allowed",
+		}
+	}
+
+	explanation := &pbresource.AuthorizationExplanation{
+		Id:     id,
+		Reason: err.Error(),
+	}
+
+	var denied acl.PermissionDeniedError
+	if errors.As(err, &denied) && denied.Resource != "" {
+		explanation.RequiredPermission = fmt.Sprintf("%s:%s", denied.Resource, denied.AccessLevel)
+		explanation.AclResource = denied.ResourceID.Name
+	}
+	return explanation
+}
+
+// derivedFields computes the derived fields registered for the resource's type.
+func derivedFields(reg *resource.Registration, res *pbresource.Resource) (map[string]*structpb.Value, error) {
+	if len(reg.DerivedFields) == 0 {
+		return nil, nil
+	}
+
+	fields := make(map[string]*structpb.Value, len(reg.DerivedFields))
+	for name, fn := range reg.DerivedFields {
+		value, err := fn(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to compute derived field %q: %v", name, err)
+		}
+		fields[name] = value
+	}
+	return fields, nil
+}
+
+// minimalProjection returns a copy of res with only its ID, version and data.
+func minimalProjection(res *pbresource.Resource) *pbresource.Resource {
+	return &pbresource.Resource{
+		Id:      res.Id,
+		Version: res.Version,
+		Data:    res.Data,
+	}
+}
+
+// fieldMaskProjection returns a copy of res with only its ID and the top-level
+// fields selected by mask, which must have been validated with
+// validateFieldMask.
+func fieldMaskProjection(res *pbresource.Resource, mask *fieldmaskpb.FieldMask) *pbresource.Resource {
+	projected := &pbresource.Resource{Id: res.Id}
+
+	src, dst := res.ProtoReflect(), projected.ProtoReflect()
+	fields := src.Descriptor().Fields()
+	for _, path := range mask.Paths {
+		fd := fields.ByName(protoreflect.Name(path))
+		if src.Has(fd) {
+			dst.Set(fd, src.Get(fd))
+		}
+	}
+	return projected
+}
+
+// validateFieldMask checks that each of the mask's paths is a top-level field
+// of Resource.
+func validateFieldMask(mask *fieldmaskpb.FieldMask) error {
+	fields := (&pbresource.Resource{}).ProtoReflect().Descriptor().Fields()
+	for _, path := range mask.GetPaths() {
+		if fields.ByName(protoreflect.Name(path)) == nil {
+			return status.Errorf(codes.InvalidArgument, "field_mask: %q is not a top-level resource field", path)
+		}
+	}
+	return nil
+}
+
+// statusRollup summarizes the statuses written to the resource, counting each
+// status key as a controller that reports healthy when all of its conditions
+// are true.
+func statusRollup(res *pbresource.Resource) *pbresource.StatusRollup {
+	rollup := &pbresource.StatusRollup{Controllers: uint32(len(res.Status))}
+	for key, status := range res.Status {
+		healthy := true
+		for _, cond := range status.Conditions {
+			if cond.State != pbresource.Condition_STATE_TRUE {
+				healthy = false
+				break
+			}
+		}
+		if healthy {
+			rollup.HealthyControllers++
+		} else {
+			rollup.UnhealthyKeys = append(rollup.UnhealthyKeys, key)
+		}
+	}
+	sort.Strings(rollup.UnhealthyKeys)
+	rollup.Summary = fmt.Sprintf("%d of %d controllers report healthy", rollup.HealthyControllers, rollup.Controllers)
+	return rollup
+}
+
+// decodeData decodes the resource's data into a structure following the
+// protobuf JSON mapping of the registered type.
+func decodeData(reg *resource.Registration, res *pbresource.Resource) (*structpb.Struct, error) {
+	if res.Data == nil {
+		return nil, nil
+	}
+
+	data := reg.Proto.ProtoReflect().New().Interface()
+	if err := res.Data.UnmarshalTo(data); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal data: %v", err)
+	}
+
+	js, err := protojson.Marshal(data)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode data: %v", err)
+	}
+
+	var decoded structpb.Struct
+	if err := protojson.Unmarshal(js, &decoded); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode data: %v", err)
+	}
+	return &decoded, nil
+}
+
+// canonicalEncoding returns the resource's data encoded as compact JSON with
+// sorted object keys, and its SHA-256 digest. The protobuf wire format isn't
+// guaranteed to be stable across library versions; this encoding is.
+func canonicalEncoding(reg *resource.Registration, res *pbresource.Resource) ([]byte, string, error) {
+	decoded, err := decodeData(reg, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// encoding/json sorts map keys and emits no insignificant whitespace.
+	data, err := json.Marshal(decoded.AsMap())
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "failed to encode data: %v", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return data, "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// ownedSize returns the combined serialized size of the resources directly
+// owned by owner. Children the caller is not authorized to read are skipped,
+// in the same way ListByOwner filters them out.
+func (s *Server) ownedSize(ctx context.Context, token string, owner *pbresource.ID, authz acl.Authorizer, authzContext *acl.AuthorizerContext) (uint64, error) {
+	children, err := s.Backend.ListByOwner(ctx, owner)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed list by owner: %v", err)
+	}
+
+	var size uint64
+	for _, child := range children {
+		childReg, err := s.resolveType(child.Id.Type)
+		if err != nil {
+			return 0, err
+		}
+
+		// Rebuild authorizer if tenancy not identical between owner and child (child scope
+		// may be narrower).
+		childAuthz := authz
+		childAuthzContext := authzContext
+		if !resource.EqualTenancy(owner.Tenancy, child.Id.Tenancy) {
+			childAuthz, childAuthzContext, err = s.getAuthorizer(token, v2TenancyToV1EntMeta(child.Id.Tenancy))
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		err = childReg.ACLs.Read(childAuthz, childAuthzContext, child.Id, child)
+		switch {
+		case acl.IsErrPermissionDenied(err):
+			continue
+		case err != nil:
+			return 0, status.Errorf(codes.Internal, "failed read acl: %v", err)
+		}
+
+		size += uint64(proto.Size(child))
+	}
+	return size, nil
+}
+
+// migrate converts a resource stored with another GroupVersion of the type to
+// the registered one, using the type's migration hook.
+func migrate(reg *resource.Registration, stored *pbresource.Resource) (*pbresource.Resource, error) {
+	res := clone(stored)
+	res.Id.Type = clone(reg.Type)
+	if err := reg.Migrate(res); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to migrate resource from GroupVersion %q: %v", stored.Id.Type.GroupVersion, err)
+	}
+	if res.Data != nil && !res.Data.MessageIs(reg.Proto) {
+		return nil, status.Errorf(codes.Internal, "migration hook did not convert resource data to %s", reg.Proto.ProtoReflect().Descriptor().FullName())
+	}
+	return res, nil
 }
 
 func (s *Server) ensureReadRequestValid(req *pbresource.ReadRequest) (*resource.Registration, error) {
@@ -96,8 +466,14 @@ func (s *Server) ensureReadRequestValid(req *pbresource.ReadRequest) (*resource.
 		return nil, err
 	}
 
+	if err := validateFieldMask(req.FieldMask); err != nil {
+		return nil, err
+	}
+
 	// Check type exists.
+	start := time.Now()
 	reg, err := s.resolveType(req.Id.Type)
+	s.measurePhase(metricReadResolveType, req.Id.Type, start)
 	if err != nil {
 		return nil, err
 	}
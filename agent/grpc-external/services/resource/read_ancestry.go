@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// ReadAncestry reads a resource and its chain of owners.
+// - Each resource goes through Read, so that it is validated and authorized
+// in exactly the same way, and the first error is returned.
+// - Errors with Internal if an owner is encountered twice.
+func (s *Server) ReadAncestry(ctx context.Context, req *pbresource.ReadAncestryRequest) (*pbresource.ReadAncestryResponse, error) {
+	if req.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	var resources []*pbresource.Resource
+	seen := make(map[resource.ReferenceKey]struct{})
+	for id := req.Id; id != nil; {
+		rsp, err := s.Read(ctx, &pbresource.ReadRequest{Id: clone(id)})
+		if err != nil {
+			return nil, err
+		}
+
+		res := rsp.Resource
+		key := resource.NewReferenceKey(res.Id)
+		if _, ok := seen[key]; ok {
+			return nil, status.Errorf(codes.Internal, "ownership cycle detected at %s", resource.IDToString(res.Id))
+		}
+		seen[key] = struct{}{}
+
+		resources = append(resources, res)
+		id = res.Owner
+	}
+	return &pbresource.ReadAncestryResponse{Resources: resources}, nil
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/agent/grpc-external/testutils"
+	"github.com/hashicorp/consul/internal/catalog"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/resource/demo"
+	rtest "github.com/hashicorp/consul/internal/resource/resourcetest"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/prototest"
+)
+
+func TestReadAncestry_InputValidation(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+
+	_, err := client.ReadAncestry(testContext(t), &pbresource.ReadAncestryRequest{})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.Contains(t, err.Error(), "id is required")
+}
+
+func TestReadAncestry_HealthStatus(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+
+	node := rtest.Resource(pbcatalog.NodeType, "node-1").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+		Write(t, client)
+	check := rtest.Resource(pbcatalog.HealthStatusType, "check").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		WithData(t, &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_PASSING}).
+		WithOwner(node.Id).
+		Write(t, client)
+
+	rsp, err := client.ReadAncestry(testContext(t), &pbresource.ReadAncestryRequest{Id: check.Id})
+	require.NoError(t, err)
+	prototest.AssertDeepEqual(t, []*pbresource.Resource{check, node}, rsp.Resources)
+
+	// A resource without an owner is its own ancestry.
+	rsp, err = client.ReadAncestry(testContext(t), &pbresource.ReadAncestryRequest{Id: node.Id})
+	require.NoError(t, err)
+	prototest.AssertDeepEqual(t, []*pbresource.Resource{node}, rsp.Resources)
+}
+
+func TestReadAncestry_ACLs(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+	artist = rsp.Resource
+
+	album, err := demo.GenerateV2Album(artist.Id)
+	require.NoError(t, err)
+	rsp, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: album})
+	require.NoError(t, err)
+	album = rsp.Resource
+
+	// The caller may read the album, but not the artist that owns it.
+	dr := &dummyACLResolver{result: AuthorizerFrom(t, `key_prefix "resource/demo.v2.Album/" { policy = "read" }`)}
+	server.ACLResolver = dr
+
+	_, err = client.ReadAncestry(testContext(t), &pbresource.ReadAncestryRequest{Id: album.Id})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+
+	dr.SetResult(testutils.ACLsDisabled(t))
+	arsp, err := client.ReadAncestry(testContext(t), &pbresource.ReadAncestryRequest{Id: album.Id})
+	require.NoError(t, err)
+	prototest.AssertDeepEqual(t, []*pbresource.Resource{album, artist}, arsp.Resources)
+}
+
+func TestReadAncestry_Cycle(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	// Write directly to the backend, as Write keeps owners consistent.
+	artistA, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	artistA.Id.Name = "artist-a"
+	artistA.Id.Uid = "a"
+
+	artistB, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	artistB.Id.Name = "artist-b"
+	artistB.Id.Uid = "b"
+
+	artistA.Owner = artistB.Id
+	artistB.Owner = artistA.Id
+	for _, res := range []*pbresource.Resource{artistA, artistB} {
+		_, err = server.Backend.WriteCAS(testContext(t), res)
+		require.NoError(t, err)
+	}
+
+	_, err = client.ReadAncestry(testContext(t), &pbresource.ReadAncestryRequest{Id: artistA.Id})
+	require.Error(t, err)
+	require.Equal(t, codes.Internal.String(), status.Code(err).String())
+	require.Contains(t, err.Error(), "ownership cycle detected")
+}
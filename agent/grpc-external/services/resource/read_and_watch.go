@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/storage"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+func (s *Server) ReadAndWatch(req *pbresource.ReadAndWatchRequest, stream pbresource.ResourceService_ReadAndWatchServer) error {
+	reg, err := s.ensureReadRequestValid(&pbresource.ReadRequest{Id: req.Id})
+	if err != nil {
+		return err
+	}
+
+	token := tokenFromContext(stream.Context())
+	authz, authzContext, err := s.resolveTenancy(token, reg, req.Id.Tenancy)
+	if err != nil {
+		return err
+	}
+
+	// As in Read, the ACL check comes before the tenancy existence check unless
+	// it requires the data payload to function.
+	authzNeedsData := false
+	err = reg.ACLs.Read(authz, authzContext, req.Id, nil)
+	switch {
+	case errors.Is(err, resource.ErrNeedResource):
+		authzNeedsData = true
+	case acl.IsErrPermissionDenied(err):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		return status.Errorf(codes.Internal, "failed read acl: %v", err)
+	}
+
+	if err = tenancyExists(reg, s.TenancyBridge, req.Id.Tenancy, codes.NotFound); err != nil {
+		return err
+	}
+
+	// The name prefix narrows the watch down to the resource and those whose
+	// names it prefixes, which are filtered out below. The storage backend
+	// emits the snapshot and subsequent events as of the same index, which is
+	// what guarantees no changes are missed between the read and the watch.
+	watch, err := s.Backend.WatchList(
+		stream.Context(),
+		storage.UnversionedTypeFrom(req.Id.Type),
+		req.Id.Tenancy,
+		req.Id.Name,
+	)
+	if err != nil {
+		return err
+	}
+	defer watch.Close()
+
+	snapshot := true
+	var current *pbresource.Resource
+	for {
+		event, err := watch.Next(stream.Context())
+		switch {
+		case errors.Is(err, storage.ErrWatchClosed):
+			return status.Error(codes.Aborted, "watch closed by the storage backend (possibly due to snapshot restoration)")
+		case err != nil:
+			return status.Errorf(codes.Internal, "failed next: %v", err)
+		}
+
+		if event.Operation == pbresource.WatchEvent_OPERATION_END_OF_SNAPSHOT {
+			if !snapshot {
+				continue
+			}
+			snapshot = false
+
+			if current == nil || (req.Id.Uid != "" && current.Id.Uid != req.Id.Uid) {
+				return status.Error(codes.NotFound, storage.ErrNotFound.Error())
+			}
+			if current.Id.Type.GroupVersion != req.Id.Type.GroupVersion {
+				return status.Error(codes.InvalidArgument, storage.GroupVersionMismatchError{
+					RequestedType: req.Id.Type,
+					Stored:        current,
+				}.Error())
+			}
+			if authzNeedsData {
+				if err = s.checkReadAndWatchACL(reg, authz, authzContext, current); err != nil {
+					return err
+				}
+			}
+			if err = stream.Send(&pbresource.WatchEvent{
+				Operation: pbresource.WatchEvent_OPERATION_UPSERT,
+				Resource:  current,
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if event.Resource.Id.Name != req.Id.Name {
+			continue
+		}
+
+		if snapshot {
+			current = event.Resource
+			continue
+		}
+
+		// drop group versions that don't match
+		if event.Resource.Id.Type.GroupVersion != req.Id.Type.GroupVersion {
+			continue
+		}
+
+		// Re-resolve the token for each event so that revoking the caller's
+		// access takes effect on an open stream, rather than only once it is
+		// reopened.
+		authz, authzContext, err = s.getAuthorizer(token, v2TenancyToV1EntMeta(req.Id.Tenancy))
+		if err != nil {
+			return err
+		}
+		err = s.checkReadAndWatchACL(reg, authz, authzContext, event.Resource)
+		switch {
+		case status.Code(err) == codes.PermissionDenied && authzNeedsData:
+			// Access depends on the resource's data, so later versions of it
+			// may be readable again.
+			continue
+		case err != nil:
+			return err
+		}
+
+		if err = stream.Send(event); err != nil {
+			return err
+		}
+	}
+}
+
+// checkReadAndWatchACL performs the read ACL check for ACL hooks that require
+// the resource's data payload.
+func (s *Server) checkReadAndWatchACL(reg *resource.Registration, authz acl.Authorizer, authzContext *acl.AuthorizerContext, res *pbresource.Resource) error {
+	err := reg.ACLs.Read(authz, authzContext, res.Id, res)
+	switch {
+	case acl.IsErrPermissionDenied(err):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		return status.Errorf(codes.Internal, "failed read acl: %v", err)
+	}
+	return nil
+}
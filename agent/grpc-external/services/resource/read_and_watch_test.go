@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/hashicorp/consul/agent/grpc-external/testutils"
+	"github.com/hashicorp/consul/internal/catalog"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/resource/demo"
+	rtest "github.com/hashicorp/consul/internal/resource/resourcetest"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	pbdemov2 "github.com/hashicorp/consul/proto/private/pbdemo/v2"
+	"github.com/hashicorp/consul/proto/private/prototest"
+)
+
+func TestReadAndWatch_NotFound(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+
+	stream, err := client.ReadAndWatch(ctx, &pbresource.ReadAndWatchRequest{Id: artist.Id})
+	require.NoError(t, err)
+	err = mustGetError(t, handleResourceStream(t, stream))
+	require.Equal(t, codes.NotFound.String(), status.Code(err).String())
+}
+
+func TestReadAndWatch_GroupVersionMismatch(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	artist, err = server.Backend.WriteCAS(ctx, artist)
+	require.NoError(t, err)
+
+	id := clone(artist.Id)
+	id.Type = demo.TypeV1Artist
+
+	stream, err := client.ReadAndWatch(ctx, &pbresource.ReadAndWatchRequest{Id: id})
+	require.NoError(t, err)
+	err = mustGetError(t, handleResourceStream(t, stream))
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.Contains(t, err.Error(), "resource was requested with GroupVersion")
+}
+
+func TestReadAndWatch_NoGap(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	artist.Id.Name = "artist"
+	artist, err = server.Backend.WriteCAS(ctx, artist)
+	require.NoError(t, err)
+
+	// A resource whose name the artist's prefixes must not be delivered.
+	sibling, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	sibling.Id.Name = "artist-2"
+	_, err = server.Backend.WriteCAS(ctx, sibling)
+	require.NoError(t, err)
+
+	stream, err := client.ReadAndWatch(ctx, &pbresource.ReadAndWatchRequest{Id: artist.Id})
+	require.NoError(t, err)
+	rspCh := handleResourceStream(t, stream)
+
+	// Change the artist straight away: the change lands either before the read,
+	// in which case it's the initial state, or after it, in which case it's
+	// delivered as an event. Either way it is delivered exactly once.
+	updated := writeArtistGenre(t, server, artist, pbdemov2.Genre_GENRE_BLUES)
+
+	rsp := mustGetResource(t, rspCh)
+	require.Equal(t, pbresource.WatchEvent_OPERATION_UPSERT, rsp.Operation)
+	if rsp.Resource.Version != updated.Version {
+		prototest.AssertDeepEqual(t, artist, rsp.Resource)
+
+		rsp = mustGetResource(t, rspCh)
+		require.Equal(t, pbresource.WatchEvent_OPERATION_UPSERT, rsp.Operation)
+	}
+	prototest.AssertDeepEqual(t, updated, rsp.Resource)
+	mustGetNoResource(t, rspCh)
+
+	// Deleting the resource emits a delete event.
+	require.NoError(t, server.Backend.DeleteCAS(ctx, updated.Id, updated.Version))
+	rsp = mustGetResource(t, rspCh)
+	require.Equal(t, pbresource.WatchEvent_OPERATION_DELETE, rsp.Operation)
+	prototest.AssertDeepEqual(t, updated.Id, rsp.Resource.Id)
+}
+
+func TestReadAndWatch_Node(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	node := rtest.Resource(pbcatalog.NodeType, "node-1").
+		WithTenancy(resource.DefaultPartitionedTenancy()).
+		WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+		Write(t, client)
+
+	stream, err := client.ReadAndWatch(ctx, &pbresource.ReadAndWatchRequest{Id: node.Id})
+	require.NoError(t, err)
+	rspCh := handleResourceStream(t, stream)
+
+	// The initial state is emitted first.
+	rsp := mustGetResource(t, rspCh)
+	require.Equal(t, pbresource.WatchEvent_OPERATION_UPSERT, rsp.Operation)
+	prototest.AssertDeepEqual(t, node, rsp.Resource)
+
+	// Updating the node's data emits an update event.
+	updated := rtest.ResourceID(node.Id).
+		WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.2"}}}).
+		Write(t, client)
+	rsp = mustGetResource(t, rspCh)
+	require.Equal(t, pbresource.WatchEvent_OPERATION_UPSERT, rsp.Operation)
+	prototest.AssertDeepEqual(t, updated, rsp.Resource)
+
+	// Deleting the node emits a delete event.
+	_, err = client.Delete(ctx, &pbresource.DeleteRequest{Id: updated.Id})
+	require.NoError(t, err)
+	rsp = mustGetResource(t, rspCh)
+	require.Equal(t, pbresource.WatchEvent_OPERATION_DELETE, rsp.Operation)
+	prototest.AssertDeepEqual(t, updated.Id, rsp.Resource.Id)
+	mustGetNoResource(t, rspCh)
+}
+
+// N.B. Uses key ACLs for now. See demo.RegisterTypes()
+func TestReadAndWatch_ACLReevaluated(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	// The caller can read the artist when the stream is opened, but their
+	// access is revoked afterwards.
+	mockACLResolver := &MockACLResolver{}
+	mockACLResolver.On("ResolveTokenAndDefaultMeta", mock.Anything, mock.Anything, mock.Anything).
+		Return(AuthorizerFrom(t, demo.ArtistV2ReadPolicy), nil).
+		Once()
+	mockACLResolver.On("ResolveTokenAndDefaultMeta", mock.Anything, mock.Anything, mock.Anything).
+		Return(testutils.ACLNoPermissions(t), nil)
+	server.ACLResolver = mockACLResolver
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	artist, err = server.Backend.WriteCAS(ctx, artist)
+	require.NoError(t, err)
+
+	stream, err := client.ReadAndWatch(ctx, &pbresource.ReadAndWatchRequest{Id: artist.Id})
+	require.NoError(t, err)
+	rspCh := handleResourceStream(t, stream)
+
+	rsp := mustGetResource(t, rspCh)
+	prototest.AssertDeepEqual(t, artist, rsp.Resource)
+
+	// The next event is checked against the revoked access, ending the stream.
+	writeArtistGenre(t, server, artist, pbdemov2.Genre_GENRE_BLUES)
+	err = mustGetError(t, rspCh)
+	require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+}
+
+func writeArtistGenre(t *testing.T, server *Server, artist *pbresource.Resource, genre pbdemov2.Genre) *pbresource.Resource {
+	t.Helper()
+
+	var data pbdemov2.Artist
+	require.NoError(t, artist.Data.UnmarshalTo(&data))
+	data.Genre = genre
+
+	updated := clone(artist)
+	var err error
+	updated.Data, err = anypb.New(&data)
+	require.NoError(t, err)
+
+	updated, err = server.Backend.WriteCAS(context.Background(), updated)
+	require.NoError(t, err)
+	return updated
+}
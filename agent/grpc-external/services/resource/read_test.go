@@ -5,26 +5,39 @@ package resource
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/acl/resolver"
 	"github.com/hashicorp/consul/agent/grpc-external/testutils"
+	"github.com/hashicorp/consul/internal/catalog"
 	"github.com/hashicorp/consul/internal/resource"
 	"github.com/hashicorp/consul/internal/resource/demo"
+	rtest "github.com/hashicorp/consul/internal/resource/resourcetest"
 	"github.com/hashicorp/consul/internal/storage"
 	"github.com/hashicorp/consul/internal/tenancy"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
 	"github.com/hashicorp/consul/proto-public/pbresource"
+	pbdemov2 "github.com/hashicorp/consul/proto/private/pbdemo/v2"
 	"github.com/hashicorp/consul/proto/private/prototest"
 	"github.com/hashicorp/consul/sdk/testutil"
 )
@@ -290,6 +303,448 @@ func TestRead_Success(t *testing.T) {
 	}
 }
 
+func TestRead_GroupVersionHeader(t *testing.T) {
+	for desc, tc := range readTestCases() {
+		t.Run(desc, func(t *testing.T) {
+			server := testServer(t)
+			demo.RegisterTypes(server.Registry)
+			client := testClient(t, server)
+
+			artist, err := demo.GenerateV2Artist()
+			require.NoError(t, err)
+			artist, err = server.Backend.WriteCAS(tc.ctx, artist)
+			require.NoError(t, err)
+
+			var header metadata.MD
+			_, err = client.Read(tc.ctx, &pbresource.ReadRequest{Id: artist.Id}, grpc.Header(&header))
+			require.NoError(t, err)
+
+			reg, ok := server.Registry.Resolve(demo.TypeV2Artist)
+			require.True(t, ok)
+			require.Equal(t, []string{reg.Type.GroupVersion}, header.Get(groupVersionHeader))
+		})
+	}
+}
+
+func TestRead_IncludeSize(t *testing.T) {
+	server := testServer(t)
+	demo.RegisterTypes(server.Registry)
+	client := testClient(t, server)
+	ctx := testContext(t)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	artist, err = server.Backend.WriteCAS(ctx, artist)
+	require.NoError(t, err)
+
+	// Sizes are only reported when requested.
+	rsp, err := client.Read(ctx, &pbresource.ReadRequest{Id: artist.Id})
+	require.NoError(t, err)
+	require.Zero(t, rsp.Size)
+	require.Zero(t, rsp.OwnedSize)
+
+	rsp, err = client.Read(ctx, &pbresource.ReadRequest{Id: artist.Id, IncludeSize: true})
+	require.NoError(t, err)
+	require.Equal(t, uint64(proto.Size(rsp.Resource)), rsp.Size)
+	require.Zero(t, rsp.OwnedSize)
+
+	var expectedOwnedSize uint64
+	for i := 0; i < 3; i++ {
+		album, err := demo.GenerateV2Album(artist.Id)
+		require.NoError(t, err)
+		// Generated names may collide, so make them unique.
+		album.Id.Name = fmt.Sprintf("album-%d", i)
+		album, err = server.Backend.WriteCAS(ctx, album)
+		require.NoError(t, err)
+		expectedOwnedSize += uint64(proto.Size(album))
+
+		rsp, err = client.Read(ctx, &pbresource.ReadRequest{Id: artist.Id, IncludeSize: true})
+		require.NoError(t, err)
+		require.Equal(t, uint64(proto.Size(rsp.Resource)), rsp.Size)
+		require.Equal(t, expectedOwnedSize, rsp.OwnedSize)
+	}
+}
+
+func TestRead_DecodeData(t *testing.T) {
+	server := testServer(t)
+	catalog.RegisterTypes(server.Registry)
+	client := testClient(t, server)
+	ctx := testContext(t)
+
+	node := rtest.Resource(pbcatalog.NodeType, "node-1").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		WithData(t, &pbcatalog.Node{
+			Addresses: []*pbcatalog.NodeAddress{
+				{Host: "198.18.0.1"},
+				{Host: "198.18.0.2", External: true},
+			},
+		}).
+		Write(t, client)
+
+	// The decoded data is only returned when requested.
+	rsp, err := client.Read(ctx, &pbresource.ReadRequest{Id: node.Id})
+	require.NoError(t, err)
+	require.Nil(t, rsp.DecodedData)
+
+	rsp, err = client.Read(ctx, &pbresource.ReadRequest{Id: node.Id, DecodeData: true})
+	require.NoError(t, err)
+	// The opaque Any is still returned as usual.
+	prototest.AssertDeepEqual(t, node, rsp.Resource)
+
+	addresses := rsp.DecodedData.AsMap()["addresses"].([]any)
+	require.Len(t, addresses, 2)
+	require.Equal(t, map[string]any{"host": "198.18.0.1"}, addresses[0])
+	require.Equal(t, map[string]any{"host": "198.18.0.2", "external": true}, addresses[1])
+}
+
+func TestRead_CanonicalEncoding(t *testing.T) {
+	server := testServer(t)
+	catalog.RegisterTypes(server.Registry)
+	client := testClient(t, server)
+	ctx := testContext(t)
+
+	nodeData := &pbcatalog.Node{
+		Addresses: []*pbcatalog.NodeAddress{
+			{Host: "198.18.0.1"},
+			{Host: "198.18.0.2", External: true},
+		},
+	}
+	node := rtest.Resource(pbcatalog.NodeType, "node-1").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		WithData(t, nodeData).
+		Write(t, client)
+
+	// The canonical encoding is only returned when requested.
+	rsp, err := client.Read(ctx, &pbresource.ReadRequest{Id: node.Id})
+	require.NoError(t, err)
+	require.Nil(t, rsp.CanonicalData)
+	require.Empty(t, rsp.CanonicalDigest)
+
+	first, err := client.Read(ctx, &pbresource.ReadRequest{Id: node.Id, CanonicalEncoding: true})
+	require.NoError(t, err)
+	require.Equal(t, `{"addresses":[{"host":"198.18.0.1"},{"external":true,"host":"198.18.0.2"}]}`, string(first.CanonicalData))
+	sum := sha256.Sum256(first.CanonicalData)
+	require.Equal(t, "sha256:"+hex.EncodeToString(sum[:]), first.CanonicalDigest)
+
+	second, err := client.Read(ctx, &pbresource.ReadRequest{Id: node.Id, CanonicalEncoding: true})
+	require.NoError(t, err)
+	require.Equal(t, first.CanonicalData, second.CanonicalData)
+	require.Equal(t, first.CanonicalDigest, second.CanonicalDigest)
+
+	// Rewriting the resource with the same data doesn't change the encoding.
+	rewritten := clone(first.Resource)
+	rewritten.Data.Value, err = proto.MarshalOptions{Deterministic: true}.Marshal(nodeData)
+	require.NoError(t, err)
+	_, err = client.Write(ctx, &pbresource.WriteRequest{Resource: rewritten})
+	require.NoError(t, err)
+
+	third, err := client.Read(ctx, &pbresource.ReadRequest{Id: node.Id, CanonicalEncoding: true})
+	require.NoError(t, err)
+	require.Equal(t, first.CanonicalData, third.CanonicalData)
+	require.Equal(t, first.CanonicalDigest, third.CanonicalDigest)
+}
+
+func TestRead_DerivedFields(t *testing.T) {
+	server := testServer(t)
+	catalog.RegisterTypes(server.Registry)
+	client := testClient(t, server)
+	ctx := testContext(t)
+
+	node := rtest.Resource(pbcatalog.NodeType, "node-1").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		WithData(t, &pbcatalog.Node{
+			Addresses: []*pbcatalog.NodeAddress{
+				{Host: "198.18.0.2", External: true},
+				{Host: "198.18.0.1"},
+			},
+		}).
+		Write(t, client)
+
+	// The derived fields are only computed when requested.
+	rsp, err := client.Read(ctx, &pbresource.ReadRequest{Id: node.Id})
+	require.NoError(t, err)
+	require.Nil(t, rsp.DerivedFields)
+
+	rsp, err = client.Read(ctx, &pbresource.ReadRequest{Id: node.Id, IncludeDerivedFields: true})
+	require.NoError(t, err)
+	prototest.AssertDeepEqual(t, node, rsp.Resource)
+	require.Len(t, rsp.DerivedFields, 1)
+	require.Equal(t, "198.18.0.1", rsp.DerivedFields["primary_address"].GetStringValue())
+}
+
+func TestRead_Minimal(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+
+	dr := &dummyACLResolver{
+		result: testutils.ACLsDisabled(t),
+	}
+	server.ACLResolver = dr
+
+	demo.RegisterTypes(server.Registry)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	artist.Metadata = map[string]string{"label": "indie"}
+	rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+	artist = rsp.Resource
+
+	_, err = client.WriteStatus(testContext(t), &pbresource.WriteStatusRequest{
+		Id:     artist.Id,
+		Key:    "consul.io/artist-controller",
+		Status: &pbresource.Status{ObservedGeneration: artist.Generation},
+	})
+	require.NoError(t, err)
+
+	testutil.RunStep(t, "normal read includes metadata", func(t *testing.T) {
+		rsp, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id})
+		require.NoError(t, err)
+		require.Equal(t, artist.Metadata, rsp.Resource.Metadata)
+		require.NotEmpty(t, rsp.Resource.Status)
+	})
+
+	testutil.RunStep(t, "minimal read omits metadata", func(t *testing.T) {
+		rsp, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id, Minimal: true})
+		require.NoError(t, err)
+		prototest.AssertDeepEqual(t, &pbresource.Resource{
+			Id:      artist.Id,
+			Version: rsp.Resource.Version,
+			Data:    artist.Data,
+		}, rsp.Resource)
+		require.NotEmpty(t, rsp.Resource.Version)
+	})
+
+	testutil.RunStep(t, "minimal read checks acls", func(t *testing.T) {
+		dr.SetResult(AuthorizerFrom(t, demo.ArtistV1ReadPolicy))
+		//nolint:staticcheck
+		ctx := context.WithValue(testContext(t), "x-consul-token", "minimal-reader")
+
+		_, err := client.Read(ctx, &pbresource.ReadRequest{Id: artist.Id, Minimal: true})
+		require.Error(t, err)
+		require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+	})
+}
+
+func TestRead_IncludeTombstone(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+
+	dr := &dummyACLResolver{
+		result: testutils.ACLsDisabled(t),
+	}
+	server.ACLResolver = dr
+
+	catalog.RegisterTypes(server.Registry)
+
+	node := rtest.Resource(pbcatalog.NodeType, "node-1").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+		Write(t, client)
+
+	beforeDelete := time.Now().Truncate(time.Millisecond)
+	_, err := client.Delete(testContext(t), &pbresource.DeleteRequest{Id: node.Id})
+	require.NoError(t, err)
+
+	testutil.RunStep(t, "deleted resource is not found by default", func(t *testing.T) {
+		_, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: node.Id})
+		require.Equal(t, codes.NotFound.String(), status.Code(err).String())
+	})
+
+	testutil.RunStep(t, "tombstone returned for deleted resource", func(t *testing.T) {
+		// The tombstone is found with or without the Uid.
+		id := clone(node.Id)
+		id.Uid = ""
+		for _, id := range []*pbresource.ID{node.Id, id} {
+			rsp, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: id, IncludeTombstone: true})
+			require.NoError(t, err)
+			require.Nil(t, rsp.Resource)
+			prototest.AssertDeepEqual(t, node.Id, rsp.Tombstone.Id)
+
+			deletedAt := rsp.Tombstone.DeletedAt.AsTime()
+			require.False(t, deletedAt.Before(beforeDelete))
+			require.False(t, deletedAt.After(time.Now()))
+		}
+	})
+
+	testutil.RunStep(t, "never seen resource is not found", func(t *testing.T) {
+		for name, id := range map[string]*pbresource.ID{
+			"other uid":  {Type: node.Id.Type, Tenancy: node.Id.Tenancy, Name: node.Id.Name, Uid: "01HZZZZZZZZZZZZZZZZZZZZZZZ"},
+			"other name": {Type: node.Id.Type, Tenancy: node.Id.Tenancy, Name: "node"},
+		} {
+			_, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: id, IncludeTombstone: true})
+			require.Equal(t, codes.NotFound.String(), status.Code(err).String(), name)
+		}
+	})
+
+	testutil.RunStep(t, "tombstone checks acls", func(t *testing.T) {
+		dr.SetResult(AuthorizerFrom(t, `node "other" { policy = "read" }`))
+		//nolint:staticcheck
+		ctx := context.WithValue(testContext(t), "x-consul-token", "other-node-reader")
+
+		_, err := client.Read(ctx, &pbresource.ReadRequest{Id: node.Id, IncludeTombstone: true})
+		require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+	})
+}
+
+func TestRead_FieldMask(t *testing.T) {
+	server := testServer(t)
+	catalog.RegisterTypes(server.Registry)
+	client := testClient(t, server)
+	ctx := testContext(t)
+
+	node := rtest.Resource(pbcatalog.NodeType, "node-1").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+		WithMeta("rack", "r1").
+		Write(t, client)
+
+	_, err := client.WriteStatus(ctx, &pbresource.WriteStatusRequest{
+		Id:  node.Id,
+		Key: "consul.io/node-health",
+		Status: &pbresource.Status{
+			ObservedGeneration: node.Generation,
+			Conditions:         []*pbresource.Condition{{Type: "healthy", State: pbresource.Condition_STATE_TRUE}},
+		},
+	})
+	require.NoError(t, err)
+	readRsp, err := client.Read(ctx, &pbresource.ReadRequest{Id: node.Id})
+	require.NoError(t, err)
+	node = readRsp.Resource
+
+	testutil.RunStep(t, "status only", func(t *testing.T) {
+		rsp, err := client.Read(ctx, &pbresource.ReadRequest{
+			Id:        node.Id,
+			FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+		})
+		require.NoError(t, err)
+		require.Nil(t, rsp.Resource.Data)
+		require.Nil(t, rsp.Resource.Metadata)
+		prototest.AssertDeepEqual(t, &pbresource.Resource{
+			Id:     node.Id,
+			Status: node.Status,
+		}, rsp.Resource)
+	})
+
+	testutil.RunStep(t, "data and metadata", func(t *testing.T) {
+		rsp, err := client.Read(ctx, &pbresource.ReadRequest{
+			Id:        node.Id,
+			FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"data", "metadata"}},
+		})
+		require.NoError(t, err)
+		prototest.AssertDeepEqual(t, &pbresource.Resource{
+			Id:       node.Id,
+			Data:     node.Data,
+			Metadata: node.Metadata,
+		}, rsp.Resource)
+	})
+
+	testutil.RunStep(t, "empty mask returns everything", func(t *testing.T) {
+		rsp, err := client.Read(ctx, &pbresource.ReadRequest{
+			Id:        node.Id,
+			FieldMask: &fieldmaskpb.FieldMask{},
+		})
+		require.NoError(t, err)
+		prototest.AssertDeepEqual(t, node, rsp.Resource)
+	})
+
+	testutil.RunStep(t, "invalid paths", func(t *testing.T) {
+		for _, path := range []string{"unknown", "id.name", "Data", ""} {
+			_, err := client.Read(ctx, &pbresource.ReadRequest{
+				Id:        node.Id,
+				FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"status", path}},
+			})
+			require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String(), path)
+			require.ErrorContains(t, err, "field_mask")
+		}
+	})
+}
+
+func TestRead_StatusRollup(t *testing.T) {
+	server := testServer(t)
+	catalog.RegisterTypes(server.Registry)
+	client := testClient(t, server)
+	ctx := testContext(t)
+
+	node := rtest.Resource(pbcatalog.NodeType, "node-1").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+		Write(t, client)
+
+	// The rollup is only computed when requested.
+	rsp, err := client.Read(ctx, &pbresource.ReadRequest{Id: node.Id})
+	require.NoError(t, err)
+	require.Nil(t, rsp.StatusRollup)
+
+	rsp, err = client.Read(ctx, &pbresource.ReadRequest{Id: node.Id, IncludeStatusRollup: true})
+	require.NoError(t, err)
+	prototest.AssertDeepEqual(t, &pbresource.StatusRollup{Summary: "0 of 0 controllers report healthy"}, rsp.StatusRollup)
+
+	// Two controllers disagree about the node.
+	for key, state := range map[string]pbresource.Condition_State{
+		"consul.io/healthy-controller":   pbresource.Condition_STATE_TRUE,
+		"consul.io/unhealthy-controller": pbresource.Condition_STATE_FALSE,
+	} {
+		_, err := client.WriteStatus(ctx, &pbresource.WriteStatusRequest{
+			Id:  node.Id,
+			Key: key,
+			Status: &pbresource.Status{
+				ObservedGeneration: node.Generation,
+				Conditions: []*pbresource.Condition{
+					{Type: "healthy", State: pbresource.Condition_STATE_TRUE},
+					{Type: "ready", State: state},
+				},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	rsp, err = client.Read(ctx, &pbresource.ReadRequest{Id: node.Id, IncludeStatusRollup: true})
+	require.NoError(t, err)
+	prototest.AssertDeepEqual(t, &pbresource.StatusRollup{
+		Controllers:        2,
+		HealthyControllers: 1,
+		UnhealthyKeys:      []string{"consul.io/unhealthy-controller"},
+		Summary:            "1 of 2 controllers report healthy",
+	}, rsp.StatusRollup)
+}
+
+func TestRead_Tenancy_MarkedForDeletion(t *testing.T) {
+	for desc, failReads := range map[string]bool{"reads allowed": false, "reads failed": true} {
+		t.Run(desc, func(t *testing.T) {
+			server := testServer(t)
+			client := testClient(t, server)
+			demo.RegisterTypes(server.Registry)
+			server.FailReadsInDeletingTenancy = failReads
+
+			artist, err := demo.GenerateV2Artist()
+			require.NoError(t, err)
+			artist.Id.Tenancy.Partition = "ap1"
+			artist.Id.Tenancy.Namespace = "ns1"
+			artist.Id.Uid = "01HCY8Z8AHGW1RFAQ5RNQ3N1DZ"
+			_, err = server.Backend.WriteCAS(testContext(t), artist)
+			require.NoError(t, err)
+
+			mockTenancyBridge := &MockTenancyBridge{}
+			mockTenancyBridge.On("PartitionExists", "ap1").Return(true, nil)
+			mockTenancyBridge.On("NamespaceExists", "ap1", "ns1").Return(true, nil)
+			mockTenancyBridge.On("IsPartitionMarkedForDeletion", "ap1").Return(false, nil)
+			mockTenancyBridge.On("IsNamespaceMarkedForDeletion", "ap1", "ns1").Return(true, nil)
+			server.TenancyBridge = mockTenancyBridge
+
+			rsp, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id})
+			if !failReads {
+				require.NoError(t, err)
+				prototest.AssertDeepEqual(t, artist.Id, rsp.Resource.Id)
+				return
+			}
+			require.Error(t, err)
+			require.Equal(t, codes.FailedPrecondition.String(), status.Code(err).String())
+			require.Contains(t, err.Error(), "namespace marked for deletion")
+		})
+	}
+}
+
 func TestRead_VerifyReadConsistencyArg(t *testing.T) {
 	// Uses a mockBackend instead of the inmem Backend to verify the ReadConsistency argument is set correctly.
 	for desc, tc := range readTestCases() {
@@ -313,6 +768,210 @@ func TestRead_VerifyReadConsistencyArg(t *testing.T) {
 	}
 }
 
+func TestRead_DefaultReadConsistency(t *testing.T) {
+	// Uses a mockBackend to verify that the type's default consistency is used
+	// when the request doesn't specify one.
+	consistentType := &pbresource.Type{Group: "demo", GroupVersion: "v2", Kind: "ConsistentArtist"}
+
+	testCases := map[string]struct {
+		typ         *pbresource.Type
+		ctx         context.Context
+		consistency storage.ReadConsistency
+	}{
+		"eventual default": {
+			typ:         demo.TypeV2Artist,
+			ctx:         context.Background(),
+			consistency: storage.EventualConsistency,
+		},
+		"consistent default": {
+			typ:         consistentType,
+			ctx:         context.Background(),
+			consistency: storage.StrongConsistency,
+		},
+		"request overrides consistent default": {
+			typ: consistentType,
+			ctx: metadata.NewOutgoingContext(
+				context.Background(),
+				metadata.New(map[string]string{"x-consul-consistency-mode": "eventual"}),
+			),
+			consistency: storage.EventualConsistency,
+		},
+	}
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			server := testServer(t)
+			mockBackend := NewMockBackend(t)
+			server.Backend = mockBackend
+			demo.RegisterTypes(server.Registry)
+			server.Registry.Register(resource.Registration{
+				Type:                   consistentType,
+				Proto:                  &pbdemov2.Artist{},
+				Scope:                  resource.ScopeNamespace,
+				DefaultReadConsistency: storage.StrongConsistency,
+			})
+
+			artist, err := demo.GenerateV2Artist()
+			require.NoError(t, err)
+			artist.Id.Type = tc.typ
+
+			mockBackend.On("Read", mock.Anything, mock.Anything, mock.Anything).Return(artist, nil)
+			client := testClient(t, server)
+
+			_, err = client.Read(tc.ctx, &pbresource.ReadRequest{Id: artist.Id})
+			require.NoError(t, err)
+			mockBackend.AssertCalled(t, "Read", mock.Anything, tc.consistency, mock.Anything)
+		})
+	}
+}
+
+func TestRead_ConsistencyOverride(t *testing.T) {
+	// Uses a mockBackend to verify that the request's consistency takes
+	// precedence over the x-consul-consistency-mode metadata.
+	testCases := map[string]struct {
+		mode        string
+		requested   pbresource.Consistency
+		consistency storage.ReadConsistency
+	}{
+		"leader overrides eventual mode": {
+			mode:        "eventual",
+			requested:   pbresource.Consistency_CONSISTENCY_LEADER,
+			consistency: storage.StrongConsistency,
+		},
+		"eventual overrides consistent mode": {
+			mode:        "consistent",
+			requested:   pbresource.Consistency_CONSISTENCY_EVENTUAL,
+			consistency: storage.EventualConsistency,
+		},
+		"unspecified uses mode": {
+			mode:        "consistent",
+			requested:   pbresource.Consistency_CONSISTENCY_UNSPECIFIED,
+			consistency: storage.StrongConsistency,
+		},
+	}
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			server := testServer(t)
+			mockBackend := NewMockBackend(t)
+			server.Backend = mockBackend
+			demo.RegisterTypes(server.Registry)
+
+			artist, err := demo.GenerateV2Artist()
+			require.NoError(t, err)
+
+			mockBackend.On("Read", mock.Anything, mock.Anything, mock.Anything).Return(artist, nil)
+			client := testClient(t, server)
+
+			ctx := metadata.NewOutgoingContext(
+				testContext(t),
+				metadata.New(map[string]string{"x-consul-consistency-mode": tc.mode}),
+			)
+			_, err = client.Read(ctx, &pbresource.ReadRequest{Id: artist.Id, Consistency: tc.requested})
+			require.NoError(t, err)
+			mockBackend.AssertCalled(t, "Read", mock.Anything, tc.consistency, mock.Anything)
+		})
+	}
+}
+
+func TestRead_LeaderConsistencyNoLeader(t *testing.T) {
+	server := testServer(t)
+	mockBackend := NewMockBackend(t)
+	server.Backend = mockBackend
+	demo.RegisterTypes(server.Registry)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+
+	mockBackend.On("Read", mock.Anything, storage.StrongConsistency, mock.Anything).Return(nil, storage.ErrNoLeader)
+	client := testClient(t, server)
+
+	_, err = client.Read(testContext(t), &pbresource.ReadRequest{
+		Id:          artist.Id,
+		Consistency: pbresource.Consistency_CONSISTENCY_LEADER,
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable.String(), status.Code(err).String())
+}
+
+func TestRead_LeaderConsistencyAfterWrite(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+
+	rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+
+	strongRead := func(t *testing.T, id *pbresource.ID) *pbresource.Resource {
+		t.Helper()
+
+		rsp, err := client.Read(testContext(t), &pbresource.ReadRequest{
+			Id:          id,
+			Consistency: pbresource.Consistency_CONSISTENCY_LEADER,
+		})
+		require.NoError(t, err)
+		return rsp.Resource
+	}
+
+	read := strongRead(t, rsp.Resource.Id)
+	require.Equal(t, rsp.Resource.Version, read.Version)
+
+	updated := writeArtistGenre(t, server, read, pbdemov2.Genre_GENRE_BLUES)
+	read = strongRead(t, updated.Id)
+	require.Equal(t, updated.Version, read.Version)
+}
+
+func TestRead_ConsistentTenancyExists(t *testing.T) {
+	server := testServer(t)
+	demo.RegisterTypes(server.Registry)
+
+	// Simulate a follower that hasn't yet seen a freshly created namespace,
+	// which the leader already knows about.
+	server.TenancyBridge = &staleTenancyBridge{
+		TenancyBridge: server.TenancyBridge,
+		namespaces:    map[string]bool{"fresh": true},
+	}
+	client := testClient(t, server)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	artist.Id.Tenancy.Namespace = "fresh"
+	artist, err = server.Backend.WriteCAS(testContext(t), artist)
+	require.NoError(t, err)
+
+	cases := readTestCases()
+
+	_, err = client.Read(cases["eventually consistent read"].ctx, &pbresource.ReadRequest{Id: artist.Id})
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound.String(), status.Code(err).String())
+	require.ErrorContains(t, err, "namespace not found")
+
+	rsp, err := client.Read(cases["strongly consistent read"].ctx, &pbresource.ReadRequest{Id: artist.Id})
+	require.NoError(t, err)
+	prototest.AssertDeepEqual(t, artist, rsp.Resource)
+}
+
+// staleTenancyBridge answers eventually consistent existence checks using the
+// embedded TenancyBridge and strongly consistent ones using namespaces.
+type staleTenancyBridge struct {
+	TenancyBridge
+	namespaces map[string]bool
+}
+
+var _ ConsistentTenancyBridge = (*staleTenancyBridge)(nil)
+
+func (b *staleTenancyBridge) PartitionExistsConsistent(partition string) (bool, error) {
+	return b.PartitionExists(partition)
+}
+
+func (b *staleTenancyBridge) NamespaceExistsConsistent(partition, namespace string) (bool, error) {
+	if b.namespaces[namespace] {
+		return true, nil
+	}
+	return b.NamespaceExists(partition, namespace)
+}
+
 // N.B. Uses key ACLs for now. See demo.RegisterTypes()
 func TestRead_ACLs(t *testing.T) {
 	type testCase struct {
@@ -348,6 +1007,21 @@ func TestRead_ACLs(t *testing.T) {
 			codeNotExist: codes.NotFound,
 			codeExists:   codes.PermissionDenied,
 		},
+		// Partition-scoped resources referenced by cluster-scoped ones (e.g. a
+		// label's executive) are read with their own tenancy, which is what the
+		// read ACL is checked against.
+		"label-v1/read hook allowed": {
+			res:          label,
+			authz:        AuthorizerFrom(t, `key_prefix "resource/demo.v1.RecordLabel/" { policy = "read" }`),
+			codeNotExist: codes.NotFound,
+			codeExists:   codes.OK,
+		},
+		"label-v1/cluster-scoped read policy denied": {
+			res:          label,
+			authz:        AuthorizerFrom(t, demo.ExecutiveV1ReadPolicy),
+			codeNotExist: codes.NotFound,
+			codeExists:   codes.PermissionDenied,
+		},
 	}
 
 	adminAuthz := AuthorizerFrom(t, `key_prefix "" { policy = "write" }`)
@@ -405,6 +1079,67 @@ func TestRead_ACLs(t *testing.T) {
 	}
 }
 
+func TestRead_ExplainAuthorization(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+
+	dr := &dummyACLResolver{
+		result: testutils.ACLsDisabled(t),
+	}
+	server.ACLResolver = dr
+
+	demo.RegisterTypes(server.Registry)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+	artist = rsp.Resource
+
+	operatorPolicy := `operator = "write"`
+
+	t.Run("denied read is explained", func(t *testing.T) {
+		dr.SetResult(AuthorizerFrom(t, operatorPolicy, demo.ArtistV1ReadPolicy))
+
+		rsp, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id, ExplainAuthorization: true})
+		require.NoError(t, err)
+		require.Nil(t, rsp.Resource)
+
+		explanation := rsp.AuthorizationExplanation
+		require.NotNil(t, explanation)
+		prototest.AssertDeepEqual(t, artist.Id, explanation.Id)
+		require.False(t, explanation.Allowed)
+		require.Equal(t, "key:read", explanation.RequiredPermission)
+		require.Equal(t, "resource/demo.v2.Artist/"+artist.Id.Name, explanation.AclResource)
+		require.Contains(t, explanation.Reason, "lacks permission 'key:read'")
+	})
+
+	t.Run("allowed read is explained", func(t *testing.T) {
+		dr.SetResult(AuthorizerFrom(t, operatorPolicy, demo.ArtistV2ReadPolicy))
+
+		rsp, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id, ExplainAuthorization: true})
+		require.NoError(t, err)
+		prototest.AssertDeepEqual(t, artist, rsp.Resource)
+		require.True(t, rsp.AuthorizationExplanation.Allowed)
+		require.Empty(t, rsp.AuthorizationExplanation.RequiredPermission)
+	})
+
+	t.Run("denied read is not explained without the flag", func(t *testing.T) {
+		dr.SetResult(AuthorizerFrom(t, operatorPolicy, demo.ArtistV1ReadPolicy))
+
+		_, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id})
+		require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+	})
+
+	t.Run("requires operator write", func(t *testing.T) {
+		dr.SetResult(AuthorizerFrom(t, demo.ArtistV2ReadPolicy))
+
+		_, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id, ExplainAuthorization: true})
+		require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+		require.Contains(t, err.Error(), "operator:write")
+	})
+}
+
 type dummyACLResolver struct {
 	lock   sync.Mutex
 	result resolver.Result
@@ -444,3 +1179,186 @@ func readTestCases() map[string]readTestCase {
 		},
 	}
 }
+
+func TestRead_PhaseMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("consul")
+	cfg.EnableHostname = false
+	metrics.NewGlobal(cfg, sink)
+	t.Cleanup(func() {
+		metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+	})
+
+	server := testServer(t)
+	demo.RegisterTypes(server.Registry)
+	client := testClient(t, server)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	artist, err = server.Backend.WriteCAS(testContext(t), artist)
+	require.NoError(t, err)
+
+	_, err = client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id})
+	require.NoError(t, err)
+
+	// The read may straddle the sink's intervals, so count across all of them.
+	for _, phase := range []string{"resolve_type", "authorize", "tenancy_exists"} {
+		key := fmt.Sprintf("consul.resource.read.%s;type=%s", phase, resource.ToGVK(demo.TypeV2Artist))
+		var count int
+		for _, interval := range sink.Data() {
+			if sample, ok := interval.Samples[key]; ok {
+				count += sample.Count
+			}
+		}
+		require.Equal(t, 1, count, "samples for %s", key)
+	}
+}
+
+func TestRead_IfVersionNotMatch(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+
+	dr := &dummyACLResolver{
+		result: testutils.ACLsDisabled(t),
+	}
+	server.ACLResolver = dr
+
+	demo.RegisterTypes(server.Registry)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+	artist = rsp.Resource
+
+	testutil.RunStep(t, "matching version is not modified", func(t *testing.T) {
+		rsp, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id, IfVersionNotMatch: artist.Version})
+		require.NoError(t, err)
+		require.True(t, rsp.NotModified)
+		require.Nil(t, rsp.Resource)
+	})
+
+	testutil.RunStep(t, "changed version returns the resource", func(t *testing.T) {
+		previous := artist.Version
+
+		updated := modifyArtist(t, artist)
+		wrsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: updated})
+		require.NoError(t, err)
+		artist = wrsp.Resource
+
+		rsp, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id, IfVersionNotMatch: previous})
+		require.NoError(t, err)
+		require.False(t, rsp.NotModified)
+		prototest.AssertDeepEqual(t, artist, rsp.Resource)
+	})
+
+	testutil.RunStep(t, "denied read doesn't reveal the version", func(t *testing.T) {
+		dr.SetResult(AuthorizerFrom(t, `node_prefix "" { policy = "read" }`))
+
+		_, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id, IfVersionNotMatch: artist.Version})
+		require.Error(t, err)
+		require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+	})
+}
+
+func TestRead_Migrate(t *testing.T) {
+	// nodeV2 is a hypothetical next version of the Node type, with a dedicated
+	// field for each kind of address rather than a list of them.
+	nodeV2 := &pbresource.Type{Group: "catalog", GroupVersion: "v2", Kind: "Node"}
+	migrateNode := func(res *pbresource.Resource) error {
+		var old pbcatalog.Node
+		if err := res.Data.UnmarshalTo(&old); err != nil {
+			return err
+		}
+		fields := make(map[string]any)
+		for _, addr := range old.Addresses {
+			if addr.External {
+				fields["wan_address"] = addr.Host
+			} else {
+				fields["lan_address"] = addr.Host
+			}
+		}
+		node, err := structpb.NewStruct(fields)
+		if err != nil {
+			return err
+		}
+		res.Data, err = anypb.New(node)
+		return err
+	}
+
+	setup := func(t *testing.T, migrate resource.MigrationHook) (pbresource.ResourceServiceClient, *pbresource.Resource) {
+		server := testServer(t)
+		catalog.RegisterTypes(server.Registry)
+		server.Registry.Register(resource.Registration{
+			Type:    nodeV2,
+			Proto:   &structpb.Struct{},
+			Scope:   resource.ScopeNamespace,
+			Migrate: migrate,
+		})
+		client := testClient(t, server)
+
+		node := rtest.Resource(pbcatalog.NodeType, "node-1").
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.Node{
+				Addresses: []*pbcatalog.NodeAddress{
+					{Host: "10.0.0.1"},
+					{Host: "node-1.example.com", External: true},
+				},
+			}).
+			Build()
+		node, err := server.Backend.WriteCAS(testContext(t), node)
+		require.NoError(t, err)
+		return client, node
+	}
+
+	t.Run("migrated", func(t *testing.T) {
+		client, stored := setup(t, migrateNode)
+
+		id := clone(stored.Id)
+		id.Type = nodeV2
+		rsp, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: id})
+		require.NoError(t, err)
+
+		prototest.AssertDeepEqual(t, id, rsp.Resource.Id)
+		require.Equal(t, stored.Version, rsp.Resource.Version)
+
+		var node structpb.Struct
+		require.NoError(t, rsp.Resource.Data.UnmarshalTo(&node))
+		require.Equal(t, map[string]any{
+			"lan_address": "10.0.0.1",
+			"wan_address": "node-1.example.com",
+		}, node.AsMap())
+	})
+
+	t.Run("requested version is stored", func(t *testing.T) {
+		client, stored := setup(t, func(*pbresource.Resource) error {
+			return errors.New("unexpected migration")
+		})
+
+		rsp, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: stored.Id})
+		require.NoError(t, err)
+		prototest.AssertDeepEqual(t, stored, rsp.Resource)
+	})
+
+	t.Run("migration fails", func(t *testing.T) {
+		client, stored := setup(t, func(*pbresource.Resource) error {
+			return errors.New("boom")
+		})
+
+		id := clone(stored.Id)
+		id.Type = nodeV2
+		_, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: id})
+		require.Equal(t, codes.Internal.String(), status.Code(err).String())
+		require.ErrorContains(t, err, `failed to migrate resource from GroupVersion "v2beta1": boom`)
+	})
+
+	t.Run("data not converted", func(t *testing.T) {
+		client, stored := setup(t, func(*pbresource.Resource) error { return nil })
+
+		id := clone(stored.Id)
+		id.Type = nodeV2
+		_, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: id})
+		require.Equal(t, codes.Internal.String(), status.Code(err).String())
+		require.ErrorContains(t, err, "did not convert resource data")
+	})
+}
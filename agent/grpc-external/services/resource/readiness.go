@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/storage"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+const (
+	readinessSubsystemRegistry = "registry"
+	readinessSubsystemBackend  = "backend"
+	readinessSubsystemTenancy  = "tenancy"
+)
+
+// readinessProbeID identifies a resource that never exists. It is read from the
+// backend to check that it is reachable.
+var readinessProbeID = &pbresource.ID{
+	Type:    resource.TypeV1Tombstone,
+	Tenancy: resource.DefaultPartitionedTenancy(),
+	Name:    "readiness-probe",
+}
+
+// MarkRegistryLoaded records that all resource types have been registered, so
+// that the type registry is reported as ready by the Readiness endpoint.
+func (s *Server) MarkRegistryLoaded() {
+	s.registryLoaded.Store(true)
+}
+
+func (s *Server) Readiness(ctx context.Context, _ *pbresource.ReadinessRequest) (*pbresource.ReadinessResponse, error) {
+	rsp := &pbresource.ReadinessResponse{
+		Subsystems: []*pbresource.SubsystemStatus{
+			subsystemStatus(readinessSubsystemRegistry, s.registryReady()),
+			subsystemStatus(readinessSubsystemBackend, s.backendReady(ctx)),
+			subsystemStatus(readinessSubsystemTenancy, s.tenancyReady()),
+		},
+	}
+
+	rsp.Ready = true
+	for _, sub := range rsp.Subsystems {
+		rsp.Ready = rsp.Ready && sub.Ready
+	}
+	return rsp, nil
+}
+
+func subsystemStatus(name string, err error) *pbresource.SubsystemStatus {
+	if err != nil {
+		return &pbresource.SubsystemStatus{Name: name, Message: err.Error()}
+	}
+	return &pbresource.SubsystemStatus{Name: name, Ready: true}
+}
+
+func (s *Server) registryReady() error {
+	if !s.registryLoaded.Load() {
+		return errors.New("resource types have not finished registering")
+	}
+	return nil
+}
+
+func (s *Server) backendReady(ctx context.Context) error {
+	// A strongly consistent read must reach the Raft leader, so it fails until
+	// the backend is connected to one.
+	_, err := s.Backend.Read(ctx, storage.StrongConsistency, readinessProbeID)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return fmt.Errorf("backend is not reachable: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) tenancyReady() error {
+	if _, err := s.TenancyBridge.PartitionExists(resource.DefaultPartitionName); err != nil {
+		return fmt.Errorf("tenancy bridge is not responsive: %w", err)
+	}
+	return nil
+}
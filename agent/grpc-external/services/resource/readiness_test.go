@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/internal/storage"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+func TestReadiness(t *testing.T) {
+	server := testServer(t)
+	mockBackend := NewMockBackend(t)
+	server.Backend = mockBackend
+	client := testClient(t, server)
+	ctx := testContext(t)
+
+	subsystems := func(rsp *pbresource.ReadinessResponse) map[string]bool {
+		out := make(map[string]bool)
+		for _, sub := range rsp.Subsystems {
+			out[sub.Name] = sub.Ready
+			if !sub.Ready {
+				require.NotEmpty(t, sub.Message)
+			}
+		}
+		return out
+	}
+
+	// Not ready before the registry has been loaded and the backend connected.
+	mockBackend.On("Read", mock.Anything, storage.StrongConsistency, readinessProbeID).
+		Return(nil, errors.New("no leader")).Once()
+
+	rsp, err := client.Readiness(ctx, &pbresource.ReadinessRequest{})
+	require.NoError(t, err)
+	require.False(t, rsp.Ready)
+	require.Equal(t, map[string]bool{
+		readinessSubsystemRegistry: false,
+		readinessSubsystemBackend:  false,
+		readinessSubsystemTenancy:  true,
+	}, subsystems(rsp))
+
+	server.MarkRegistryLoaded()
+	mockBackend.On("Read", mock.Anything, storage.StrongConsistency, readinessProbeID).
+		Return(nil, errors.New("no leader")).Once()
+
+	rsp, err = client.Readiness(ctx, &pbresource.ReadinessRequest{})
+	require.NoError(t, err)
+	require.False(t, rsp.Ready)
+	require.False(t, subsystems(rsp)[readinessSubsystemBackend])
+
+	// Ready once the backend is reachable.
+	mockBackend.On("Read", mock.Anything, storage.StrongConsistency, readinessProbeID).
+		Return(nil, storage.ErrNotFound)
+
+	rsp, err = client.Readiness(ctx, &pbresource.ReadinessRequest{})
+	require.NoError(t, err)
+	require.True(t, rsp.Ready)
+	require.Equal(t, map[string]bool{
+		readinessSubsystemRegistry: true,
+		readinessSubsystemBackend:  true,
+		readinessSubsystemTenancy:  true,
+	}, subsystems(rsp))
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// relativeReferences returns a copy of res with its owner rewritten relative to
+// res's tenancy: the tenancy fields the owner shares with res are cleared, as is
+// the owner's Uid, which identifies an incarnation that won't exist elsewhere.
+// The namespace is only cleared along with the partition, because a namespace
+// is only meaningful within its partition.
+func relativeReferences(res *pbresource.Resource) *pbresource.Resource {
+	if res.Owner == nil {
+		return res
+	}
+
+	res = clone(res)
+	res.Owner.Uid = ""
+	if res.Owner.Tenancy == nil {
+		return res
+	}
+	if res.Owner.Tenancy.Partition != res.Id.Tenancy.Partition {
+		return res
+	}
+	res.Owner.Tenancy.Partition = ""
+	if res.Owner.Tenancy.Namespace == res.Id.Tenancy.Namespace {
+		res.Owner.Tenancy.Namespace = ""
+	}
+	return res
+}
+
+// expandRelativeReferences fills in the empty tenancy fields of res's owner
+// from res's tenancy, reversing relativeReferences. Only the fields used by the
+// owner's scope are filled in, so a partition scoped owner doesn't gain a
+// namespace.
+func (s *Server) expandRelativeReferences(res *pbresource.Resource) error {
+	if res.Owner == nil {
+		return nil
+	}
+
+	reg, err := s.resolveType(res.Owner.Type)
+	if err != nil {
+		return err
+	}
+
+	if res.Owner.Tenancy == nil {
+		res.Owner.Tenancy = &pbresource.Tenancy{PeerName: res.Id.Tenancy.PeerName}
+	}
+	if reg.Scope != resource.ScopeCluster && res.Owner.Tenancy.Partition == "" {
+		res.Owner.Tenancy.Partition = res.Id.Tenancy.Partition
+	}
+	if reg.Scope == resource.ScopeNamespace && res.Owner.Tenancy.Namespace == "" {
+		res.Owner.Tenancy.Namespace = res.Id.Tenancy.Namespace
+	}
+	return nil
+}
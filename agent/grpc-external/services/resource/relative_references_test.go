@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/internal/catalog"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/resource/demo"
+	rtest "github.com/hashicorp/consul/internal/resource/resourcetest"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/prototest"
+)
+
+func TestRelativeReferences_DifferentTenancy(t *testing.T) {
+	server := testServer(t)
+	catalog.RegisterTypes(server.Registry)
+	demo.RegisterTypes(server.Registry)
+
+	tenancy := func(partition, namespace string) *pbresource.Tenancy {
+		return &pbresource.Tenancy{Partition: partition, Namespace: namespace, PeerName: resource.DefaultPeerName}
+	}
+
+	status := &pbresource.Resource{
+		Id: &pbresource.ID{
+			Type:    pbcatalog.HealthStatusType,
+			Tenancy: tenancy("ap1", "ns1"),
+			Name:    "status",
+		},
+		Owner: &pbresource.ID{
+			Type:    pbcatalog.NodeType,
+			Tenancy: tenancy("ap1", "ns1"),
+			Name:    "node",
+			Uid:     "node-uid",
+		},
+	}
+
+	relative := relativeReferences(status)
+	prototest.AssertDeepEqual(t, tenancy("", ""), relative.Owner.Tenancy)
+	require.Empty(t, relative.Owner.Uid)
+	// The original resource is left as it was.
+	prototest.AssertDeepEqual(t, tenancy("ap1", "ns1"), status.Owner.Tenancy)
+
+	// Re-importing into a different tenancy references the node there.
+	relative.Id.Tenancy = tenancy("ap2", "ns2")
+	require.NoError(t, server.expandRelativeReferences(relative))
+	prototest.AssertDeepEqual(t, tenancy("ap2", "ns2"), relative.Owner.Tenancy)
+
+	// References to other tenancies are kept absolute.
+	status.Id.Tenancy = tenancy("ap1", "ns3")
+	relative = relativeReferences(status)
+	prototest.AssertDeepEqual(t, tenancy("", "ns1"), relative.Owner.Tenancy)
+
+	// Owners in other partitions are kept absolute, even when their namespace
+	// has the same name.
+	status.Id.Tenancy = tenancy("ap2", "default")
+	status.Owner.Tenancy = tenancy("ap1", "default")
+	relative = relativeReferences(status)
+	prototest.AssertDeepEqual(t, tenancy("ap1", "default"), relative.Owner.Tenancy)
+
+	relative.Id.Tenancy = tenancy("ap3", "ns3")
+	require.NoError(t, server.expandRelativeReferences(relative))
+	prototest.AssertDeepEqual(t, tenancy("ap1", "default"), relative.Owner.Tenancy)
+
+	// A partition scoped owner doesn't gain a namespace.
+	artist := &pbresource.Resource{
+		Id: &pbresource.ID{
+			Type:    demo.TypeV2Artist,
+			Tenancy: tenancy("ap2", "ns2"),
+			Name:    "artist",
+		},
+		Owner: &pbresource.ID{
+			Type:    demo.TypeV1RecordLabel,
+			Tenancy: tenancy("", ""),
+			Name:    "label",
+		},
+	}
+	require.NoError(t, server.expandRelativeReferences(artist))
+	prototest.AssertDeepEqual(t, tenancy("ap2", ""), artist.Owner.Tenancy)
+}
+
+func TestRead_RelativeReferences(t *testing.T) {
+	server := testServer(t)
+	catalog.RegisterTypes(server.Registry)
+	client := testClient(t, server)
+	ctx := testContext(t)
+
+	node := rtest.Resource(pbcatalog.NodeType, "node").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+		Write(t, client)
+	original := rtest.Resource(pbcatalog.HealthStatusType, "original").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		WithData(t, &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_PASSING}).
+		WithOwner(node.Id).
+		Write(t, client)
+
+	readRsp, err := client.Read(ctx, &pbresource.ReadRequest{Id: original.Id, RelativeReferences: true})
+	require.NoError(t, err)
+	exported := readRsp.Resource
+	require.Empty(t, exported.Owner.Tenancy.Partition)
+	require.Empty(t, exported.Owner.Uid)
+
+	// Import the exported resource as a new resource.
+	exported.Id.Name = "imported"
+	exported.Id.Uid = ""
+	exported.Version = ""
+	exported.Status = nil
+	writeRsp, err := client.Write(ctx, &pbresource.WriteRequest{Resource: exported, ExpandRelativeReferences: true})
+	require.NoError(t, err)
+	prototest.AssertDeepEqual(t, node.Id, writeRsp.Resource.Owner)
+}
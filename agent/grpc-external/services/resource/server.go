@@ -6,6 +6,7 @@ package resource
 import (
 	"context"
 	"strings"
+	"sync/atomic"
 
 	"github.com/hashicorp/go-hclog"
 	"google.golang.org/grpc"
@@ -16,13 +17,19 @@ import (
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/acl/resolver"
+	"github.com/hashicorp/consul/internal/controller"
 	"github.com/hashicorp/consul/internal/resource"
 	"github.com/hashicorp/consul/internal/storage"
+	"github.com/hashicorp/consul/internal/telemetry"
 	"github.com/hashicorp/consul/proto-public/pbresource"
 )
 
 type Server struct {
 	Config
+
+	// registryLoaded is set once all resource types have been registered. See
+	// MarkRegistryLoaded.
+	registryLoaded atomic.Bool
 }
 
 type Config struct {
@@ -40,6 +47,61 @@ type Config struct {
 	// Attempts to create v2 tenancy resources (partition or namespace) will fail when the
 	// flag is false.
 	UseV2Tenancy bool
+
+	// MaxStatusKeys caps the number of statuses (identified by their keys) a
+	// resource may carry. WriteStatus rejects writes of new keys beyond the cap.
+	// Zero means unlimited.
+	MaxStatusKeys int
+
+	// MaxStatusConditions caps the number of conditions in a single status.
+	// Zero means unlimited.
+	MaxStatusConditions int
+
+	// OwnerConflictPolicy determines how Write handles a resource being written
+	// with a different owner than it already has, for types whose Registration
+	// sets AllowOwnerChanges. The owner of other types can't be changed.
+	OwnerConflictPolicy OwnerConflictPolicy
+
+	// FailReadsInDeletingTenancy makes Read fail with FailedPrecondition when
+	// the resource's partition or namespace is marked for deletion, as Write
+	// does, so that clients don't act on data that is about to be deleted. By
+	// default reads continue to work during deletion to allow inspection.
+	FailReadsInDeletingTenancy bool
+
+	// ReconcileEvents is the source of the events streamed by the
+	// WatchReconcileEvents endpoint. The endpoint is unavailable when nil.
+	ReconcileEvents ReconcileEventSource
+
+	// NodeHealth computes the health of catalog nodes for the BulkNodeHealth
+	// and NodeGroupHealth endpoints and for Read's IncludeLiveHealth. They are
+	// unavailable when nil.
+	NodeHealth NodeHealthSource
+
+	// RateLimiter limits the rate of Read, List, Write, Delete and DeleteByOwner
+	// requests. Each entry of a BatchRead counts as a Read. Requests are
+	// unlimited when nil.
+	//
+	// There is no agent configuration for it yet, so the agent's resource
+	// service is not rate limited by it; the gRPC server's global rate limiting
+	// still applies.
+	RateLimiter RateLimiter
+
+	// Admission is called on Write, after the resource has been validated and
+	// the write authorized, to allow, deny, or mutate the resource. Writes are
+	// not subject to admission when nil.
+	//
+	// The agent does not configure an admission server, so it is only
+	// available to programs that construct the server themselves.
+	Admission *AdmissionHook
+
+	// Metrics is where the service's metrics are emitted, e.g. an OpenTelemetry
+	// sink from telemetry.NewOTelSink. Metrics are emitted via go-metrics when
+	// nil.
+	//
+	// The agent does not set it: there is no agent configuration for exporting
+	// metrics via OpenTelemetry, so it is only for programs that construct the
+	// server themselves, and for tests.
+	Metrics telemetry.Sink
 }
 
 //go:generate mockery --name Registry --inpackage
@@ -65,8 +127,57 @@ type TenancyBridge interface {
 	IsNamespaceMarkedForDeletion(partition, namespace string) (bool, error)
 }
 
+// OwnerConflictPolicy determines how conflicting claims of ownership over a
+// resource are resolved, i.e. when a resource that already has an owner is
+// written with a different one. It only applies to types whose Registration
+// sets AllowOwnerChanges.
+type OwnerConflictPolicy int
+
+const (
+	// OwnerConflictExplicitWins replaces the resource's owner with the one
+	// explicitly given in the write, and logs a warning. This is the default.
+	OwnerConflictExplicitWins OwnerConflictPolicy = iota
+
+	// OwnerConflictNewestWins keeps whichever of the two owners was created
+	// most recently, and logs a warning. The rest of the write is applied
+	// either way.
+	OwnerConflictNewestWins
+
+	// OwnerConflictReject fails any write that changes a resource's owner with
+	// InvalidArgument, as for types that don't allow their owner to change.
+	OwnerConflictReject
+)
+
+// ReconcileEventSource streams the reconcile events of the controllers running
+// on this server. It is implemented by controller.Manager.
+type ReconcileEventSource interface {
+	WatchReconcileEvents(ctx context.Context, controllerName string, fn func(controller.ReconcileEvent) error) error
+}
+
+// NodeHealthSource computes the health of catalog nodes on the fly, in the same
+// way the node health controller does. The nodes and their HealthStatus
+// resources are read with the given client, which makes its requests on behalf
+// of the caller so that their ACLs are enforced. It is implemented by
+// catalog.NodeHealthSource.
+type NodeHealthSource interface {
+	BulkNodeHealth(ctx context.Context, client pbresource.ResourceServiceClient, req *pbresource.BulkNodeHealthRequest) (*pbresource.BulkNodeHealthResponse, error)
+	NodeGroupHealth(ctx context.Context, client pbresource.ResourceServiceClient, req *pbresource.NodeGroupHealthRequest) (*pbresource.NodeGroupHealthResponse, error)
+	LiveNodeHealth(ctx context.Context, client pbresource.ResourceServiceClient, node *pbresource.Resource) (*pbresource.LiveNodeHealth, error)
+}
+
+// ConsistentTenancyBridge is an optional interface implemented by TenancyBridges
+// that can check for the existence of a partition or namespace against the most
+// up-to-date state (e.g. by reading from the Raft leader). It is used when the
+// caller has requested a strongly consistent read, so that reading a resource
+// from a tenancy that was only just created doesn't fail on a stale follower.
+type ConsistentTenancyBridge interface {
+	TenancyBridge
+	PartitionExistsConsistent(partition string) (bool, error)
+	NamespaceExistsConsistent(partition, namespace string) (bool, error)
+}
+
 func NewServer(cfg Config) *Server {
-	return &Server{cfg}
+	return &Server{Config: cfg}
 }
 
 var _ pbresource.ResourceServiceServer = (*Server)(nil)
@@ -100,15 +211,23 @@ func (s *Server) resolveType(typ *pbresource.Type) (*resource.Registration, erro
 	)
 }
 
-func readConsistencyFrom(ctx context.Context) storage.ReadConsistency {
+// groupVersionHeader is the response metadata key under which Read surfaces the
+// group version of the returned resource, so that clients supporting multiple
+// versions can branch without parsing the type URL of the resource's data.
+const groupVersionHeader = "x-consul-group-version"
+
+// readConsistencyFrom returns the consistency requested via the
+// x-consul-consistency-mode metadata, or the type's default if the request
+// doesn't specify one.
+func readConsistencyFrom(ctx context.Context, reg *resource.Registration) storage.ReadConsistency {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return storage.EventualConsistency
+		return reg.DefaultReadConsistency
 	}
 
 	vals := md.Get("x-consul-consistency-mode")
 	if len(vals) == 0 {
-		return storage.EventualConsistency
+		return reg.DefaultReadConsistency
 	}
 
 	if vals[0] == "consistent" {
@@ -117,6 +236,19 @@ func readConsistencyFrom(ctx context.Context) storage.ReadConsistency {
 	return storage.EventualConsistency
 }
 
+// readConsistencyFor returns the consistency explicitly requested by a read,
+// falling back to readConsistencyFrom when it is unspecified.
+func readConsistencyFor(ctx context.Context, reg *resource.Registration, requested pbresource.Consistency) storage.ReadConsistency {
+	switch requested {
+	case pbresource.Consistency_CONSISTENCY_EVENTUAL:
+		return storage.EventualConsistency
+	case pbresource.Consistency_CONSISTENCY_LEADER:
+		return storage.StrongConsistency
+	default:
+		return readConsistencyFrom(ctx, reg)
+	}
+}
+
 func (s *Server) getAuthorizer(token string, entMeta *acl.EnterpriseMeta) (acl.Authorizer, *acl.AuthorizerContext, error) {
 	authzContext := &acl.AuthorizerContext{}
 	authz, err := s.ACLResolver.ResolveTokenAndDefaultMeta(token, entMeta, authzContext)
@@ -126,6 +258,35 @@ func (s *Server) getAuthorizer(token string, entMeta *acl.EnterpriseMeta) (acl.A
 	return authz, authzContext, nil
 }
 
+// resolveTenancy gets the authorizer for the given token and tenancy, and fills
+// in the tenancy's empty partition and namespace, according to the scope of the
+// resource type, with the token's defaults (in CE, always "default").
+//
+// Wildcard tenancy units are left as is, as the v1 ACL subsystem is
+// "wildcard" aware.
+func (s *Server) resolveTenancy(token string, reg *resource.Registration, tenancy *pbresource.Tenancy) (acl.Authorizer, *acl.AuthorizerContext, error) {
+	// acl.EnterpriseMeta acl.AuthorizerContext follow rules for V1 resources since they integrate with the V1 acl subsystem.
+	// pbresource.Tenacy follows rules for V2 resources and the Resource service.
+	// Example:
+	//
+	//    A CE namespace scoped resource:
+	//      V1: EnterpriseMeta{}
+	//      V2: Tenancy {Partition: "default", Namespace: "default"}
+	//
+	//   An ENT namespace scoped resource:
+	//      V1: EnterpriseMeta{Partition: "default", Namespace: "default"}
+	//      V2: Tenancy {Partition: "default", Namespace: "default"}
+	//
+	// It is necessary to convert back and forth depending on which component supports which version, V1 or V2.
+	entMeta := v2TenancyToV1EntMeta(tenancy)
+	authz, authzContext, err := s.getAuthorizer(token, entMeta)
+	if err != nil {
+		return nil, nil, err
+	}
+	v1EntMetaToV2Tenancy(reg, entMeta, tenancy)
+	return authz, authzContext, nil
+}
+
 func isGRPCStatusError(err error) bool {
 	if err == nil {
 		return false
@@ -218,6 +379,34 @@ func validateWildcardTenancy(tenancy *pbresource.Tenancy, namePrefix string) err
 	return nil
 }
 
+// tenancyBridgeFor returns a TenancyBridge that satisfies the given read
+// consistency. If strong consistency was requested but the configured bridge
+// does not implement ConsistentTenancyBridge, the configured bridge is used as-is.
+func (s *Server) tenancyBridgeFor(consistency storage.ReadConsistency) TenancyBridge {
+	if consistency != storage.StrongConsistency {
+		return s.TenancyBridge
+	}
+
+	if bridge, ok := s.TenancyBridge.(ConsistentTenancyBridge); ok {
+		return consistentTenancyBridge{bridge}
+	}
+	return s.TenancyBridge
+}
+
+// consistentTenancyBridge adapts a ConsistentTenancyBridge so that its existence
+// checks are always strongly consistent.
+type consistentTenancyBridge struct {
+	ConsistentTenancyBridge
+}
+
+func (b consistentTenancyBridge) PartitionExists(partition string) (bool, error) {
+	return b.PartitionExistsConsistent(partition)
+}
+
+func (b consistentTenancyBridge) NamespaceExists(partition, namespace string) (bool, error) {
+	return b.NamespaceExistsConsistent(partition, namespace)
+}
+
 // tenancyExists return an error with the passed in gRPC status code when tenancy partition or namespace do not exist.
 func tenancyExists(reg *resource.Registration, tenancyBridge TenancyBridge, tenancy *pbresource.Tenancy, errCode codes.Code) error {
 	if reg.Scope == resource.ScopePartition || reg.Scope == resource.ScopeNamespace {
@@ -272,15 +461,15 @@ func validateScopedTenancy(scope resource.Scope, resourceType *pbresource.Type,
 	return nil
 }
 
-// tenancyMarkedForDeletion returns a gRPC InvalidArgument when either partition or namespace is marked for deletion.
-func tenancyMarkedForDeletion(reg *resource.Registration, tenancyBridge TenancyBridge, tenancy *pbresource.Tenancy) error {
+// tenancyMarkedForDeletion returns a gRPC error with the given code when either partition or namespace is marked for deletion.
+func tenancyMarkedForDeletion(reg *resource.Registration, tenancyBridge TenancyBridge, tenancy *pbresource.Tenancy, errCode codes.Code) error {
 	if reg.Scope == resource.ScopePartition || reg.Scope == resource.ScopeNamespace {
 		marked, err := tenancyBridge.IsPartitionMarkedForDeletion(tenancy.Partition)
 		switch {
 		case err != nil:
 			return err
 		case marked:
-			return status.Errorf(codes.InvalidArgument, "partition marked for deletion: %v", tenancy.Partition)
+			return status.Errorf(errCode, "partition marked for deletion: %v", tenancy.Partition)
 		}
 	}
 
@@ -290,7 +479,7 @@ func tenancyMarkedForDeletion(reg *resource.Registration, tenancyBridge TenancyB
 		case err != nil:
 			return err
 		case marked:
-			return status.Errorf(codes.InvalidArgument, "namespace marked for deletion: %v", tenancy.Namespace)
+			return status.Errorf(errCode, "namespace marked for deletion: %v", tenancy.Namespace)
 		}
 	}
 	return nil
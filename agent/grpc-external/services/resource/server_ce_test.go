@@ -5,7 +5,18 @@
 
 package resource
 
-import "github.com/hashicorp/consul/acl"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/resource/demo"
+	"github.com/hashicorp/consul/internal/storage"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/prototest"
+)
 
 func fillEntMeta(entMeta *acl.EnterpriseMeta) {
 	return
@@ -14,3 +25,64 @@ func fillEntMeta(entMeta *acl.EnterpriseMeta) {
 func fillAuthorizerContext(authzContext *acl.AuthorizerContext) {
 	return
 }
+
+func TestTenancyDefaulting(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	testCases := map[string]struct {
+		generate func() (*pbresource.Resource, error)
+		expected *pbresource.Tenancy
+	}{
+		"namespace scoped": {
+			generate: demo.GenerateV2Artist,
+			expected: resource.DefaultNamespacedTenancy(),
+		},
+		"partition scoped": {
+			generate: func() (*pbresource.Resource, error) { return demo.GenerateV1RecordLabel("looney-tunes") },
+			expected: resource.DefaultPartitionedTenancy(),
+		},
+		"cluster scoped": {
+			generate: func() (*pbresource.Resource, error) { return demo.GenerateV1Executive("marvin", "CEO") },
+			expected: resource.DefaultClusteredTenancy(),
+		},
+	}
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			res, err := tc.generate()
+			require.NoError(t, err)
+
+			// withoutTenancy returns the resource's ID with its partition and
+			// namespace left for the server to default.
+			withoutTenancy := func(id *pbresource.ID) *pbresource.ID {
+				id = clone(id)
+				id.Tenancy.Partition = ""
+				id.Tenancy.Namespace = ""
+				return id
+			}
+
+			res.Id = withoutTenancy(res.Id)
+			writeRsp, err := client.Write(ctx, &pbresource.WriteRequest{Resource: res})
+			require.NoError(t, err)
+			prototest.AssertDeepEqual(t, tc.expected, writeRsp.Resource.Id.Tenancy)
+
+			readRsp, err := client.Read(ctx, &pbresource.ReadRequest{Id: withoutTenancy(writeRsp.Resource.Id)})
+			require.NoError(t, err)
+			prototest.AssertDeepEqual(t, writeRsp.Resource, readRsp.Resource)
+
+			listRsp, err := client.List(ctx, &pbresource.ListRequest{
+				Type:    res.Id.Type,
+				Tenancy: withoutTenancy(writeRsp.Resource.Id).Tenancy,
+			})
+			require.NoError(t, err)
+			prototest.AssertElementsMatch(t, []*pbresource.Resource{writeRsp.Resource}, listRsp.Resources)
+
+			_, err = client.Delete(ctx, &pbresource.DeleteRequest{Id: withoutTenancy(writeRsp.Resource.Id)})
+			require.NoError(t, err)
+			_, err = server.Backend.Read(ctx, storage.EventualConsistency, writeRsp.Resource.Id)
+			require.ErrorIs(t, err, storage.ErrNotFound)
+		})
+	}
+}
@@ -152,7 +152,9 @@ func RunResourceServiceWithConfig(t *testing.T, config svc.Config, registerFns .
 		config.Logger = testutil.Logger(t)
 	}
 
-	svc.NewServer(config).Register(server)
+	resourceServer := svc.NewServer(config)
+	resourceServer.MarkRegistryLoaded()
+	resourceServer.Register(server)
 
 	pipe := internal.NewPipeListener()
 	go server.Serve(pipe)
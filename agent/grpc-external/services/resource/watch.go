@@ -21,10 +21,8 @@ func (s *Server) WatchList(req *pbresource.WatchListRequest, stream pbresource.R
 		return err
 	}
 
-	// v1 ACL subsystem is "wildcard" aware so just pass on through.
-	entMeta := v2TenancyToV1EntMeta(req.Tenancy)
 	token := tokenFromContext(stream.Context())
-	authz, authzContext, err := s.getAuthorizer(token, entMeta)
+	authz, authzContext, err := s.resolveTenancy(token, reg, req.Tenancy)
 	if err != nil {
 		return err
 	}
@@ -38,21 +36,25 @@ func (s *Server) WatchList(req *pbresource.WatchListRequest, stream pbresource.R
 		return status.Errorf(codes.Internal, "failed list acl: %v", err)
 	}
 
-	// Ensure we're defaulting correctly when request tenancy units are empty.
-	v1EntMetaToV2Tenancy(reg, entMeta, req.Tenancy)
-
-	unversionedType := storage.UnversionedTypeFrom(req.Type)
-	watch, err := s.Backend.WatchList(
-		stream.Context(),
-		unversionedType,
-		req.Tenancy,
-		req.NamePrefix,
-	)
+	watch, resumable, err := s.watchListFrom(stream, req)
 	if err != nil {
 		return err
 	}
 	defer watch.Close()
 
+	// withResumeToken returns a copy of the event (which may be shared with other
+	// watches) carrying the token to resume the watch after it.
+	withResumeToken := func(event *pbresource.WatchEvent) *pbresource.WatchEvent {
+		if resumable == nil {
+			return event
+		}
+		return &pbresource.WatchEvent{
+			Operation:   event.Operation,
+			Resource:    event.Resource,
+			ResumeToken: resumable.ResumeToken(),
+		}
+	}
+
 	for {
 		event, err := watch.Next(stream.Context())
 		switch {
@@ -62,6 +64,16 @@ func (s *Server) WatchList(req *pbresource.WatchListRequest, stream pbresource.R
 			return status.Errorf(codes.Internal, "failed next: %v", err)
 		}
 
+		if event.Operation == pbresource.WatchEvent_OPERATION_END_OF_SNAPSHOT {
+			if !req.IncludeEndOfSnapshot {
+				continue
+			}
+			if err = stream.Send(withResumeToken(event)); err != nil {
+				return err
+			}
+			continue
+		}
+
 		// drop group versions that don't match
 		if event.Resource.Id.Type.GroupVersion != req.Type.GroupVersion {
 			continue
@@ -70,8 +82,7 @@ func (s *Server) WatchList(req *pbresource.WatchListRequest, stream pbresource.R
 		// Need to rebuild authorizer per resource since wildcard inputs may
 		// result in different tenancies. Consider caching per tenancy if this
 		// is deemed expensive.
-		entMeta = v2TenancyToV1EntMeta(event.Resource.Id.Tenancy)
-		authz, authzContext, err = s.getAuthorizer(token, entMeta)
+		authz, authzContext, err = s.getAuthorizer(token, v2TenancyToV1EntMeta(event.Resource.Id.Tenancy))
 		if err != nil {
 			return err
 		}
@@ -85,12 +96,45 @@ func (s *Server) WatchList(req *pbresource.WatchListRequest, stream pbresource.R
 			return status.Errorf(codes.Internal, "failed read acl: %v", err)
 		}
 
-		if err = stream.Send(event); err != nil {
+		if err = stream.Send(withResumeToken(event)); err != nil {
 			return err
 		}
 	}
 }
 
+// watchListFrom starts the storage watch for req, resuming it from
+// req.ResumeToken when possible. The returned ResumableWatch is nil if the
+// backend doesn't support resuming watches.
+//
+// When the watch can't be resumed, an OPERATION_NEW_SNAPSHOT event is sent and
+// a new watch is started.
+func (s *Server) watchListFrom(stream pbresource.ResourceService_WatchListServer, req *pbresource.WatchListRequest) (storage.Watch, storage.ResumableWatch, error) {
+	unversionedType := storage.UnversionedTypeFrom(req.Type)
+
+	backend, ok := s.Backend.(storage.ResumableBackend)
+	if !ok {
+		if req.ResumeToken != "" {
+			if err := stream.Send(&pbresource.WatchEvent{Operation: pbresource.WatchEvent_OPERATION_NEW_SNAPSHOT}); err != nil {
+				return nil, nil, err
+			}
+		}
+		watch, err := s.Backend.WatchList(stream.Context(), unversionedType, req.Tenancy, req.NamePrefix)
+		return watch, nil, err
+	}
+
+	watch, err := backend.WatchListFrom(stream.Context(), unversionedType, req.Tenancy, req.NamePrefix, req.ResumeToken)
+	if errors.Is(err, storage.ErrResumeTokenExpired) {
+		if err = stream.Send(&pbresource.WatchEvent{Operation: pbresource.WatchEvent_OPERATION_NEW_SNAPSHOT}); err != nil {
+			return nil, nil, err
+		}
+		watch, err = backend.WatchListFrom(stream.Context(), unversionedType, req.Tenancy, req.NamePrefix, "")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return watch, watch, nil
+}
+
 func (s *Server) ensureWatchListRequestValid(req *pbresource.WatchListRequest) (*resource.Registration, error) {
 	if req.Type == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "type is required")
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/storage"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+func (s *Server) WatchListByOwner(req *pbresource.WatchListByOwnerRequest, stream pbresource.ResourceService_WatchListByOwnerServer) error {
+	reg, ownerReg, err := s.ensureWatchListByOwnerRequestValid(req)
+	if err != nil {
+		return err
+	}
+
+	token := tokenFromContext(stream.Context())
+	authz, authzContext, err := s.resolveTenancy(token, ownerReg, req.Owner.Tenancy)
+	if err != nil {
+		return err
+	}
+
+	// Check list ACL before verifying tenancy exists to not leak tenancy existence.
+	err = reg.ACLs.List(authz, authzContext)
+	switch {
+	case acl.IsErrPermissionDenied(err):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		return status.Errorf(codes.Internal, "failed list acl: %v", err)
+	}
+
+	if err = tenancyExists(ownerReg, s.TenancyBridge, req.Owner.Tenancy, codes.InvalidArgument); err != nil {
+		return err
+	}
+
+	// Owned resources may be in a narrower tenancy than their owner, so all of
+	// the resources of the type are watched and filtered by owner below.
+	watch, err := s.Backend.WatchList(
+		stream.Context(),
+		storage.UnversionedTypeFrom(req.Type),
+		wildcardTenancyFor(reg.Scope),
+		"",
+	)
+	if err != nil {
+		return err
+	}
+	defer watch.Close()
+
+	// owned are the resources for which an upsert has been sent, so that a
+	// resource whose owner changes can be emitted as deleted.
+	owned := make(map[resource.ReferenceKey]struct{})
+	for {
+		event, err := watch.Next(stream.Context())
+		switch {
+		case errors.Is(err, storage.ErrWatchClosed):
+			return status.Error(codes.Aborted, "watch closed by the storage backend (possibly due to snapshot restoration)")
+		case err != nil:
+			return status.Errorf(codes.Internal, "failed next: %v", err)
+		}
+
+		if event.Operation == pbresource.WatchEvent_OPERATION_END_OF_SNAPSHOT {
+			if !req.IncludeEndOfSnapshot {
+				continue
+			}
+			if err = stream.Send(event); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// drop group versions that don't match
+		if event.Resource.Id.Type.GroupVersion != req.Type.GroupVersion {
+			continue
+		}
+
+		key := resource.NewReferenceKey(event.Resource.Id)
+		if !isOwnedBy(event.Resource, req.Owner) {
+			if _, ok := owned[key]; !ok {
+				continue
+			}
+			// The resource's owner has changed, so it has left the watched set.
+			event = &pbresource.WatchEvent{
+				Operation: pbresource.WatchEvent_OPERATION_DELETE,
+				Resource:  event.Resource,
+			}
+		}
+
+		// Rebuild the authorizer per resource as owned resources may be in a
+		// different tenancy than their owner.
+		authz, authzContext, err = s.getAuthorizer(token, v2TenancyToV1EntMeta(event.Resource.Id.Tenancy))
+		if err != nil {
+			return err
+		}
+
+		// filter out items that don't pass read ACLs
+		err = reg.ACLs.Read(authz, authzContext, event.Resource.Id, event.Resource)
+		switch {
+		case acl.IsErrPermissionDenied(err):
+			continue
+		case err != nil:
+			return status.Errorf(codes.Internal, "failed read acl: %v", err)
+		}
+
+		if event.Operation == pbresource.WatchEvent_OPERATION_DELETE {
+			delete(owned, key)
+		} else {
+			owned[key] = struct{}{}
+		}
+
+		if err = stream.Send(event); err != nil {
+			return err
+		}
+	}
+}
+
+// isOwnedBy reports whether res is owned by the resource with the given ID,
+// matching owners regardless of their type's group version, as ListByOwner
+// does.
+func isOwnedBy(res *pbresource.Resource, owner *pbresource.ID) bool {
+	return res.Owner != nil &&
+		storage.UnversionedTypeFrom(res.Owner.Type) == storage.UnversionedTypeFrom(owner.Type) &&
+		resource.EqualTenancy(res.Owner.Tenancy, owner.Tenancy) &&
+		res.Owner.Name == owner.Name &&
+		res.Owner.Uid == owner.Uid
+}
+
+// ensureWatchListByOwnerRequestValid returns the registrations of the watched
+// type and of the owner's type.
+func (s *Server) ensureWatchListByOwnerRequestValid(req *pbresource.WatchListByOwnerRequest) (*resource.Registration, *resource.Registration, error) {
+	if req.Type == nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "type is required")
+	}
+
+	// The owner is validated as in ListByOwner.
+	ownerReg, err := s.ensureListByOwnerRequestValid(&pbresource.ListByOwnerRequest{Owner: req.Owner})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Check type exists.
+	reg, err := s.resolveType(req.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = checkV2Tenancy(s.UseV2Tenancy, req.Type); err != nil {
+		return nil, nil, err
+	}
+
+	return reg, ownerReg, nil
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/agent/grpc-external/testutils"
+	"github.com/hashicorp/consul/internal/catalog"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/resource/demo"
+	rtest "github.com/hashicorp/consul/internal/resource/resourcetest"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/prototest"
+)
+
+func TestWatchListByOwner_InputValidation(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+
+	node := rtest.Resource(pbcatalog.NodeType, "node-1").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+		Write(t, client)
+
+	testCases := map[string]struct {
+		modFn       func(*pbresource.WatchListByOwnerRequest)
+		errContains string
+	}{
+		"no type": {
+			modFn:       func(req *pbresource.WatchListByOwnerRequest) { req.Type = nil },
+			errContains: "type is required",
+		},
+		"no owner": {
+			modFn:       func(req *pbresource.WatchListByOwnerRequest) { req.Owner = nil },
+			errContains: "owner is required",
+		},
+		"no owner uid": {
+			modFn:       func(req *pbresource.WatchListByOwnerRequest) { req.Owner.Uid = "" },
+			errContains: "owner uid is required",
+		},
+	}
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			req := &pbresource.WatchListByOwnerRequest{
+				Type:  pbcatalog.HealthStatusType,
+				Owner: clone(node.Id),
+			}
+			tc.modFn(req)
+
+			stream, err := client.WatchListByOwner(testContext(t), req)
+			require.NoError(t, err)
+
+			err = mustGetError(t, handleResourceStream(t, stream))
+			require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+			require.ErrorContains(t, err, tc.errContains)
+		})
+	}
+}
+
+func TestWatchListByOwner_Events(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	writeNode := func(name string) *pbresource.Resource {
+		return rtest.Resource(pbcatalog.NodeType, name).
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+			Write(t, client)
+	}
+	writeCheck := func(name string, owner *pbresource.ID) *pbresource.Resource {
+		return rtest.Resource(pbcatalog.HealthStatusType, name).
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_PASSING}).
+			WithOwner(owner).
+			Write(t, client)
+	}
+
+	node := writeNode("node-1")
+	other := writeNode("node-2")
+	existing := writeCheck("existing", node.Id)
+
+	stream, err := client.WatchListByOwner(ctx, &pbresource.WatchListByOwnerRequest{
+		Type:                 pbcatalog.HealthStatusType,
+		Owner:                node.Id,
+		IncludeEndOfSnapshot: true,
+	})
+	require.NoError(t, err)
+	rspCh := handleResourceStream(t, stream)
+
+	// The snapshot contains the node's existing checks.
+	rsp := mustGetResource(t, rspCh)
+	require.Equal(t, pbresource.WatchEvent_OPERATION_UPSERT, rsp.Operation)
+	prototest.AssertDeepEqual(t, existing, rsp.Resource)
+
+	rsp = mustGetResource(t, rspCh)
+	require.Equal(t, pbresource.WatchEvent_OPERATION_END_OF_SNAPSHOT, rsp.Operation)
+
+	// Checks of other nodes aren't delivered.
+	writeCheck("other", other.Id)
+	mustGetNoResource(t, rspCh)
+
+	// A check written under the node is.
+	check := writeCheck("check", node.Id)
+	rsp = mustGetResource(t, rspCh)
+	require.Equal(t, pbresource.WatchEvent_OPERATION_UPSERT, rsp.Operation)
+	prototest.AssertDeepEqual(t, check, rsp.Resource)
+
+	_, err = client.Delete(ctx, &pbresource.DeleteRequest{Id: check.Id})
+	require.NoError(t, err)
+	rsp = mustGetResource(t, rspCh)
+	require.Equal(t, pbresource.WatchEvent_OPERATION_DELETE, rsp.Operation)
+	prototest.AssertDeepEqual(t, check.Id, rsp.Resource.Id)
+
+	// Moving a check to another node deletes it from the watched set. The
+	// resource service ignores owner changes, so the check is moved directly.
+	moved := clone(existing)
+	moved.Owner = other.Id
+	_, err = server.Backend.WriteCAS(ctx, moved)
+	require.NoError(t, err)
+	rsp = mustGetResource(t, rspCh)
+	require.Equal(t, pbresource.WatchEvent_OPERATION_DELETE, rsp.Operation)
+	prototest.AssertDeepEqual(t, existing.Id, rsp.Resource.Id)
+	mustGetNoResource(t, rspCh)
+}
+
+func TestWatchListByOwner_ACL_ReadFiltered(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+
+	dr := &dummyACLResolver{
+		result: testutils.ACLsDisabled(t),
+	}
+	server.ACLResolver = dr
+
+	demo.RegisterTypes(server.Registry)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+	artist = rsp.Resource
+
+	dr.SetResult(AuthorizerFrom(t,
+		demo.ArtistV2ListPolicy,
+		`key_prefix "resource/demo.v2.Album/denied" { policy = "deny" }`,
+	))
+	//nolint:staticcheck
+	ctx := context.WithValue(testContext(t), "x-consul-token", "album-reader")
+
+	stream, err := client.WatchListByOwner(ctx, &pbresource.WatchListByOwnerRequest{
+		Type:  demo.TypeV2Album,
+		Owner: artist.Id,
+	})
+	require.NoError(t, err)
+	rspCh := handleResourceStream(t, stream)
+
+	// The ACLs are checked for each event.
+	for _, name := range []string{"denied", "allowed"} {
+		album, err := demo.GenerateV2Album(artist.Id)
+		require.NoError(t, err)
+		album.Id.Name = name
+		_, err = server.Backend.WriteCAS(testContext(t), album)
+		require.NoError(t, err)
+	}
+
+	event := mustGetResource(t, rspCh)
+	require.Equal(t, "allowed", event.Resource.Id.Name)
+	mustGetNoResource(t, rspCh)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+var reconcileResults = map[controller.ReconcileResult]pbresource.ReconcileEvent_Result{
+	controller.ReconcileResultSuccess: pbresource.ReconcileEvent_RESULT_SUCCESS,
+	controller.ReconcileResultRequeue: pbresource.ReconcileEvent_RESULT_REQUEUE,
+	controller.ReconcileResultError:   pbresource.ReconcileEvent_RESULT_ERROR,
+}
+
+func (s *Server) WatchReconcileEvents(req *pbresource.WatchReconcileEventsRequest, stream pbresource.ResourceService_WatchReconcileEventsServer) error {
+	if req.ControllerName == "" {
+		return status.Error(codes.InvalidArgument, "controller_name is required")
+	}
+
+	authz, authzContext, err := s.getAuthorizer(tokenFromContext(stream.Context()), acl.DefaultEnterpriseMeta())
+	if err != nil {
+		return err
+	}
+
+	err = authz.ToAllowAuthorizer().OperatorReadAllowed(authzContext)
+	switch {
+	case acl.IsErrPermissionDenied(err):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		return status.Errorf(codes.Internal, "failed operator acl: %v", err)
+	}
+
+	if s.ReconcileEvents == nil {
+		return status.Error(codes.Unimplemented, "reconcile events are not available on this server")
+	}
+
+	var sendErr error
+	err = s.ReconcileEvents.WatchReconcileEvents(stream.Context(), req.ControllerName, func(event controller.ReconcileEvent) error {
+		sendErr = stream.Send(reconcileEventToProto(event))
+		return sendErr
+	})
+	switch {
+	case sendErr != nil:
+		return sendErr
+	case stream.Context().Err() != nil:
+		return status.FromContextError(stream.Context().Err()).Err()
+	case err != nil:
+		// The only other error is for an unknown controller.
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return nil
+}
+
+func reconcileEventToProto(event controller.ReconcileEvent) *pbresource.ReconcileEvent {
+	rsp := &pbresource.ReconcileEvent{
+		Id:       event.ID,
+		Result:   reconcileResults[event.Result],
+		Duration: durationpb.New(event.Duration),
+	}
+	if event.Err != nil {
+		rsp.Error = event.Err.Error()
+	}
+	return rsp
+}
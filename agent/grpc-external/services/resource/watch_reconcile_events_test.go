@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/agent/grpc-external/testutils"
+	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/resource/demo"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/prototest"
+)
+
+func TestWatchReconcileEvents_InputValidation(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+
+	stream, err := client.WatchReconcileEvents(testContext(t), &pbresource.WatchReconcileEventsRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.Contains(t, err.Error(), "controller_name is required")
+}
+
+func TestWatchReconcileEvents_Unavailable(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+
+	stream, err := client.WatchReconcileEvents(testContext(t), &pbresource.WatchReconcileEventsRequest{ControllerName: "artists"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unimplemented.String(), status.Code(err).String())
+}
+
+func TestWatchReconcileEvents_ACLs(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	server.ReconcileEvents = &fakeReconcileEventSource{name: "artists"}
+
+	dr := &dummyACLResolver{result: AuthorizerFrom(t, demo.ArtistV2ReadPolicy)}
+	server.ACLResolver = dr
+
+	stream, err := client.WatchReconcileEvents(testContext(t), &pbresource.WatchReconcileEventsRequest{ControllerName: "artists"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+
+	dr.SetResult(testutils.ACLOperatorRead(t))
+	stream, err = client.WatchReconcileEvents(testContext(t), &pbresource.WatchReconcileEventsRequest{ControllerName: "unknown"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.NotFound.String(), status.Code(err).String())
+}
+
+func TestWatchReconcileEvents_Success(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+
+	server.ReconcileEvents = &fakeReconcileEventSource{
+		name: "artists",
+		events: []controller.ReconcileEvent{
+			{Controller: "artists", ID: artist.Id, Result: controller.ReconcileResultSuccess, Duration: time.Millisecond},
+			{Controller: "artists", ID: artist.Id, Result: controller.ReconcileResultRequeue, Duration: time.Second, Err: controller.RequeueAfter(time.Minute)},
+			{Controller: "artists", ID: artist.Id, Result: controller.ReconcileResultError, Duration: time.Minute, Err: errors.New("KABOOM")},
+		},
+	}
+
+	stream, err := client.WatchReconcileEvents(testContext(t), &pbresource.WatchReconcileEventsRequest{ControllerName: "artists"})
+	require.NoError(t, err)
+
+	expected := []struct {
+		result   pbresource.ReconcileEvent_Result
+		duration time.Duration
+		err      string
+	}{
+		{pbresource.ReconcileEvent_RESULT_SUCCESS, time.Millisecond, ""},
+		{pbresource.ReconcileEvent_RESULT_REQUEUE, time.Second, controller.RequeueAfter(time.Minute).Error()},
+		{pbresource.ReconcileEvent_RESULT_ERROR, time.Minute, "KABOOM"},
+	}
+	for _, exp := range expected {
+		event, err := stream.Recv()
+		require.NoError(t, err)
+		prototest.AssertDeepEqual(t, artist.Id, event.Id)
+		require.Equal(t, exp.result, event.Result)
+		require.Equal(t, exp.duration, event.Duration.AsDuration())
+		require.Equal(t, exp.err, event.Error)
+	}
+}
+
+// fakeReconcileEventSource emits the given events for the controller with the
+// given name, and then blocks until the watch is canceled.
+type fakeReconcileEventSource struct {
+	name   string
+	events []controller.ReconcileEvent
+}
+
+func (f *fakeReconcileEventSource) WatchReconcileEvents(ctx context.Context, name string, fn func(controller.ReconcileEvent) error) error {
+	if name != f.name {
+		return fmt.Errorf("no controller registered with name %q", name)
+	}
+	for _, event := range f.events {
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
@@ -6,6 +6,7 @@ package resource
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"strings"
 	"testing"
@@ -166,6 +167,167 @@ func TestWatchList_GroupVersionMatches(t *testing.T) {
 	require.Equal(t, pbresource.WatchEvent_OPERATION_DELETE, rsp.Operation)
 }
 
+func TestWatchList_EndOfSnapshot(t *testing.T) {
+	t.Parallel()
+
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+	ctx := context.Background()
+
+	existing := make(map[string]*pbresource.Resource)
+	for i := 0; i < 3; i++ {
+		artist, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		artist.Id.Name = fmt.Sprintf("existing-%d", i)
+		artist, err = server.Backend.WriteCAS(ctx, artist)
+		require.NoError(t, err)
+		existing[artist.Id.Name] = artist
+	}
+
+	stream, err := client.WatchList(ctx, &pbresource.WatchListRequest{
+		Type:                 demo.TypeV2Artist,
+		Tenancy:              resource.DefaultNamespacedTenancy(),
+		IncludeEndOfSnapshot: true,
+	})
+	require.NoError(t, err)
+	rspCh := handleResourceStream(t, stream)
+
+	// All existing resources are delivered first...
+	for range existing {
+		rsp := mustGetResource(t, rspCh)
+		require.Equal(t, pbresource.WatchEvent_OPERATION_UPSERT, rsp.Operation)
+		prototest.AssertDeepEqual(t, existing[rsp.Resource.Id.Name], rsp.Resource)
+	}
+
+	// ...followed by the marker...
+	rsp := mustGetResource(t, rspCh)
+	require.Equal(t, pbresource.WatchEvent_OPERATION_END_OF_SNAPSHOT, rsp.Operation)
+	require.Nil(t, rsp.Resource)
+
+	// ...and then live events.
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	artist.Id.Name = "live"
+	artist, err = server.Backend.WriteCAS(ctx, artist)
+	require.NoError(t, err)
+
+	rsp = mustGetResource(t, rspCh)
+	require.Equal(t, pbresource.WatchEvent_OPERATION_UPSERT, rsp.Operation)
+	prototest.AssertDeepEqual(t, artist, rsp.Resource)
+}
+
+func TestWatchList_ResumeToken(t *testing.T) {
+	t.Parallel()
+
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+	ctx := context.Background()
+
+	writeArtist := func(name string) *pbresource.Resource {
+		artist, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		artist.Id.Name = name
+		artist, err = server.Backend.WriteCAS(ctx, artist)
+		require.NoError(t, err)
+		return artist
+	}
+	watch := func(t *testing.T, token string) (<-chan resourceOrError, context.CancelFunc) {
+		ctx, cancel := context.WithCancel(ctx)
+		t.Cleanup(cancel)
+		stream, err := client.WatchList(ctx, &pbresource.WatchListRequest{
+			Type:                 demo.TypeV2Artist,
+			Tenancy:              resource.DefaultNamespacedTenancy(),
+			IncludeEndOfSnapshot: true,
+			ResumeToken:          token,
+		})
+		require.NoError(t, err)
+		return handleResourceStream(t, stream), cancel
+	}
+
+	existing := writeArtist("existing")
+
+	rspCh, cancel := watch(t, "")
+	rsp := mustGetResource(t, rspCh)
+	require.Equal(t, pbresource.WatchEvent_OPERATION_UPSERT, rsp.Operation)
+	require.Empty(t, rsp.ResumeToken, "snapshot events can't be resumed from")
+	rsp = mustGetResource(t, rspCh)
+	require.Equal(t, pbresource.WatchEvent_OPERATION_END_OF_SNAPSHOT, rsp.Operation)
+	require.NotEmpty(t, rsp.ResumeToken)
+
+	live := writeArtist("live")
+	rsp = mustGetResource(t, rspCh)
+	prototest.AssertDeepEqual(t, live, rsp.Resource)
+	require.NotEmpty(t, rsp.ResumeToken)
+	token := rsp.ResumeToken
+	cancel()
+
+	// Changes made while disconnected.
+	missed := writeArtist("missed")
+	require.NoError(t, server.Backend.DeleteCAS(ctx, existing.Id, existing.Version))
+
+	t.Run("resumed", func(t *testing.T) {
+		rspCh, _ := watch(t, token)
+
+		// Only the missed events are delivered, without the snapshot.
+		rsp := mustGetResource(t, rspCh)
+		require.Equal(t, pbresource.WatchEvent_OPERATION_UPSERT, rsp.Operation)
+		prototest.AssertDeepEqual(t, missed, rsp.Resource)
+
+		rsp = mustGetResource(t, rspCh)
+		require.Equal(t, pbresource.WatchEvent_OPERATION_DELETE, rsp.Operation)
+		prototest.AssertDeepEqual(t, existing.Id, rsp.Resource.Id)
+		mustGetNoResource(t, rspCh)
+
+		// Followed by live events.
+		later := writeArtist("later")
+		rsp = mustGetResource(t, rspCh)
+		prototest.AssertDeepEqual(t, later, rsp.Resource)
+		require.NotEmpty(t, rsp.ResumeToken)
+		require.NotEqual(t, token, rsp.ResumeToken)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		rspCh, _ := watch(t, "not-a-token")
+
+		rsp := mustGetResource(t, rspCh)
+		require.Equal(t, pbresource.WatchEvent_OPERATION_NEW_SNAPSHOT, rsp.Operation)
+		require.Nil(t, rsp.Resource)
+
+		// The state-of-the-world is sent again.
+		names := make(map[string]struct{})
+		for {
+			rsp = mustGetResource(t, rspCh)
+			if rsp.Operation == pbresource.WatchEvent_OPERATION_END_OF_SNAPSHOT {
+				break
+			}
+			require.Equal(t, pbresource.WatchEvent_OPERATION_UPSERT, rsp.Operation)
+			names[rsp.Resource.Id.Name] = struct{}{}
+		}
+		require.Equal(t, map[string]struct{}{"live": {}, "missed": {}, "later": {}}, names)
+	})
+}
+
+func TestWatchList_EndOfSnapshotNotRequested(t *testing.T) {
+	t.Parallel()
+
+	server := testServer(t)
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+	ctx := context.Background()
+
+	stream, err := client.WatchList(ctx, &pbresource.WatchListRequest{
+		Type:    demo.TypeV2Artist,
+		Tenancy: resource.DefaultNamespacedTenancy(),
+	})
+	require.NoError(t, err)
+	rspCh := handleResourceStream(t, stream)
+
+	// The (empty) snapshot's marker is not sent to watches that didn't ask.
+	mustGetNoResource(t, rspCh)
+}
+
 func TestWatchList_Tenancy_Defaults_And_Normalization(t *testing.T) {
 	// Test units of tenancy get lowercased and defaulted correctly when empty.
 	for desc, tc := range wildcardTenancyCases() {
@@ -367,7 +529,7 @@ func mustGetError(t *testing.T, ch <-chan resourceOrError) error {
 	}
 }
 
-func handleResourceStream(t *testing.T, stream pbresource.ResourceService_WatchListClient) <-chan resourceOrError {
+func handleResourceStream(t *testing.T, stream watchEventStream) <-chan resourceOrError {
 	t.Helper()
 
 	rspCh := make(chan resourceOrError)
@@ -388,6 +550,12 @@ func handleResourceStream(t *testing.T, stream pbresource.ResourceService_WatchL
 	return rspCh
 }
 
+// watchEventStream is implemented by the client streams of the endpoints that
+// emit watch events.
+type watchEventStream interface {
+	Recv() (*pbresource.WatchEvent, error)
+}
+
 type resourceOrError struct {
 	rsp *pbresource.WatchEvent
 	err error
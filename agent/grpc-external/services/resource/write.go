@@ -37,17 +37,25 @@ import (
 var errUseWriteStatus = status.Error(codes.InvalidArgument, "resource.status can only be set using the WriteStatus endpoint")
 
 func (s *Server) Write(ctx context.Context, req *pbresource.WriteRequest) (*pbresource.WriteResponse, error) {
+	if err := s.checkRateLimit(ctx, "Write"); err != nil {
+		return nil, err
+	}
+
 	reg, err := s.ensureWriteRequestValid(req)
 	if err != nil {
 		return nil, err
 	}
 
-	v1EntMeta := v2TenancyToV1EntMeta(req.Resource.Id.Tenancy)
-	authz, authzContext, err := s.getAuthorizer(tokenFromContext(ctx), v1EntMeta)
+	authz, authzContext, err := s.resolveTenancy(tokenFromContext(ctx), reg, req.Resource.Id.Tenancy)
 	if err != nil {
 		return nil, err
 	}
-	v1EntMetaToV2Tenancy(reg, v1EntMeta, req.Resource.Id.Tenancy)
+
+	if req.ExpandRelativeReferences {
+		if err = s.expandRelativeReferences(req.Resource); err != nil {
+			return nil, err
+		}
+	}
 
 	// Check the user sent the correct type of data.
 	if req.Resource.Data != nil && !req.Resource.Data.MessageIs(reg.Proto) {
@@ -66,7 +74,7 @@ func (s *Server) Write(ctx context.Context, req *pbresource.WriteRequest) (*pbre
 	}
 
 	if err = reg.Validate(req.Resource); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, resource.NewValidationError(err).GRPCStatus().Err()
 	}
 
 	// ACL check comes before tenancy existence checks to not leak tenancy "existence".
@@ -84,7 +92,17 @@ func (s *Server) Write(ctx context.Context, req *pbresource.WriteRequest) (*pbre
 	}
 
 	// Check tenancy not marked for deletion.
-	if err = tenancyMarkedForDeletion(reg, s.TenancyBridge, req.Resource.Id.Tenancy); err != nil {
+	if err = tenancyMarkedForDeletion(reg, s.TenancyBridge, req.Resource.Id.Tenancy, codes.InvalidArgument); err != nil {
+		return nil, err
+	}
+
+	if reg.ValidateReferences != nil {
+		if err = s.validateReferences(ctx, reg, req.Resource); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Resource, err = s.admit(ctx, reg, authz, authzContext, req.Resource); err != nil {
 		return nil, err
 	}
 
@@ -130,7 +148,8 @@ func (s *Server) Write(ctx context.Context, req *pbresource.WriteRequest) (*pbre
 			// Generally, we expect resources with owners to be created by controllers,
 			// and they should provide the Uid. In cases where no Uid is given (e.g. the
 			// owner is specified in the resource HCL) we'll look up whatever the current
-			// Uid is and use that.
+			// Uid is and use that. Types registered with ValidateOwner have their owner
+			// looked up regardless, to check it exists.
 			//
 			// An important note on consistency:
 			//
@@ -141,15 +160,10 @@ func (s *Server) Write(ctx context.Context, req *pbresource.WriteRequest) (*pbre
 			// That said, there is still a chance that the owner has been deleted by the
 			// time we write this resource. This is not a relational database and we do
 			// not support ACID transactions or real foreign key constraints.
-			if input.Owner != nil && input.Owner.Uid == "" {
-				owner, err := s.Backend.Read(ctx, storage.StrongConsistency, input.Owner)
-				switch {
-				case errors.Is(err, storage.ErrNotFound):
-					return status.Error(codes.InvalidArgument, "resource.owner does not exist")
-				case err != nil:
-					return status.Errorf(codes.Internal, "failed to resolve owner: %v", err)
+			if input.Owner != nil && (input.Owner.Uid == "" || reg.ValidateOwner) {
+				if input.Owner, err = s.resolveOwner(ctx, input.Owner, reg.ValidateOwner); err != nil {
+					return err
 				}
-				input.Owner = owner.Id
 			}
 
 			// TODO(spatel): Revisit owner<->resource tenancy rules post-1.16
@@ -187,18 +201,36 @@ func (s *Server) Write(ctx context.Context, req *pbresource.WriteRequest) (*pbre
 				return storage.ErrCASFailure
 			}
 
-			// Fill in an empty Owner UID with the existing owner's UID. If other parts
-			// of the owner ID like the type or name have changed then the subsequent
-			// EqualID call will still error as you are not allowed to change the owner.
-			// This is a small UX nicety to repeatedly "apply" a resource that should
-			// have an owner without having to care about the current owners incarnation.
-			if input.Owner != nil && existing.Owner != nil && input.Owner.Uid == "" {
-				input.Owner.Uid = existing.Owner.Uid
-			}
+			switch {
+			case input.Owner == nil && existing.Owner == nil:
+
+			// Fill in an empty Owner UID with the existing owner's UID. This is a
+			// small UX nicety to repeatedly "apply" a resource that should have an
+			// owner without having to care about the current owners incarnation.
+			case input.Owner != nil && existing.Owner != nil &&
+				resource.ReferenceOrIDMatch(input.Owner, existing.Owner) &&
+				(input.Owner.Uid == "" || input.Owner.Uid == existing.Owner.Uid):
+				input.Owner = existing.Owner
 
-			// Owner can only be set on creation. Enforce immutability.
-			if !resource.EqualID(input.Owner, existing.Owner) {
+			// Owner can only be set on creation, unless the type allows it to be
+			// changed. Enforce immutability.
+			case !reg.AllowOwnerChanges:
 				return status.Errorf(codes.InvalidArgument, "owner cannot be changed")
+
+			// The owner is being set, removed, or claimed by a different owner
+			// (or incarnation of the owner).
+			default:
+				if err := s.resolveOwnerChange(ctx, reg, input, existing); err != nil {
+					return err
+				}
+			}
+
+			// Resources stay marked for deletion until their finalizers are removed.
+			if resource.IsMarkedForDeletion(existing) {
+				if input.Metadata == nil {
+					input.Metadata = make(map[string]string)
+				}
+				input.Metadata[resource.DeletionTimestampKey] = existing.Metadata[resource.DeletionTimestampKey]
 			}
 
 			// Carry over status and prevent updates
@@ -213,6 +245,10 @@ func (s *Server) Write(ctx context.Context, req *pbresource.WriteRequest) (*pbre
 		}
 
 		input.Generation = ulid.Make().String()
+		if req.DryRun {
+			result = input
+			return nil
+		}
 		result, err = s.Backend.WriteCAS(ctx, input)
 		return err
 	})
@@ -227,9 +263,121 @@ func (s *Server) Write(ctx context.Context, req *pbresource.WriteRequest) (*pbre
 	case err != nil:
 		return nil, status.Errorf(codes.Internal, "failed to write resource: %v", err.Error())
 	}
+
+	if req.DryRun {
+		return &pbresource.WriteResponse{Resource: result, DryRun: true}, nil
+	}
+
+	// Removing the last finalizer of a resource marked for deletion deletes it.
+	if resource.IsMarkedForDeletion(result) && !resource.HasFinalizers(result) {
+		if err := s.deleteFinalized(ctx, result); err != nil {
+			return nil, err
+		}
+	}
 	return &pbresource.WriteResponse{Resource: result}, nil
 }
 
+// resolveOwner returns the ID of the current incarnation of the given owner.
+//
+// When validate is set, for types registered with ValidateOwner, an owner that
+// doesn't exist, or whose given Uid is not its current incarnation's, fails the
+// write with FailedPrecondition rather than InvalidArgument.
+func (s *Server) resolveOwner(ctx context.Context, owner *pbresource.ID, validate bool) (*pbresource.ID, error) {
+	var mismatchError storage.GroupVersionMismatchError
+	res, err := s.Backend.Read(ctx, storage.StrongConsistency, owner)
+	switch {
+	case err == nil:
+		return res.Id, nil
+	case errors.As(err, &mismatchError):
+		// The owner is stored at a different GroupVersion; keep the given one.
+		id := clone(owner)
+		id.Uid = mismatchError.Stored.Id.Uid
+		return id, nil
+	case errors.Is(err, storage.ErrNotFound) && validate:
+		return nil, status.Errorf(codes.FailedPrecondition, "resource.owner %s does not exist", resource.IDToString(owner))
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.InvalidArgument, "resource.owner does not exist")
+	default:
+		return nil, status.Errorf(codes.Internal, "failed to resolve owner: %v", err)
+	}
+}
+
+// validateReferences calls the type's reference validation hook, giving it a
+// reader whose requests are made on behalf of the writer.
+func (s *Server) validateReferences(ctx context.Context, reg *resource.Registration, res *pbresource.Resource) error {
+	ctx, cancel := context.WithTimeout(ctx, reg.ValidateReferencesTimeout)
+	defer cancel()
+
+	err := reg.ValidateReferences(ctx, serverReader{s}, res)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return status.Errorf(codes.DeadlineExceeded, "reference validation timed out after %s", reg.ValidateReferencesTimeout)
+	case isGRPCStatusError(err):
+		return err
+	default:
+		return resource.NewValidationError(err).GRPCStatus().Err()
+	}
+}
+
+// serverReader implements resource.ResourceReader by calling the server's
+// own endpoints, so that reads are validated and authorized like any other.
+// The reads are made on behalf of a Write, so they aren't rate limited.
+type serverReader struct {
+	s *Server
+}
+
+func (r serverReader) Read(ctx context.Context, req *pbresource.ReadRequest) (*pbresource.ReadResponse, error) {
+	return r.s.Read(withRateLimitExempt(ctx), req)
+}
+
+func (r serverReader) List(ctx context.Context, req *pbresource.ListRequest) (*pbresource.ListResponse, error) {
+	return r.s.List(withRateLimitExempt(ctx), req)
+}
+
+// resolveOwnerChange applies the configured OwnerConflictPolicy to a write
+// that changes the owner of a resource whose type allows it. Setting an owner
+// on a resource without one, or removing it, is applied as it doesn't conflict
+// with another owner's claim.
+func (s *Server) resolveOwnerChange(ctx context.Context, reg *resource.Registration, input, existing *pbresource.Resource) error {
+	if s.OwnerConflictPolicy == OwnerConflictReject {
+		return status.Errorf(codes.InvalidArgument, "owner cannot be changed")
+	}
+	if input.Owner == nil {
+		return nil
+	}
+
+	if input.Owner.Uid == "" || reg.ValidateOwner {
+		owner, err := s.resolveOwner(ctx, input.Owner, reg.ValidateOwner)
+		if err != nil {
+			return err
+		}
+		input.Owner = owner
+	}
+	if existing.Owner == nil {
+		return nil
+	}
+
+	// Uids are ULIDs, so they sort in the order the owners were created.
+	if s.OwnerConflictPolicy == OwnerConflictNewestWins && input.Owner.Uid < existing.Owner.Uid {
+		s.Logger.Warn("keeping a resource's existing owner, which is newer than the owner being written",
+			"resource", resource.IDToString(existing.Id),
+			"existing_owner", resource.IDToString(existing.Owner),
+			"conflicting_owner", resource.IDToString(input.Owner),
+		)
+		input.Owner = existing.Owner
+		return nil
+	}
+
+	s.Logger.Warn("replacing a resource's owner with a conflicting owner",
+		"resource", resource.IDToString(existing.Id),
+		"existing_owner", resource.IDToString(existing.Owner),
+		"conflicting_owner", resource.IDToString(input.Owner),
+	)
+	return nil
+}
+
 // retryCAS retries the given operation with exponential backoff if the user
 // didn't provide a version. This is intended to hide failures when the user
 // isn't intentionally performing a CAS operation (all writes are, by design,
@@ -288,6 +436,10 @@ func (s *Server) ensureWriteRequestValid(req *pbresource.WriteRequest) (*resourc
 		}
 	}
 
+	if _, _, err := resource.ExpireAt(req.Resource); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "resource.metadata invalid: %v", err)
+	}
+
 	// Check type exists.
 	reg, err := s.resolveType(req.Resource.Id.Type)
 	if err != nil {
@@ -19,21 +19,25 @@ import (
 	"github.com/hashicorp/consul/proto-public/pbresource"
 )
 
+// errStatusKeyLimit is returned from within the CAS retry loop when writing a
+// new status would exceed Config.MaxStatusKeys.
+var errStatusKeyLimit = errors.New("status key limit reached")
+
+// errStatusConditionLimit is returned from within the CAS retry loop when
+// merging conditions into a status would exceed Config.MaxStatusConditions.
+var errStatusConditionLimit = errors.New("status condition limit reached")
+
 func (s *Server) WriteStatus(ctx context.Context, req *pbresource.WriteStatusRequest) (*pbresource.WriteStatusResponse, error) {
 	reg, err := s.validateWriteStatusRequest(req)
 	if err != nil {
 		return nil, err
 	}
 
-	entMeta := v2TenancyToV1EntMeta(req.Id.Tenancy)
-	authz, authzContext, err := s.getAuthorizer(tokenFromContext(ctx), entMeta)
+	authz, authzContext, err := s.resolveTenancy(tokenFromContext(ctx), reg, req.Id.Tenancy)
 	if err != nil {
 		return nil, err
 	}
 
-	// Apply defaults when tenancy units empty.
-	v1EntMetaToV2Tenancy(reg, entMeta, req.Id.Tenancy)
-
 	// Check tenancy exists for the V2 resource. Ignore "marked for deletion" since status updates
 	// should still work regardless.
 	if err = tenancyExists(reg, s.TenancyBridge, req.Id.Tenancy, codes.InvalidArgument); err != nil {
@@ -88,12 +92,22 @@ func (s *Server) WriteStatus(ctx context.Context, req *pbresource.WriteStatusReq
 			return storage.ErrCASFailure
 		}
 
+		if _, ok := resource.Status[req.Key]; !ok && s.MaxStatusKeys > 0 && len(resource.Status) >= s.MaxStatusKeys {
+			return errStatusKeyLimit
+		}
+
 		resource = clone(resource)
 		if resource.Status == nil {
 			resource.Status = make(map[string]*pbresource.Status)
 		}
 
 		status := clone(req.Status)
+		if req.Merge {
+			status.Conditions = mergeConditions(resource.Status[req.Key].GetConditions(), status.Conditions)
+			if s.MaxStatusConditions > 0 && len(status.Conditions) > s.MaxStatusConditions {
+				return errStatusConditionLimit
+			}
+		}
 		status.UpdatedAt = timestamppb.Now()
 		resource.Status[req.Key] = status
 
@@ -104,6 +118,10 @@ func (s *Server) WriteStatus(ctx context.Context, req *pbresource.WriteStatusReq
 	switch {
 	case errors.Is(err, storage.ErrNotFound):
 		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errStatusKeyLimit):
+		return nil, status.Errorf(codes.InvalidArgument, "resource already has the maximum of %d statuses", s.MaxStatusKeys)
+	case errors.Is(err, errStatusConditionLimit):
+		return nil, status.Errorf(codes.InvalidArgument, "status.conditions cannot contain more than %d conditions", s.MaxStatusConditions)
 	case errors.Is(err, storage.ErrCASFailure):
 		return nil, status.Error(codes.Aborted, err.Error())
 	case err != nil:
@@ -113,6 +131,31 @@ func (s *Server) WriteStatus(ctx context.Context, req *pbresource.WriteStatusReq
 	return &pbresource.WriteStatusResponse{Resource: result}, nil
 }
 
+// mergeConditions returns existing with the conditions of the same Type as one
+// of updates replaced by it, followed by the updates of any other types.
+func mergeConditions(existing, updates []*pbresource.Condition) []*pbresource.Condition {
+	byType := make(map[string]*pbresource.Condition, len(updates))
+	for _, condition := range updates {
+		byType[condition.Type] = condition
+	}
+
+	merged := make([]*pbresource.Condition, 0, len(existing)+len(updates))
+	for _, condition := range existing {
+		if update, ok := byType[condition.Type]; ok {
+			condition = update
+			delete(byType, condition.Type)
+		}
+		merged = append(merged, condition)
+	}
+	for _, condition := range updates {
+		if update, ok := byType[condition.Type]; ok {
+			merged = append(merged, update)
+			delete(byType, condition.Type)
+		}
+	}
+	return merged
+}
+
 func (s *Server) validateWriteStatusRequest(req *pbresource.WriteStatusRequest) (*resource.Registration, error) {
 	var field string
 	switch {
@@ -163,6 +206,10 @@ func (s *Server) validateWriteStatusRequest(req *pbresource.WriteStatusRequest)
 		return nil, status.Error(codes.InvalidArgument, "status.updated_at is automatically set and cannot be provided")
 	}
 
+	if s.MaxStatusConditions > 0 && len(req.Status.Conditions) > s.MaxStatusConditions {
+		return nil, status.Errorf(codes.InvalidArgument, "status.conditions cannot contain more than %d conditions", s.MaxStatusConditions)
+	}
+
 	if _, err := ulid.ParseStrict(req.Status.ObservedGeneration); err != nil {
 		return nil, status.Error(codes.InvalidArgument, "status.observed_generation is not valid")
 	}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/consul/internal/resource"
 	"github.com/hashicorp/consul/internal/resource/demo"
 	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/prototest"
 )
 
 func TestWriteStatus_ACL(t *testing.T) {
@@ -448,6 +449,117 @@ func TestWriteStatus_CASFailure(t *testing.T) {
 	require.Equal(t, codes.Aborted.String(), status.Code(err).String())
 }
 
+func TestWriteStatus_Limits(t *testing.T) {
+	server := testServer(t)
+	server.MaxStatusKeys = 2
+	server.MaxStatusConditions = 2
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	res, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+
+	writeRsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: res})
+	require.NoError(t, err)
+	res = writeRsp.Resource
+
+	writeStatus := func(key string, numConditions int) error {
+		req := validWriteStatusRequest(t, res)
+		req.Version = ""
+		req.Key = key
+		for len(req.Status.Conditions) < numConditions {
+			req.Status.Conditions = append(req.Status.Conditions, clone(req.Status.Conditions[0]))
+		}
+		_, err := client.WriteStatus(testContext(t), req)
+		return err
+	}
+
+	// Writes within the limits succeed, including rewrites of an existing key
+	// once the key limit has been reached.
+	require.NoError(t, writeStatus("consul.io/controller-1", 1))
+	require.NoError(t, writeStatus("consul.io/controller-2", 2))
+	require.NoError(t, writeStatus("consul.io/controller-1", 2))
+
+	err = writeStatus("consul.io/controller-3", 1)
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.Contains(t, err.Error(), "maximum of 2 statuses")
+
+	err = writeStatus("consul.io/controller-1", 3)
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.Contains(t, err.Error(), "cannot contain more than 2 conditions")
+
+	readRsp, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: res.Id})
+	require.NoError(t, err)
+	require.Len(t, readRsp.Resource.Status, 2)
+}
+
+func TestWriteStatus_Merge(t *testing.T) {
+	server := testServer(t)
+	server.MaxStatusConditions = 3
+	client := testClient(t, server)
+	demo.RegisterTypes(server.Registry)
+
+	res, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+
+	writeRsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: res})
+	require.NoError(t, err)
+	res = writeRsp.Resource
+
+	condition := func(typ string, state pbresource.Condition_State) *pbresource.Condition {
+		return &pbresource.Condition{Type: typ, State: state, Reason: typ}
+	}
+	writeStatus := func(merge bool, conditions ...*pbresource.Condition) (*pbresource.Status, error) {
+		rsp, err := client.WriteStatus(testContext(t), &pbresource.WriteStatusRequest{
+			Id:  res.Id,
+			Key: "consul.io/artist-controller",
+			Status: &pbresource.Status{
+				ObservedGeneration: res.Generation,
+				Conditions:         conditions,
+			},
+			Merge: merge,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return rsp.Resource.Status["consul.io/artist-controller"], nil
+	}
+
+	_, err = writeStatus(false,
+		condition("Ready", pbresource.Condition_STATE_FALSE),
+		condition("Accepted", pbresource.Condition_STATE_TRUE),
+	)
+	require.NoError(t, err)
+
+	// Merging replaces the conditions of the given types, preserves the others
+	// and appends new types.
+	st, err := writeStatus(true,
+		condition("Ready", pbresource.Condition_STATE_TRUE),
+		condition("Resolved", pbresource.Condition_STATE_TRUE),
+	)
+	require.NoError(t, err)
+	prototest.AssertDeepEqual(t, []*pbresource.Condition{
+		condition("Ready", pbresource.Condition_STATE_TRUE),
+		condition("Accepted", pbresource.Condition_STATE_TRUE),
+		condition("Resolved", pbresource.Condition_STATE_TRUE),
+	}, st.Conditions)
+
+	// The merged conditions are subject to the conditions limit.
+	_, err = writeStatus(true, condition("Programmed", pbresource.Condition_STATE_TRUE))
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.Contains(t, err.Error(), "cannot contain more than 3 conditions")
+
+	// Without merging, the conditions are replaced wholesale.
+	st, err = writeStatus(false, condition("Accepted", pbresource.Condition_STATE_FALSE))
+	require.NoError(t, err)
+	prototest.AssertDeepEqual(t, []*pbresource.Condition{
+		condition("Accepted", pbresource.Condition_STATE_FALSE),
+	}, st.Conditions)
+}
+
 func TestWriteStatus_TypeNotFound(t *testing.T) {
 	server := testServer(t)
 	client := testClient(t, server)
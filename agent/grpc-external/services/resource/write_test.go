@@ -5,21 +5,27 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/hashicorp/consul/acl/resolver"
+	"github.com/hashicorp/consul/internal/catalog"
 	"github.com/hashicorp/consul/internal/resource"
 	"github.com/hashicorp/consul/internal/resource/demo"
+	rtest "github.com/hashicorp/consul/internal/resource/resourcetest"
 	"github.com/hashicorp/consul/internal/storage"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
 	"github.com/hashicorp/consul/proto-public/pbresource"
 	pbdemov1 "github.com/hashicorp/consul/proto/private/pbdemo/v1"
 	pbdemov2 "github.com/hashicorp/consul/proto/private/pbdemo/v2"
@@ -125,6 +131,13 @@ func TestWrite_InputValidation(t *testing.T) {
 			},
 			errContains: "artist.name required",
 		},
+		"malformed expiry": {
+			modFn: func(artist, _ *pbresource.Resource) *pbresource.Resource {
+				artist.Metadata = map[string]string{resource.ExpireAtKey: "tomorrow"}
+				return artist
+			},
+			errContains: "resource.metadata invalid: expireAt must be an RFC 3339 timestamp",
+		},
 		"partition scope with non-empty namespace": {
 			modFn: func(_, recordLabel *pbresource.Resource) *pbresource.Resource {
 				recordLabel.Id.Tenancy.Namespace = "bogus"
@@ -535,6 +548,261 @@ func TestWrite_CASUpdate_Success(t *testing.T) {
 	require.NotEqual(t, rsp1.Resource.Generation, rsp2.Resource.Generation)
 }
 
+func TestWrite_ValidationErrorDetails(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+
+	node := rtest.Resource(pbcatalog.NodeType, "node-1").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: ""}}}).
+		Build()
+
+	_, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: node})
+	require.Error(t, err)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument.String(), st.Code().String())
+	require.Contains(t, st.Message(), `invalid "host" field`)
+
+	require.Len(t, st.Details(), 1)
+	detail, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	prototest.AssertDeepEqual(t, []*errdetails.BadRequest_FieldViolation{
+		{Field: "addresses[0].host", Description: resource.ErrMissing.Error()},
+	}, detail.FieldViolations)
+}
+
+func TestWrite_ValidateOwner(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	node := rtest.Resource(pbcatalog.NodeType, "node-1").
+		WithTenancy(resource.DefaultNamespacedTenancy()).
+		WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+		Write(t, client)
+
+	check := func(owner *pbresource.ID) *pbresource.Resource {
+		return rtest.Resource(pbcatalog.HealthStatusType, "check").
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_PASSING}).
+			WithOwner(owner).
+			Build()
+	}
+
+	t.Run("valid owner", func(t *testing.T) {
+		rsp, err := client.Write(ctx, &pbresource.WriteRequest{Resource: check(node.Id)})
+		require.NoError(t, err)
+		prototest.AssertDeepEqual(t, node.Id, rsp.Resource.Owner)
+
+		_, err = client.Delete(ctx, &pbresource.DeleteRequest{Id: rsp.Resource.Id})
+		require.NoError(t, err)
+	})
+
+	t.Run("unchanged owner", func(t *testing.T) {
+		// Updates that keep the owner don't look it up again, so they succeed
+		// even once it has been deleted.
+		node := rtest.Resource(pbcatalog.NodeType, "node-3").
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.3"}}}).
+			Write(t, client)
+		rsp, err := client.Write(ctx, &pbresource.WriteRequest{Resource: check(node.Id)})
+		require.NoError(t, err)
+
+		_, err = client.Delete(ctx, &pbresource.DeleteRequest{Id: node.Id})
+		require.NoError(t, err)
+
+		res := rsp.Resource
+		res.Metadata = map[string]string{"updated": "true"}
+		_, err = client.Write(ctx, &pbresource.WriteRequest{Resource: res})
+		require.NoError(t, err)
+
+		_, err = client.Delete(ctx, &pbresource.DeleteRequest{Id: res.Id})
+		require.NoError(t, err)
+	})
+
+	t.Run("non-existent owner", func(t *testing.T) {
+		missing := clone(node.Id)
+		missing.Name = "node-2"
+		_, err := client.Write(ctx, &pbresource.WriteRequest{Resource: check(missing)})
+		require.Equal(t, codes.FailedPrecondition.String(), status.Code(err).String())
+		require.ErrorContains(t, err, "does not exist")
+	})
+
+	t.Run("stale owner uid", func(t *testing.T) {
+		stale := clone(node.Id)
+		stale.Uid = ulid.Make().String()
+		_, err := client.Write(ctx, &pbresource.WriteRequest{Resource: check(stale)})
+		require.Equal(t, codes.FailedPrecondition.String(), status.Code(err).String())
+	})
+
+	t.Run("wrong-typed owner", func(t *testing.T) {
+		owner := clone(node.Id)
+		owner.Type = pbcatalog.ServiceType
+		_, err := client.Write(ctx, &pbresource.WriteRequest{Resource: check(owner)})
+		require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	})
+
+	t.Run("not validated", func(t *testing.T) {
+		// Types that don't opt in may be written with missing owners.
+		missing := clone(node.Id)
+		missing.Name = "node-2"
+		missing.Uid = ulid.Make().String()
+		res := rtest.Resource(pbcatalog.ServiceType, "service").
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.Service{}).
+			WithOwner(missing).
+			Build()
+		_, err := client.Write(ctx, &pbresource.WriteRequest{Resource: res})
+		require.NoError(t, err)
+	})
+}
+
+func TestWrite_ValidateReferences(t *testing.T) {
+	// nodeExists rejects health statuses whose owner node doesn't exist.
+	nodeExists := func(ctx context.Context, reader resource.ResourceReader, res *pbresource.Resource) error {
+		_, err := reader.Read(ctx, &pbresource.ReadRequest{Id: res.Owner})
+		if status.Code(err) == codes.NotFound {
+			return resource.ErrInvalidField{Name: "owner", Wrapped: errors.New("node does not exist")}
+		}
+		return err
+	}
+
+	// registerCatalog registers the catalog types, with the given hook in place
+	// of HealthStatus's owner validation.
+	registerCatalog := func(server *Server, hook resource.ReferenceValidationHook, timeout time.Duration) {
+		catalogTypes := resource.NewRegistry()
+		catalog.RegisterTypes(catalogTypes)
+		for _, reg := range catalogTypes.Types() {
+			if _, ok := server.Registry.Resolve(reg.Type); ok {
+				continue
+			}
+			if resource.EqualType(reg.Type, pbcatalog.HealthStatusType) {
+				reg.ValidateOwner = false
+				reg.ValidateReferences = hook
+				reg.ValidateReferencesTimeout = timeout
+			}
+			server.Registry.Register(reg)
+		}
+	}
+
+	check := func(owner *pbresource.ID) *pbresource.Resource {
+		return rtest.Resource(pbcatalog.HealthStatusType, "check").
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_PASSING}).
+			WithOwner(owner).
+			Build()
+	}
+
+	t.Run("owner exists", func(t *testing.T) {
+		server := testServer(t)
+		client := testClient(t, server)
+		registerCatalog(server, nodeExists, 0)
+
+		node := rtest.Resource(pbcatalog.NodeType, "node-1").
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+			Write(t, client)
+
+		_, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: check(node.Id)})
+		require.NoError(t, err)
+	})
+
+	t.Run("owner does not exist", func(t *testing.T) {
+		server := testServer(t)
+		client := testClient(t, server)
+		registerCatalog(server, nodeExists, 0)
+
+		missing := rtest.Resource(pbcatalog.NodeType, "node-2").
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			ID()
+		_, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: check(missing)})
+		require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+		require.ErrorContains(t, err, "node does not exist")
+
+		_, err = client.Read(testContext(t), &pbresource.ReadRequest{Id: check(missing).Id})
+		require.Equal(t, codes.NotFound.String(), status.Code(err).String())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		server := testServer(t)
+		client := testClient(t, server)
+		registerCatalog(server, func(ctx context.Context, _ resource.ResourceReader, _ *pbresource.Resource) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}, 50*time.Millisecond)
+
+		node := rtest.Resource(pbcatalog.NodeType, "node-1").
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.Node{Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}}}).
+			Write(t, client)
+
+		_, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: check(node.Id)})
+		require.Equal(t, codes.DeadlineExceeded.String(), status.Code(err).String())
+	})
+}
+
+func TestWrite_DryRun(t *testing.T) {
+	server := testServer(t)
+	client := testClient(t, server)
+	catalog.RegisterTypes(server.Registry)
+	ctx := testContext(t)
+
+	node := func(name string, addresses ...string) *pbresource.Resource {
+		var data pbcatalog.Node
+		for _, addr := range addresses {
+			data.Addresses = append(data.Addresses, &pbcatalog.NodeAddress{Host: addr})
+		}
+		return rtest.Resource(pbcatalog.NodeType, name).
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &data).
+			Build()
+	}
+	requireNodes := func(expected ...*pbresource.Resource) {
+		t.Helper()
+		rsp, err := server.Backend.List(ctx, storage.StrongConsistency, storage.UnversionedTypeFrom(pbcatalog.NodeType), resource.DefaultNamespacedTenancy(), "")
+		require.NoError(t, err)
+		prototest.AssertElementsMatch(t, expected, rsp)
+	}
+
+	t.Run("valid create", func(t *testing.T) {
+		rsp, err := client.Write(ctx, &pbresource.WriteRequest{Resource: node("node-1", "198.18.0.1"), DryRun: true})
+		require.NoError(t, err)
+		require.True(t, rsp.DryRun)
+		require.Equal(t, "node-1", rsp.Resource.Id.Name)
+		require.NotEmpty(t, rsp.Resource.Id.Uid)
+		require.Empty(t, rsp.Resource.Version)
+		requireNodes()
+	})
+
+	t.Run("valid update", func(t *testing.T) {
+		rsp, err := client.Write(ctx, &pbresource.WriteRequest{Resource: node("node-2", "198.18.0.1")})
+		require.NoError(t, err)
+		require.False(t, rsp.DryRun)
+		existing := rsp.Resource
+
+		update := node("node-2", "198.18.0.2")
+		rsp, err = client.Write(ctx, &pbresource.WriteRequest{Resource: update, DryRun: true})
+		require.NoError(t, err)
+		require.True(t, rsp.DryRun)
+		prototest.AssertDeepEqual(t, update.Data, rsp.Resource.Data)
+		require.Equal(t, existing.Id.Uid, rsp.Resource.Id.Uid)
+		requireNodes(existing)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := client.Write(ctx, &pbresource.WriteRequest{Resource: node("node-3"), DryRun: true})
+		require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+
+		rsp, err := client.Read(ctx, &pbresource.ReadRequest{Id: node("node-2").Id})
+		require.NoError(t, err)
+		requireNodes(rsp.Resource)
+	})
+}
+
 func TestWrite_ResourceCreation_StatusProvided(t *testing.T) {
 	server := testServer(t)
 	client := testClient(t, server)
@@ -783,9 +1051,10 @@ func TestWrite_NoData(t *testing.T) {
 }
 
 func TestWrite_Owner_Immutable(t *testing.T) {
-	// Use of proto.Equal(..) in implementation covers all permutations
-	// (nil -> non-nil, non-nil -> nil, owner1 -> owner2) so only the first one
-	// is tested.
+	// Owners can't be changed unless the type allows it, which is covered by
+	// TestWrite_OwnerConflict. The implementation covers all permutations
+	// (nil -> non-nil, non-nil -> nil, owner1 -> owner2) alike so only the
+	// first and last are tested.
 	server := testServer(t)
 	client := testClient(t, server)
 
@@ -811,6 +1080,174 @@ func TestWrite_Owner_Immutable(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
 	require.ErrorContains(t, err, "owner cannot be changed")
+
+	// changing the owner on update should fail too, whatever the server's
+	// owner conflict policy. Generated names may collide, so give the new
+	// resources distinct ones.
+	other, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	other.Id.Name = artist.Id.Name + "-other"
+	rsp3, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: other})
+	require.NoError(t, err)
+	owned, err := demo.GenerateV2Album(artist.Id)
+	require.NoError(t, err)
+	owned.Id.Name = album.Id.Name + "-owned"
+	rsp4, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: owned})
+	require.NoError(t, err)
+
+	owned = rsp4.Resource
+	owned.Owner = rsp3.Resource.Id
+	_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: owned})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.ErrorContains(t, err, "owner cannot be changed")
+}
+
+func TestWrite_OwnerConflict(t *testing.T) {
+	testCases := map[string]struct {
+		policy OwnerConflictPolicy
+		// claimantFirst creates the claimant before the original owner.
+		claimantFirst bool
+		expectOwner   func(original, claimant *pbresource.ID) *pbresource.ID
+		expectErr     string
+	}{
+		"explicit wins": {
+			policy:        OwnerConflictExplicitWins,
+			claimantFirst: true,
+			expectOwner:   func(_, claimant *pbresource.ID) *pbresource.ID { return claimant },
+		},
+		"newest wins with newer claimant": {
+			policy:      OwnerConflictNewestWins,
+			expectOwner: func(_, claimant *pbresource.ID) *pbresource.ID { return claimant },
+		},
+		"newest wins with older claimant": {
+			policy:        OwnerConflictNewestWins,
+			claimantFirst: true,
+			expectOwner:   func(original, _ *pbresource.ID) *pbresource.ID { return original },
+		},
+		"reject": {
+			policy:    OwnerConflictReject,
+			expectErr: "owner cannot be changed",
+		},
+	}
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			server := testServer(t)
+			server.OwnerConflictPolicy = tc.policy
+			client := testClient(t, server)
+
+			registerDemoTypesAllowingOwnerChanges(server)
+
+			writeArtist := func(name string) *pbresource.ID {
+				artist, err := demo.GenerateV2Artist()
+				require.NoError(t, err)
+				artist.Id.Name = name
+				rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+				require.NoError(t, err)
+				return rsp.Resource.Id
+			}
+			var original, claimant *pbresource.ID
+			if tc.claimantFirst {
+				claimant = writeArtist("claimant")
+				original = writeArtist("original")
+			} else {
+				original = writeArtist("original")
+				claimant = writeArtist("claimant")
+			}
+
+			album, err := demo.GenerateV2Album(original)
+			require.NoError(t, err)
+			rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: album})
+			require.NoError(t, err)
+
+			// The claimant's Uid is left for the server to fill in.
+			album = rsp.Resource
+			album.Owner = clone(claimant)
+			album.Owner.Uid = ""
+			album.Metadata = map[string]string{"claimed": "true"}
+
+			rsp, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: album})
+			if tc.expectErr != "" {
+				require.Error(t, err)
+				require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			owner := tc.expectOwner(original, claimant)
+			prototest.AssertDeepEqual(t, owner, rsp.Resource.Owner)
+			require.Equal(t, "true", rsp.Resource.Metadata["claimed"])
+
+			listRsp, err := client.ListByOwner(testContext(t), &pbresource.ListByOwnerRequest{Owner: owner})
+			require.NoError(t, err)
+			require.Len(t, listRsp.Resources, 1)
+		})
+	}
+}
+
+func TestWrite_OwnerChange_SetAndRemove(t *testing.T) {
+	for desc, policy := range map[string]OwnerConflictPolicy{
+		"explicit wins": OwnerConflictExplicitWins,
+		"newest wins":   OwnerConflictNewestWins,
+		"reject":        OwnerConflictReject,
+	} {
+		t.Run(desc, func(t *testing.T) {
+			server := testServer(t)
+			server.OwnerConflictPolicy = policy
+			client := testClient(t, server)
+
+			registerDemoTypesAllowingOwnerChanges(server)
+
+			artist, err := demo.GenerateV2Artist()
+			require.NoError(t, err)
+			rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+			require.NoError(t, err)
+			artist = rsp.Resource
+
+			album, err := demo.GenerateV2Album(artist.Id)
+			require.NoError(t, err)
+			album.Owner = nil
+			rsp, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: album})
+			require.NoError(t, err)
+
+			// Setting an owner on a resource without one, and removing it
+			// again, don't conflict with another owner's claim so are only
+			// rejected by OwnerConflictReject.
+			album = rsp.Resource
+			album.Owner = artist.Id
+			rsp, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: album})
+			if policy == OwnerConflictReject {
+				require.Error(t, err)
+				require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+				return
+			}
+			require.NoError(t, err)
+			prototest.AssertDeepEqual(t, artist.Id, rsp.Resource.Owner)
+
+			album = rsp.Resource
+			album.Owner = nil
+			rsp, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: album})
+			require.NoError(t, err)
+			require.Nil(t, rsp.Resource.Owner)
+		})
+	}
+}
+
+// registerDemoTypesAllowingOwnerChanges registers the demo types, with the
+// owner of albums allowed to change.
+func registerDemoTypesAllowingOwnerChanges(server *Server) {
+	demoTypes := resource.NewRegistry()
+	demo.RegisterTypes(demoTypes)
+	for _, reg := range demoTypes.Types() {
+		if _, ok := server.Registry.Resolve(reg.Type); ok {
+			continue
+		}
+		if resource.EqualType(reg.Type, demo.TypeV2Album) {
+			reg.AllowOwnerChanges = true
+		}
+		server.Registry.Register(reg)
+	}
 }
 
 func TestWrite_Owner_Uid(t *testing.T) {
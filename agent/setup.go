@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/consul/agent/consul/usagemetrics"
 	"github.com/hashicorp/consul/agent/consul/xdscapacity"
 	"github.com/hashicorp/consul/agent/grpc-external/limiter"
+	resourcegrpc "github.com/hashicorp/consul/agent/grpc-external/services/resource"
 	grpcInt "github.com/hashicorp/consul/agent/grpc-internal"
 	"github.com/hashicorp/consul/agent/grpc-internal/balancer"
 	"github.com/hashicorp/consul/agent/grpc-internal/resolver"
@@ -42,6 +43,8 @@ import (
 	"github.com/hashicorp/consul/agent/submatview"
 	"github.com/hashicorp/consul/agent/token"
 	"github.com/hashicorp/consul/agent/xds"
+	"github.com/hashicorp/consul/internal/catalog"
+	"github.com/hashicorp/consul/internal/controller"
 	"github.com/hashicorp/consul/ipaddr"
 	"github.com/hashicorp/consul/lib"
 	"github.com/hashicorp/consul/lib/hoststats"
@@ -367,6 +370,7 @@ func getPrometheusDefs(cfg *config.RuntimeConfig, isServer bool) ([]prometheus.G
 			consul.LeaderCertExpirationGauges,
 			consul.LeaderPeeringMetrics,
 			xdscapacity.StatsGauges,
+			resourcegrpc.CountGauges,
 		)
 	}
 
@@ -431,6 +435,8 @@ func getPrometheusDefs(cfg *config.RuntimeConfig, isServer bool) ([]prometheus.G
 		cache.Counters,
 		consul.ACLCounters,
 		consul.CatalogCounters,
+		catalog.NodeHealthCounters,
+		controller.Counters,
 		consul.ClientCounters,
 		consul.RPCCounters,
 		grpcWare.StatsCounters,
@@ -506,6 +512,7 @@ func getPrometheusDefs(cfg *config.RuntimeConfig, isServer bool) ([]prometheus.G
 		consul.ACLSummaries,
 		consul.ACLEndpointSummaries,
 		consul.CatalogSummaries,
+		controller.Summaries,
 		consul.FederationStateSummaries,
 		consul.IntentionSummaries,
 		consul.KVSummaries,
@@ -519,6 +526,7 @@ func getPrometheusDefs(cfg *config.RuntimeConfig, isServer bool) ([]prometheus.G
 		fsm.CommandsSummaries,
 		fsm.SnapshotSummaries,
 		raftSummaries,
+		resourcegrpc.ReadSummaries,
 		xds.StatsSummaries,
 	}
 	// Flatten definitions
@@ -54,8 +54,15 @@ function main {
         status_stage "Generating protobuf module: $mod"
         (
             cd $mod
-            buf generate
-            for proto_file in $(buf ls-files)
+            # Vendored third-party protos under google/ are only imported, their
+            # Go code comes from the upstream packages.
+            if test -d google
+            then
+                buf generate --exclude-path google
+            else
+                buf generate
+            fi
+            for proto_file in $(buf ls-files | grep -v '^google/')
             do
                 postprocess_protobuf_code $proto_file
             done
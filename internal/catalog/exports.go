@@ -4,10 +4,15 @@
 package catalog
 
 import (
+	"context"
+	"time"
+
 	"github.com/hashicorp/consul/internal/catalog/internal/controllers"
 	"github.com/hashicorp/consul/internal/catalog/internal/controllers/endpoints"
 	"github.com/hashicorp/consul/internal/catalog/internal/controllers/failover"
 	"github.com/hashicorp/consul/internal/catalog/internal/controllers/nodehealth"
+	"github.com/hashicorp/consul/internal/catalog/internal/controllers/nodehealthsummary"
+	"github.com/hashicorp/consul/internal/catalog/internal/controllers/nodesethealth"
 	"github.com/hashicorp/consul/internal/catalog/internal/controllers/workloadhealth"
 	"github.com/hashicorp/consul/internal/catalog/internal/mappers/failovermapper"
 	"github.com/hashicorp/consul/internal/catalog/internal/mappers/nodemapper"
@@ -20,15 +25,33 @@ import (
 )
 
 var (
+	// Controller Names
+	NodeHealthControllerName        = nodehealth.ControllerName
+	NodeSetHealthControllerName     = nodesethealth.ControllerName
+	NodeHealthSummaryControllerName = nodehealthsummary.ControllerName
+
 	// Controller Statuses
 	NodeHealthStatusKey              = nodehealth.StatusKey
 	NodeHealthStatusConditionHealthy = nodehealth.StatusConditionHealthy
 	NodeHealthConditions             = nodehealth.Conditions
 
+	NodeHealthReasonCriticalInMaintenance = nodehealth.ReasonCriticalInMaintenance
+	NodeHealthSampledMessage              = nodehealth.NodeSampledMessage
+
+	NodeSetHealthStatusKey              = nodesethealth.StatusKey
+	NodeSetHealthStatusConditionHealthy = nodesethealth.StatusConditionHealthy
+
+	// NodeHealthSummaryName is the name of the cluster's NodeHealthSummary.
+	NodeHealthSummaryName = nodehealthsummary.SummaryName
+
+	// Metric Definitions
+	NodeHealthCounters = nodehealth.Counters
+
 	WorkloadHealthStatusKey              = workloadhealth.StatusKey
 	WorkloadHealthStatusConditionHealthy = workloadhealth.StatusConditionHealthy
 	WorkloadHealthConditions             = workloadhealth.WorkloadConditions
 	WorkloadAndNodeHealthConditions      = workloadhealth.NodeAndWorkloadConditions
+	WorkloadNodeMaintenanceCondition     = workloadhealth.ConditionNodeMaintenanceInherited
 
 	EndpointsStatusKey                       = endpoints.StatusKey
 	EndpointsStatusConditionEndpointsManaged = endpoints.StatusConditionEndpointsManaged
@@ -48,6 +71,225 @@ var (
 	FailoverStatusConditionAcceptedUsingMeshDestinationPortReason  = failover.UsingMeshDestinationPortReason
 )
 
+type (
+	NodeHealthOption                    = nodehealth.Option
+	NodeHealthAggregationStrategy       = nodehealth.AggregationStrategy
+	NodeHealthAggregationStrategyFunc   = nodehealth.AggregationStrategyFunc
+	NodeHealthMaintenanceConflictPolicy = nodehealth.MaintenanceConflictPolicy
+	MissingNodeMode                     = nodehealth.MissingNodeMode
+	NodeHealthMessageCatalog            = nodehealth.MessageCatalog
+	BulkNodeHealthResult                = nodehealth.BulkNodeHealthResult
+	NodeHealthTransition                = nodehealth.HealthTransition
+	NodeHealthEventSink                 = nodehealth.EventSink
+	NodeHealthEventSinkFunc             = nodehealth.EventSinkFunc
+)
+
+const (
+	NodeHealthMaintenanceConflictPreferMaintenance = nodehealth.MaintenanceConflictPreferMaintenance
+	NodeHealthMaintenanceConflictPreferCritical    = nodehealth.MaintenanceConflictPreferCritical
+	NodeHealthMaintenanceConflictDistinctReason    = nodehealth.MaintenanceConflictDistinctReason
+
+	MissingNodePassing  = nodehealth.MissingNodePassing
+	MissingNodeNotFound = nodehealth.MissingNodeNotFound
+)
+
+// AggregateHealth returns the highest-precedence health of the given statuses,
+// as the node health controller does by default. It is PASSING when no statuses
+// are given.
+func AggregateHealth(statuses []*pbcatalog.HealthStatus) pbcatalog.Health {
+	return nodehealth.AggregateHealth(statuses)
+}
+
+// RegisterNodeHealthAggregationStrategy makes a node health aggregation strategy
+// available under the given name for selection with
+// WithNodeHealthAggregationStrategy.
+func RegisterNodeHealthAggregationStrategy(name string, strategy NodeHealthAggregationStrategy) {
+	nodehealth.RegisterAggregationStrategy(name, strategy)
+}
+
+// WithNodeHealthAggregationStrategy configures the node health controller to use
+// the aggregation strategy registered under the given name.
+func WithNodeHealthAggregationStrategy(name string) NodeHealthOption {
+	return nodehealth.WithAggregationStrategy(name)
+}
+
+// WithNodeHealthConditionState configures the node health controller to write
+// the given condition state for nodes with the given health.
+func WithNodeHealthConditionState(health pbcatalog.Health, state pbresource.Condition_State) NodeHealthOption {
+	return nodehealth.WithConditionState(health, state)
+}
+
+// WithNodeHealthConditionTTL configures the node health controller to write
+// statuses with the given TTL, refreshing them before they go stale.
+func WithNodeHealthConditionTTL(ttl time.Duration) NodeHealthOption {
+	return nodehealth.WithConditionTTL(ttl)
+}
+
+// WithNodeHealthCheckCeiling caps the health that checks of the given type can
+// contribute to a node's health.
+func WithNodeHealthCheckCeiling(checkType string, ceiling pbcatalog.Health) NodeHealthOption {
+	return nodehealth.WithCheckCeiling(checkType, ceiling)
+}
+
+// WithNodeHealthCheckFloor sets the minimum severity that checks of the given
+// type contribute to a node's health.
+func WithNodeHealthCheckFloor(checkType string, floor pbcatalog.Health) NodeHealthOption {
+	return nodehealth.WithCheckFloor(checkType, floor)
+}
+
+// WithNodeHealthPrecedence configures the node health controller to aggregate
+// node health using the given precedence of each health level.
+func WithNodeHealthPrecedence(precedence map[pbcatalog.Health]int) NodeHealthOption {
+	return nodehealth.WithHealthPrecedence(precedence)
+}
+
+// WithNodeHealthLocale configures the node health controller to write condition
+// messages translated into the given locale.
+func WithNodeHealthLocale(catalog NodeHealthMessageCatalog, locale string) NodeHealthOption {
+	return nodehealth.WithLocale(catalog, locale)
+}
+
+// WithNodeHealthMaintenanceConflictPolicy selects the health reported for nodes
+// in maintenance with a critical check.
+func WithNodeHealthMaintenanceConflictPolicy(policy NodeHealthMaintenanceConflictPolicy) NodeHealthOption {
+	return nodehealth.WithMaintenanceConflictPolicy(policy)
+}
+
+// WithNodeHealthMaintenanceMessage renders the message of the MAINTENANCE
+// condition from the description of the HealthStatus in maintenance.
+func WithNodeHealthMaintenanceMessage(tmpl string) NodeHealthOption {
+	return nodehealth.WithMaintenanceMessage(tmpl)
+}
+
+// WithNodeHealthOptOutLabel excludes nodes labelled with the given metadata key
+// and value from node health management, reporting them as unmanaged.
+func WithNodeHealthOptOutLabel(key, value string) NodeHealthOption {
+	return nodehealth.WithOptOutLabel(key, value)
+}
+
+// WithNodeHealthEventSink publishes the transitions of nodes' health to sink.
+func WithNodeHealthEventSink(sink NodeHealthEventSink) NodeHealthOption {
+	return nodehealth.WithEventSink(sink)
+}
+
+// WithNodeHealthExpectedChecks makes nodes missing a HealthStatus of any of the
+// given check types report at least missingSeverity.
+func WithNodeHealthExpectedChecks(missingSeverity pbcatalog.Health, checkTypes ...string) NodeHealthOption {
+	return nodehealth.WithExpectedChecks(missingSeverity, checkTypes...)
+}
+
+// WithNodeHealthFailingChecksMessage configures the node health controller to
+// list up to limit failing HealthStatus resources in the message of the
+// WARNING and CRITICAL conditions.
+func WithNodeHealthFailingChecksMessage(limit int) NodeHealthOption {
+	return nodehealth.WithFailingChecksMessage(limit)
+}
+
+// WithNodeHealthScore enables computing a continuous health score for each
+// node, weighting its checks by their health.
+func WithNodeHealthScore(weights map[pbcatalog.Health]float64) NodeHealthOption {
+	return nodehealth.WithHealthScore(weights)
+}
+
+// WithNodeHealthReporterFilter restricts the HealthStatus resources considered
+// when computing node health to those from the given reporter, identified by a
+// metadata label.
+func WithNodeHealthReporterFilter(key, reporter string) NodeHealthOption {
+	return nodehealth.WithReporterFilter(key, reporter)
+}
+
+// WithNodeHealthSampling configures the node health controller to estimate the
+// health of nodes with more than threshold HealthStatus resources from a sample
+// of sampleSize of them, the first by name.
+func WithNodeHealthSampling(threshold, sampleSize int) NodeHealthOption {
+	return nodehealth.WithSampling(threshold, sampleSize)
+}
+
+// WithNodeHealthStickyHealth keeps nodes that have been reported with at least
+// the given severity at that health until acknowledged with the ackKey label.
+func WithNodeHealthStickyHealth(severity pbcatalog.Health, ackKey string) NodeHealthOption {
+	return nodehealth.WithStickyHealth(severity, ackKey)
+}
+
+// WithNodeHealthStartupRamp configures the node health controller to spread the
+// reconciles of existing nodes over the given window when it starts.
+func WithNodeHealthStartupRamp(window time.Duration) NodeHealthOption {
+	return nodehealth.WithStartupRamp(window)
+}
+
+// WithNodeHealthWorkers configures the number of nodes whose health the node
+// health controller may reconcile concurrently.
+func WithNodeHealthWorkers(workers int) NodeHealthOption {
+	return nodehealth.WithWorkers(workers)
+}
+
+// WithNodeHealthLastErrorRecording configures the node health controller to
+// record reconcile errors in the node's status until the next successful
+// reconcile.
+func WithNodeHealthLastErrorRecording() NodeHealthOption {
+	return nodehealth.WithLastErrorRecording()
+}
+
+// WithNodeHealthUnknownFieldPreservation configures the node health controller
+// to keep the fields of existing conditions it doesn't know about when it
+// rewrites a node's status.
+func WithNodeHealthUnknownFieldPreservation() NodeHealthOption {
+	return nodehealth.WithUnknownFieldPreservation()
+}
+
+// WithNodeHealthUnhealthyGracePeriod configures the node health controller to
+// keep reporting PASSING nodes as such until they have been unhealthy for the
+// given period.
+func WithNodeHealthUnhealthyGracePeriod(period time.Duration) NodeHealthOption {
+	return nodehealth.WithUnhealthyGracePeriod(period)
+}
+
+type LiveNodeHealth = nodehealth.LiveNodeHealth
+
+// ReadLiveNodeHealth reads a node along with both its stored health condition
+// and its health recomputed from the current HealthStatus resources. See
+// nodehealth.ReadLiveNodeHealth.
+func ReadLiveNodeHealth(ctx context.Context, client pbresource.ResourceServiceClient, id *pbresource.ID, opts ...NodeHealthOption) (*LiveNodeHealth, error) {
+	return nodehealth.ReadLiveNodeHealth(ctx, client, id, opts...)
+}
+
+// NodePassingDeletePrecondition returns a Delete precondition that refuses to
+// delete a node unless its health condition is PASSING.
+func NodePassingDeletePrecondition() *pbresource.DeletePrecondition {
+	return nodehealth.PassingDeletePrecondition()
+}
+
+type NodeGroupHealth = nodehealth.GroupHealth
+
+// GetNodeGroupHealth aggregates the health of all nodes in the given tenancy
+// whose metadata label has the given value. See nodehealth.NodeGroupHealth.
+func GetNodeGroupHealth(ctx context.Context, client pbresource.ResourceServiceClient, tenancy *pbresource.Tenancy, label, value string, opts ...NodeHealthOption) (*NodeGroupHealth, error) {
+	return nodehealth.NodeGroupHealth(ctx, client, tenancy, label, value, opts...)
+}
+
+// BulkNodeHealth computes the health of each of the nodes with the given IDs.
+// See nodehealth.BulkNodeHealth.
+func BulkNodeHealth(ctx context.Context, client pbresource.ResourceServiceClient, ids []*pbresource.ID, missing MissingNodeMode, opts ...NodeHealthOption) ([]*BulkNodeHealthResult, error) {
+	return nodehealth.BulkNodeHealth(ctx, client, ids, missing, opts...)
+}
+
+type NodeHealthSource = nodehealth.Source
+
+// NewNodeHealthSource returns the source of node health for the resource
+// service's node health endpoints, which computes it with the given node health
+// controller options applied. See nodehealth.Source.
+func NewNodeHealthSource(opts ...NodeHealthOption) *NodeHealthSource {
+	return nodehealth.NewSource(opts...)
+}
+
+type WorkloadHealthOption = workloadhealth.Option
+
+// WithWorkloadHealthNodeMaintenanceInheritance configures the workload health
+// controller to report workloads as in maintenance while their node is.
+func WithWorkloadHealthNodeMaintenanceInheritance() WorkloadHealthOption {
+	return workloadhealth.WithNodeMaintenanceInheritance()
+}
+
 type WorkloadSelecting = types.WorkloadSelecting
 
 func ACLHooksForWorkloadSelectingType[T WorkloadSelecting]() *resource.ACLHooks {
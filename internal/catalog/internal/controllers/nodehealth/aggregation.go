@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	"fmt"
+	"sync"
+
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+)
+
+// MaxSeverityStrategy is the name of the default aggregation strategy. The
+// node's health is that of its most severe HealthStatus, where severity follows
+// the order of the pbcatalog.Health enum (PASSING < WARNING < CRITICAL <
+// MAINTENANCE). A node without any HealthStatus resources is PASSING.
+const MaxSeverityStrategy = "max-severity"
+
+// AggregationStrategy computes the overall health of a node from the decoded
+// HealthStatus resources that it owns.
+type AggregationStrategy interface {
+	Aggregate(statuses []*pbcatalog.HealthStatus) pbcatalog.Health
+}
+
+// AggregationStrategyFunc is an adapter to allow the use of ordinary functions
+// as an AggregationStrategy.
+type AggregationStrategyFunc func(statuses []*pbcatalog.HealthStatus) pbcatalog.Health
+
+// Aggregate calls f(statuses).
+func (f AggregationStrategyFunc) Aggregate(statuses []*pbcatalog.HealthStatus) pbcatalog.Health {
+	return f(statuses)
+}
+
+var (
+	strategiesMu sync.RWMutex
+	strategies   = map[string]AggregationStrategy{
+		MaxSeverityStrategy: maxSeverityStrategy,
+	}
+)
+
+// RegisterAggregationStrategy makes an aggregation strategy available under the
+// given name so that it can be selected with WithAggregationStrategy. It panics
+// if a strategy with the same name has already been registered.
+func RegisterAggregationStrategy(name string, strategy AggregationStrategy) {
+	if strategy == nil {
+		panic("strategy must not be nil")
+	}
+
+	strategiesMu.Lock()
+	defer strategiesMu.Unlock()
+
+	if _, ok := strategies[name]; ok {
+		panic(fmt.Sprintf("node health aggregation strategy %q already registered", name))
+	}
+	strategies[name] = strategy
+}
+
+func lookupAggregationStrategy(name string) (AggregationStrategy, bool) {
+	strategiesMu.RLock()
+	defer strategiesMu.RUnlock()
+
+	strategy, ok := strategies[name]
+	return strategy, ok
+}
+
+// MaxSeverity returns the most severe of the given healths, with the same
+// precedence as MaxSeverityStrategy, e.g. to aggregate the health of a group of
+// nodes. It is PASSING when no healths are given.
+func MaxSeverity(healths ...pbcatalog.Health) pbcatalog.Health {
+	statuses := make([]*pbcatalog.HealthStatus, 0, len(healths))
+	for _, health := range healths {
+		statuses = append(statuses, &pbcatalog.HealthStatus{Status: health})
+	}
+	return AggregateHealth(statuses)
+}
+
+// HealthAggregator returns a function that aggregates the given healths, e.g.
+// of a group of nodes, with the aggregation strategy the node health controller
+// is configured with by opts, such as the precedence given to
+// WithHealthPrecedence. Without one, healths are aggregated as by MaxSeverity.
+func HealthAggregator(opts ...Option) func(healths ...pbcatalog.Health) pbcatalog.Health {
+	r := newNodeHealthReconciler(opts...)
+	return r.aggregateHealths
+}
+
+// aggregateHealths aggregates the given healths as if they were those of a
+// node's HealthStatus resources.
+func (r *nodeHealthReconciler) aggregateHealths(healths ...pbcatalog.Health) pbcatalog.Health {
+	statuses := make([]*pbcatalog.HealthStatus, 0, len(healths))
+	for _, health := range healths {
+		statuses = append(statuses, &pbcatalog.HealthStatus{Status: health})
+	}
+	return r.aggregationStrategy().Aggregate(statuses)
+}
+
+// aggregationStrategy returns the configured strategy, or that registered as
+// MaxSeverityStrategy when none is.
+func (r *nodeHealthReconciler) aggregationStrategy() AggregationStrategy {
+	if r.strategy == nil {
+		return maxSeverityStrategy
+	}
+	return r.strategy
+}
+
+// foldingStrategy returns the configured strategy if it is one of the built-in
+// severity strategies, into which HealthStatus resources can be folded one at a
+// time.
+func (r *nodeHealthReconciler) foldingStrategy() (severityStrategy, bool) {
+	s, ok := r.aggregationStrategy().(severityStrategy)
+	return s, ok
+}
+
+// rank returns the severity of the given health with the configured strategy,
+// so that comparisons of health outside of aggregation, e.g. against the
+// missing check or sticky severity, honor a precedence given to
+// WithHealthPrecedence. Custom strategies don't define a severity, so health is
+// then ranked by the order of the pbcatalog.Health enum.
+func (r *nodeHealthReconciler) rank(health pbcatalog.Health) int {
+	if s, ok := r.foldingStrategy(); ok {
+		return s.rank(health)
+	}
+	return int(health)
+}
+
+// AggregateHealth returns the health of the highest-precedence of the given
+// statuses, with the precedence of MaxSeverityStrategy. It is PASSING when no
+// statuses are given.
+func AggregateHealth(statuses []*pbcatalog.HealthStatus) pbcatalog.Health {
+	health := pbcatalog.Health_HEALTH_PASSING
+	for _, hs := range statuses {
+		if hs.Status > health {
+			health = hs.Status
+		}
+	}
+	return health
+}
+
+// severityStrategy aggregates HealthStatus resources to the most severe of
+// them, with the severity of each health given by rank. Of healths with equal
+// rank the one seen first wins, with PASSING seen before any HealthStatus. As
+// only the most severe health seen so far matters, a node's HealthStatus
+// resources can be folded in as they are listed rather than being held until
+// all of them have been.
+type severityStrategy struct {
+	rank func(pbcatalog.Health) int
+}
+
+// maxSeverityStrategy is the strategy registered as MaxSeverityStrategy.
+var maxSeverityStrategy = severityStrategy{
+	rank: func(health pbcatalog.Health) int { return int(health) },
+}
+
+// Aggregate implements AggregationStrategy.
+func (s severityStrategy) Aggregate(statuses []*pbcatalog.HealthStatus) pbcatalog.Health {
+	health := pbcatalog.Health_HEALTH_PASSING
+	for _, hs := range statuses {
+		health = s.fold(health, hs.Status)
+	}
+	return health
+}
+
+// fold returns the health aggregated from health and then next.
+func (s severityStrategy) fold(health, next pbcatalog.Health) pbcatalog.Health {
+	if s.rank(next) > s.rank(health) {
+		return next
+	}
+	return health
+}
+
+// precedenceStrategy is the MaxSeverityStrategy with the severity of each health
+// given by precedence rather than by the order of the pbcatalog.Health enum.
+// Health levels without a precedence keep their enum value. For example, giving
+// HEALTH_WARNING the precedence of HEALTH_PASSING makes nodes with only passing
+// and warning checks PASSING.
+func precedenceStrategy(precedence map[pbcatalog.Health]int) severityStrategy {
+	ranks := make(map[pbcatalog.Health]int, len(precedence))
+	for health, rank := range precedence {
+		ranks[health] = rank
+	}
+	return severityStrategy{
+		rank: func(health pbcatalog.Health) int {
+			if r, ok := ranks[health]; ok {
+				return r
+			}
+			return int(health)
+		},
+	}
+}
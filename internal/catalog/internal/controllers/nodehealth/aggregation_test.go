@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+)
+
+func TestAggregateHealth(t *testing.T) {
+	statuses := func(healths ...pbcatalog.Health) []*pbcatalog.HealthStatus {
+		var statuses []*pbcatalog.HealthStatus
+		for _, health := range healths {
+			statuses = append(statuses, &pbcatalog.HealthStatus{Type: "tcp", Status: health})
+		}
+		return statuses
+	}
+
+	cases := map[string]struct {
+		statuses []*pbcatalog.HealthStatus
+		expected pbcatalog.Health
+	}{
+		"nil": {
+			expected: pbcatalog.Health_HEALTH_PASSING,
+		},
+		"empty": {
+			statuses: statuses(),
+			expected: pbcatalog.Health_HEALTH_PASSING,
+		},
+		"single warning": {
+			statuses: statuses(pbcatalog.Health_HEALTH_WARNING),
+			expected: pbcatalog.Health_HEALTH_WARNING,
+		},
+		"critical then warning": {
+			statuses: statuses(pbcatalog.Health_HEALTH_CRITICAL, pbcatalog.Health_HEALTH_WARNING),
+			expected: pbcatalog.Health_HEALTH_CRITICAL,
+		},
+		"warning then critical": {
+			statuses: statuses(pbcatalog.Health_HEALTH_WARNING, pbcatalog.Health_HEALTH_CRITICAL),
+			expected: pbcatalog.Health_HEALTH_CRITICAL,
+		},
+		"maintenance over critical": {
+			statuses: statuses(pbcatalog.Health_HEALTH_PASSING, pbcatalog.Health_HEALTH_MAINTENANCE, pbcatalog.Health_HEALTH_CRITICAL),
+			expected: pbcatalog.Health_HEALTH_MAINTENANCE,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, AggregateHealth(tc.statuses))
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/internal/controller"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// MissingNodeMode decides how BulkNodeHealth reports nodes that don't exist.
+type MissingNodeMode int
+
+const (
+	// MissingNodePassing reports missing nodes as PASSING, in the same way the
+	// health of a node without any HealthStatus resources is computed.
+	MissingNodePassing MissingNodeMode = iota
+
+	// MissingNodeNotFound reports missing nodes with NotFound set and no health.
+	MissingNodeNotFound
+)
+
+// BulkNodeHealthResult is the health of one of the nodes passed to
+// BulkNodeHealth.
+type BulkNodeHealthResult struct {
+	// ID is the ID of the node as it was passed to BulkNodeHealth.
+	ID *pbresource.ID
+
+	// Health is the node's aggregated health. It is HEALTH_ANY when Err is set
+	// or the node was not found with MissingNodeNotFound.
+	Health pbcatalog.Health
+
+	// CheckCounts is the number of the node's HealthStatus resources with each
+	// health, after any check bounds have been applied.
+	CheckCounts map[pbcatalog.Health]int
+
+	// NotFound is true when the node doesn't exist and MissingNodeNotFound was
+	// given.
+	NotFound bool
+
+	// Err is the error returned by the resource service when the node couldn't
+	// be read, e.g. because the caller is not authorized to read it.
+	Err error
+}
+
+// BulkNodeHealth computes the health of each of the nodes with the given IDs,
+// in the same way the node health controller would with the given options
+// applied, e.g. to render a table of nodes with a single call. Results are
+// returned in the order of ids.
+//
+// All reads are made with the given client, so ACLs are enforced per node: a
+// node the caller is not authorized to read has its Err set to the resulting
+// PermissionDenied error rather than failing the whole call. Only unexpected
+// errors are returned.
+func BulkNodeHealth(
+	ctx context.Context,
+	client pbresource.ResourceServiceClient,
+	ids []*pbresource.ID,
+	missing MissingNodeMode,
+	opts ...Option,
+) ([]*BulkNodeHealthResult, error) {
+	r := newNodeHealthReconciler(opts...)
+	rt := controller.Runtime{Client: client}
+
+	results := make([]*BulkNodeHealthResult, 0, len(ids))
+	for _, id := range ids {
+		result := &BulkNodeHealthResult{ID: id}
+		results = append(results, result)
+
+		rsp, err := client.Read(ctx, &pbresource.ReadRequest{Id: id})
+		switch {
+		case status.Code(err) == codes.NotFound:
+			// A node that doesn't exist owns no HealthStatus resources.
+			if missing == MissingNodeNotFound {
+				result.NotFound = true
+			} else {
+				result.Health = pbcatalog.Health_HEALTH_PASSING
+				result.CheckCounts = make(map[pbcatalog.Health]int)
+			}
+			continue
+		case status.Code(err) == codes.PermissionDenied:
+			result.Err = err
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		checks, err := r.listNodeChecks(ctx, rt, rsp.Resource.Id)
+		if err != nil {
+			return nil, err
+		}
+		result.Health = checks.health()
+		result.CheckCounts = checks.counts
+	}
+	return results, nil
+}
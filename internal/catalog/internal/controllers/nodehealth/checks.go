@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/resource"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// childrenPageSize is the number of owned resources listed per ListByOwner
+// call, bounding the size of each response for nodes with many checks.
+const childrenPageSize = 64
+
+// nodeChecks holds what a node's health, condition and score are computed
+// from. Its HealthStatus resources are folded into it as they are listed, so
+// that with the built-in aggregation strategies no more than a page of them is
+// held at once however many checks the node has. A custom strategy is given all
+// of them at once.
+type nodeChecks struct {
+	r *nodeHealthReconciler
+
+	// count is the number of HealthStatus resources folded in, and counts the
+	// number with each health after any check bounds have been applied.
+	count  int
+	counts map[pbcatalog.Health]int
+
+	// expected records which of the configured expected check types have a
+	// HealthStatus.
+	expected map[string]bool
+
+	// folding is set when the configured strategy is a built-in severity
+	// strategy, in which case folded is the health aggregated from the
+	// HealthStatus resources so far. Otherwise statuses holds all of them for
+	// the strategy to aggregate once they have been listed.
+	folding  bool
+	severity severityStrategy
+	folded   pbcatalog.Health
+	statuses []*pbcatalog.HealthStatus
+
+	// sample is the first sampleSize HealthStatus resources, when sampling is
+	// enabled.
+	sample []*pbcatalog.HealthStatus
+
+	// failing is the most severe of the failing checks, at most
+	// failingChecksLimit of them, and failingCount is how many there are.
+	failing      []failingCheck
+	failingCount int
+
+	// maintenance is the most recently written HealthStatus in maintenance.
+	maintenance           *pbcatalog.HealthStatus
+	maintenanceGeneration string
+
+	score healthScore
+}
+
+func (r *nodeHealthReconciler) newNodeChecks() *nodeChecks {
+	c := &nodeChecks{
+		r:      r,
+		counts: make(map[pbcatalog.Health]int),
+		folded: pbcatalog.Health_HEALTH_PASSING,
+	}
+	c.severity, c.folding = r.foldingStrategy()
+	if len(r.expectedChecks) > 0 {
+		c.expected = make(map[string]bool, len(r.expectedChecks))
+		for _, checkType := range r.expectedChecks {
+			c.expected[checkType] = false
+		}
+	}
+	return c
+}
+
+// listNodeChecks lists the resources owned by the node a page at a time, in
+// order of type and name, and folds its HealthStatus resources into the
+// returned nodeChecks, excluding any from other reporters when a reporter
+// filter is set. When sampling is enabled, the node's children are only listed
+// until enough HealthStatus resources have been to sample them.
+func (r *nodeHealthReconciler) listNodeChecks(ctx context.Context, rt controller.Runtime, nodeRef *pbresource.ID) (*nodeChecks, error) {
+	checks := r.newNodeChecks()
+	var pageToken string
+	for {
+		rsp, err := rt.Client.ListByOwner(ctx, &pbresource.ListByOwnerRequest{
+			Owner:     nodeRef,
+			PageSize:  childrenPageSize,
+			PageToken: pageToken,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, child := range rsp.Resources {
+			if !resource.EqualType(child.Id.Type, pbcatalog.HealthStatusType) {
+				continue
+			}
+			if r.reporterKey != "" && child.Metadata[r.reporterKey] != r.reporter {
+				continue
+			}
+			if err := checks.add(child); err != nil {
+				return nil, err
+			}
+		}
+
+		if rsp.NextPageToken == "" || checks.sampled() {
+			return checks, nil
+		}
+		pageToken = rsp.NextPageToken
+	}
+}
+
+// add folds the given HealthStatus resource in.
+func (c *nodeChecks) add(res *pbresource.Resource) error {
+	var hs pbcatalog.HealthStatus
+	if err := res.Data.UnmarshalTo(&hs); err != nil {
+		// This should be impossible as the resource service + type validations the
+		// catalog is performing will ensure that no data gets written where unmarshalling
+		// to this type will error.
+		return fmt.Errorf("error unmarshalling health status data: %w", err)
+	}
+	if b, ok := c.r.checkBounds[hs.Type]; ok {
+		hs.Status = b.clamp(hs.Status)
+	}
+
+	c.count++
+	c.counts[hs.Status]++
+	if c.folding {
+		c.folded = c.severity.fold(c.folded, hs.Status)
+	} else {
+		c.statuses = append(c.statuses, &hs)
+	}
+	if c.r.sampleSize > 0 && len(c.sample) < c.r.sampleSize {
+		c.sample = append(c.sample, &hs)
+	}
+	if _, ok := c.expected[hs.Type]; ok {
+		c.expected[hs.Type] = true
+	}
+	if c.r.scoreWeights != nil {
+		c.score.add(hs.Status, c.r.scoreWeights)
+	}
+
+	if hs.Status != pbcatalog.Health_HEALTH_PASSING {
+		c.addFailing(res.Id.Name, hs.Status)
+	}
+	// Generations are ULIDs, so the greatest is the most recent write.
+	if hs.Status == pbcatalog.Health_HEALTH_MAINTENANCE && (c.maintenance == nil || res.Generation > c.maintenanceGeneration) {
+		c.maintenance, c.maintenanceGeneration = &hs, res.Generation
+	}
+	return nil
+}
+
+// addFailing keeps the check with the given name and health if it is among the
+// failingChecksLimit most severe failing checks.
+func (c *nodeChecks) addFailing(name string, health pbcatalog.Health) {
+	if c.r.failingChecksLimit == 0 {
+		return
+	}
+	c.failingCount++
+
+	c.failing = append(c.failing, failingCheck{name: name, health: health})
+	// Sort so that the message, and so the status, is stable across reconciles.
+	sort.Slice(c.failing, func(i, j int) bool {
+		if c.failing[i].health != c.failing[j].health {
+			return c.failing[i].health > c.failing[j].health
+		}
+		return c.failing[i].name < c.failing[j].name
+	})
+	if len(c.failing) > c.r.failingChecksLimit {
+		c.failing = c.failing[:c.r.failingChecksLimit]
+	}
+}
+
+// sampled reports whether the node's health is estimated from a sample of its
+// HealthStatus resources.
+func (c *nodeChecks) sampled() bool {
+	return c.r.sampleListed(c.count)
+}
+
+// health computes the node's health, or that of a sample of its checks, using
+// the configured strategy.
+func (c *nodeChecks) health() pbcatalog.Health {
+	if c.sampled() {
+		health := c.r.aggregationStrategy().Aggregate(c.sample)
+		if health == pbcatalog.Health_HEALTH_MAINTENANCE &&
+			c.r.maintenanceConflict == MaintenanceConflictPreferCritical &&
+			criticalInMaintenance(c.sample) {
+			health = pbcatalog.Health_HEALTH_CRITICAL
+		}
+		return health
+	}
+
+	health := c.folded
+	if !c.folding {
+		health = c.r.aggregationStrategy().Aggregate(c.statuses)
+	}
+
+	if health == pbcatalog.Health_HEALTH_MAINTENANCE &&
+		c.r.maintenanceConflict == MaintenanceConflictPreferCritical &&
+		c.criticalInMaintenance() {
+		health = pbcatalog.Health_HEALTH_CRITICAL
+	}
+
+	if c.r.rank(health) < c.r.rank(c.r.missingCheckSeverity) && c.missingExpectedCheck() {
+		health = c.r.missingCheckSeverity
+	}
+	return health
+}
+
+// missingExpectedCheck reports whether any of the expected check types has no
+// HealthStatus.
+func (c *nodeChecks) missingExpectedCheck() bool {
+	for _, present := range c.expected {
+		if !present {
+			return true
+		}
+	}
+	return false
+}
+
+// criticalInMaintenance reports whether any of the checks is in maintenance
+// while another is critical.
+func (c *nodeChecks) criticalInMaintenance() bool {
+	return c.counts[pbcatalog.Health_HEALTH_MAINTENANCE] > 0 && c.counts[pbcatalog.Health_HEALTH_CRITICAL] > 0
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+)
+
+// MaintenanceConflictPolicy decides the health reported for a node that is in
+// maintenance while one of its checks is critical.
+type MaintenanceConflictPolicy int
+
+const (
+	// MaintenanceConflictPreferMaintenance reports the node as MAINTENANCE,
+	// silencing the critical check. This is the default.
+	MaintenanceConflictPreferMaintenance MaintenanceConflictPolicy = iota
+
+	// MaintenanceConflictPreferCritical reports the node as CRITICAL, so that
+	// the failing check still pages.
+	MaintenanceConflictPreferCritical
+
+	// MaintenanceConflictDistinctReason reports the node as MAINTENANCE, but
+	// with the ConditionCriticalInMaintenance condition so that the conflict is
+	// visible to operators.
+	MaintenanceConflictDistinctReason
+)
+
+// criticalInMaintenance reports whether statuses contain both a MAINTENANCE
+// and a CRITICAL status.
+func criticalInMaintenance(statuses []*pbcatalog.HealthStatus) bool {
+	var maintenance, critical bool
+	for _, hs := range statuses {
+		switch hs.Status {
+		case pbcatalog.Health_HEALTH_MAINTENANCE:
+			maintenance = true
+		case pbcatalog.Health_HEALTH_CRITICAL:
+			critical = true
+		}
+	}
+	return maintenance && critical
+}
@@ -6,9 +6,15 @@ package nodehealth
 import (
 	"context"
 	"fmt"
+	"strings"
+	"text/template"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/hashicorp/consul/internal/controller"
 	"github.com/hashicorp/consul/internal/resource"
@@ -16,13 +22,383 @@ import (
 	"github.com/hashicorp/consul/proto-public/pbresource"
 )
 
-func NodeHealthController() controller.Controller {
-	return controller.ForType(pbcatalog.NodeType).
+// ControllerName is the name under which the node health controller is
+// registered with the controller Manager.
+const ControllerName = "consul.io/node-health"
+
+// Option configures the node health controller.
+type Option func(*nodeHealthReconciler)
+
+// WithAggregationStrategy selects the registered AggregationStrategy used to
+// compute a node's health from its HealthStatus resources. NodeHealthController
+// panics if no strategy has been registered under the given name.
+func WithAggregationStrategy(name string) Option {
+	return func(r *nodeHealthReconciler) {
+		strategy, ok := lookupAggregationStrategy(name)
+		if !ok {
+			panic(fmt.Sprintf("unknown node health aggregation strategy %q", name))
+		}
+		r.strategy = strategy
+	}
+}
+
+// WithConditionState overrides the state of the healthy condition written for
+// nodes with the given health. By default PASSING nodes have a STATE_TRUE
+// condition and all other nodes have a STATE_FALSE condition.
+func WithConditionState(health pbcatalog.Health, state pbresource.Condition_State) Option {
+	return func(r *nodeHealthReconciler) {
+		if r.conditionStates == nil {
+			r.conditionStates = make(map[pbcatalog.Health]pbresource.Condition_State)
+		}
+		r.conditionStates[health] = state
+	}
+}
+
+// WithConditionTTL sets a TTL on the node health status so that consumers can
+// detect when it has gone stale, e.g. because the controller has stopped. The
+// controller refreshes each node's status within the TTL even when its health
+// is unchanged.
+func WithConditionTTL(ttl time.Duration) Option {
+	return func(r *nodeHealthReconciler) {
+		r.conditionTTL = ttl
+	}
+}
+
+// WithCheckCeiling caps the contribution of HealthStatus resources of the given
+// check type to the node's health at ceiling, e.g. so that a noisy check can
+// never make a node worse than WARNING.
+func WithCheckCeiling(checkType string, ceiling pbcatalog.Health) Option {
+	return func(r *nodeHealthReconciler) {
+		b := r.checkBoundsFor(checkType)
+		b.ceiling = ceiling
+		r.checkBounds[checkType] = b
+	}
+}
+
+// WithCheckFloor raises the contribution of HealthStatus resources of the given
+// check type to the node's health to at least floor, e.g. so that a critical
+// security check can never be reported as anything better than CRITICAL.
+func WithCheckFloor(checkType string, floor pbcatalog.Health) Option {
+	return func(r *nodeHealthReconciler) {
+		b := r.checkBoundsFor(checkType)
+		b.floor = floor
+		r.checkBounds[checkType] = b
+	}
+}
+
+// WithEventSink publishes a HealthTransition to sink whenever the health
+// reported for a node changes, e.g. from PASSING to CRITICAL. Rewrites of a
+// node's status that don't change its health aren't published.
+func WithEventSink(sink EventSink) Option {
+	return func(r *nodeHealthReconciler) {
+		r.eventSink = sink
+	}
+}
+
+// WithExpectedChecks makes nodes missing a HealthStatus resource of any of the
+// given check types report at least missingSeverity, so that a gap in
+// monitoring is flagged rather than the node appearing healthy. Severity is that
+// of the aggregation strategy, e.g. as given to WithHealthPrecedence.
+func WithExpectedChecks(missingSeverity pbcatalog.Health, checkTypes ...string) Option {
+	return func(r *nodeHealthReconciler) {
+		r.expectedChecks = checkTypes
+		r.missingCheckSeverity = missingSeverity
+	}
+}
+
+// WithHealthScore enables computing a continuous health score for each node,
+// from 0 (all checks critical) to MaxHealthScore (all checks passing), as the
+// average of its checks' scores weighted by their health. For example, giving
+// HEALTH_CRITICAL a weight of 4 makes a critical check count four times as
+// much as a passing one. Health levels without a weight have a weight of 1.
+// The score is returned by ReadLiveNodeHealth.
+func WithHealthScore(weights map[pbcatalog.Health]float64) Option {
+	return func(r *nodeHealthReconciler) {
+		r.scoreWeights = weights
+		if r.scoreWeights == nil {
+			r.scoreWeights = make(map[pbcatalog.Health]float64)
+		}
+	}
+}
+
+// WithHealthPrecedence aggregates a node's HealthStatus resources to the most
+// severe of them, like the MaxSeverityStrategy, but with severity given by the
+// precedence of each health instead of the order of the pbcatalog.Health enum.
+// Health levels without a precedence keep their enum value, so an empty table
+// behaves exactly like the default. As it replaces the aggregation strategy, the
+// last of WithHealthPrecedence and WithAggregationStrategy given wins.
+func WithHealthPrecedence(precedence map[pbcatalog.Health]int) Option {
+	return func(r *nodeHealthReconciler) {
+		r.strategy = precedenceStrategy(precedence)
+	}
+}
+
+// WithLocale makes the controller write condition messages translated into the
+// given locale using catalog, falling back to the DefaultLocale for messages
+// the catalog doesn't translate. Only the human readable messages are
+// translated: condition reasons stay the same in every locale.
+func WithLocale(catalog MessageCatalog, locale string) Option {
+	return func(r *nodeHealthReconciler) {
+		r.messages = catalog
+		r.locale = locale
+	}
+}
+
+// WithMaintenanceConflictPolicy selects the health reported for nodes that are
+// in maintenance while one of their checks is critical. By default such nodes
+// are reported as MAINTENANCE.
+func WithMaintenanceConflictPolicy(policy MaintenanceConflictPolicy) Option {
+	return func(r *nodeHealthReconciler) {
+		r.maintenanceConflict = policy
+	}
+}
+
+// WithMaintenanceMessage renders the message of the MAINTENANCE condition from
+// tmpl, a text/template executed with the node's most recently written
+// *pbcatalog.HealthStatus in maintenance, e.g. "In maintenance: {{.Description}}".
+// The default message is kept when that status has no description. It panics
+// if tmpl can't be parsed.
+func WithMaintenanceMessage(tmpl string) Option {
+	t := template.Must(template.New("maintenance").Parse(tmpl))
+	return func(r *nodeHealthReconciler) {
+		r.maintenanceMessage = t
+	}
+}
+
+// WithOptOutLabel excludes nodes whose metadata has key set to value (e.g.
+// consul.io/health=disabled) from health management. The controller still
+// reads such nodes but no longer computes their health, reporting them with
+// ConditionUnmanaged instead so that consumers of the node health status don't
+// wait on a health that won't be written. Removing the label resumes
+// management.
+func WithOptOutLabel(key, value string) Option {
+	return func(r *nodeHealthReconciler) {
+		r.optOutKey = key
+		r.optOutValue = value
+	}
+}
+
+// WithLastErrorRecording makes the controller record the error from a failed
+// reconcile, and when it occurred, in the LastReconcileError field of the
+// node's status. The healthy condition is left as it was so that a transient
+// failure doesn't change the node's reported health, and a node that fails its
+// first reconcile is reported by ReportedHealth as not yet reconciled. The error
+// is cleared by the next successful reconcile.
+func WithLastErrorRecording() Option {
+	return func(r *nodeHealthReconciler) {
+		r.recordErrors = true
+	}
+}
+
+// WithReporterFilter restricts the HealthStatus resources considered when
+// computing a node's health to those whose metadata has key set to reporter,
+// e.g. to isolate which of several agents is driving a node's health when
+// debugging with ReadLiveNodeHealth. By default statuses from all reporters
+// are considered.
+func WithReporterFilter(key, reporter string) Option {
+	return func(r *nodeHealthReconciler) {
+		r.reporterKey = key
+		r.reporter = reporter
+	}
+}
+
+// WithSampling bounds the cost of aggregating the health of nodes with more
+// than threshold HealthStatus resources by aggregating a sample of sampleSize of
+// them instead, so a node is CRITICAL if any sampled check is. The sample is the
+// first sampleSize checks by name, so that it is the same on every reconcile,
+// and a node's checks are only listed until it is known to exceed threshold.
+// The message of the condition written for such nodes ends with
+// NodeSampledMessage to make clear that its health is an estimate, while its
+// reason is the usual one. Expected checks (see WithExpectedChecks) aren't
+// enforced for sampled nodes, as the sample may not include them. By default
+// health is always aggregated exactly.
+func WithSampling(threshold, sampleSize int) Option {
+	return func(r *nodeHealthReconciler) {
+		r.sampleThreshold = threshold
+		r.sampleSize = sampleSize
+	}
+}
+
+// WithStickyHealth makes a node that has been reported with at least the given
+// severity keep that health, even if its checks recover, until it is
+// acknowledged by setting the ackKey metadata label on the node, e.g. to force
+// a human review of incidents. Once acknowledged the node's health is computed
+// normally; removing the label re-arms the sticky behavior. As with
+// WithExpectedChecks, severity is that of the aggregation strategy.
+func WithStickyHealth(severity pbcatalog.Health, ackKey string) Option {
+	return func(r *nodeHealthReconciler) {
+		r.stickySeverity = severity
+		r.stickyAckKey = ackKey
+	}
+}
+
+// WithUnknownFieldPreservation makes the controller keep the fields of the
+// existing healthy condition that it doesn't know about, such as those added
+// by a newer version of the Condition schema, when it rewrites a node's status.
+// This keeps them from being dropped while controllers of different versions
+// are running, e.g. during an upgrade.
+func WithUnknownFieldPreservation() Option {
+	return func(r *nodeHealthReconciler) {
+		r.preserveUnknownFields = true
+	}
+}
+
+// WithStartupRamp spreads the reconciles of all existing nodes when the
+// controller starts, such as after a leader election, over the given window so
+// that they don't all hit the backend at once.
+func WithStartupRamp(window time.Duration) Option {
+	return func(r *nodeHealthReconciler) {
+		r.startupRamp = window
+	}
+}
+
+// WithWorkers sets the number of nodes whose health may be reconciled
+// concurrently. The default is a single worker.
+func WithWorkers(workers int) Option {
+	return func(r *nodeHealthReconciler) {
+		r.workers = workers
+	}
+}
+
+func NodeHealthController(opts ...Option) controller.Controller {
+	r := newNodeHealthReconciler(opts...)
+	ctrl := controller.ForType(pbcatalog.NodeType).
+		WithName(ControllerName).
 		WithWatch(pbcatalog.HealthStatusType, controller.MapOwnerFiltered(pbcatalog.NodeType)).
-		WithReconciler(&nodeHealthReconciler{})
+		WithStartupRamp(r.startupRamp)
+	if r.workers > 0 {
+		ctrl = ctrl.WithWorkers(r.workers)
+	}
+	return ctrl.WithReconciler(r)
+}
+
+type nodeHealthReconciler struct {
+	// strategy is used to aggregate the node's HealthStatus resources. When nil,
+	// the MaxSeverityStrategy is used.
+	strategy AggregationStrategy
+
+	// conditionStates overrides the state of the condition written for each
+	// health. Health levels without an override use the state in Conditions.
+	conditionStates map[pbcatalog.Health]pbresource.Condition_State
+
+	// conditionTTL is the TTL set on the status written for each node, which
+	// is refreshed at half that interval. Statuses don't expire when zero.
+	conditionTTL time.Duration
+
+	// checkBounds clamps the health of HealthStatus resources by check type
+	// before they are aggregated.
+	checkBounds map[string]healthBounds
+
+	// expectedChecks are the check types every node must have a HealthStatus
+	// for. Nodes missing any have at least missingCheckSeverity health.
+	expectedChecks       []string
+	missingCheckSeverity pbcatalog.Health
+
+	// scoreWeights are the weights of each health when computing a node's
+	// health score. No score is computed when nil.
+	scoreWeights map[pbcatalog.Health]float64
+
+	// failingChecksLimit, when non-zero, is the number of failing checks listed
+	// in the message of the WARNING and CRITICAL conditions.
+	failingChecksLimit int
+
+	// messages and locale select the translation of the condition messages.
+	// Messages aren't translated when locale is empty.
+	messages MessageCatalog
+	locale   string
+
+	// maintenanceConflict decides the health of nodes in maintenance with a
+	// critical check.
+	maintenanceConflict MaintenanceConflictPolicy
+
+	// maintenanceMessage, when non-nil, renders the message of the MAINTENANCE
+	// condition from the HealthStatus that put the node in maintenance.
+	maintenanceMessage *template.Template
+
+	// optOutKey and optOutValue identify the metadata label of nodes that are
+	// excluded from health management. No nodes are excluded when optOutKey is
+	// empty.
+	optOutKey   string
+	optOutValue string
+
+	// reporterKey and reporter identify the metadata label of the HealthStatus
+	// resources considered. All are considered when reporterKey is empty.
+	reporterKey string
+	reporter    string
+
+	// sampleThreshold is the number of HealthStatus resources above which a
+	// sample of sampleSize of them is aggregated. Health is never sampled when
+	// sampleThreshold is zero.
+	sampleThreshold int
+	sampleSize      int
+
+	// stickySeverity is the health at and above which a node's reported health
+	// is kept until the node has the stickyAckKey metadata label. Health is
+	// never sticky when stickyAckKey is empty.
+	stickySeverity pbcatalog.Health
+	stickyAckKey   string
+
+	// eventSink, when non-nil, receives the transitions of nodes' health.
+	eventSink EventSink
+
+	// grace, when non-nil, delays reporting nodes that were PASSING as
+	// unhealthy.
+	grace *gracePeriods
+
+	// recordErrors enables writing reconcile errors to the node's status.
+	recordErrors bool
+
+	// preserveUnknownFields enables carrying over the unknown fields of the
+	// existing conditions when writing the node's status.
+	preserveUnknownFields bool
+
+	// startupRamp is the window over which the initial reconciles are spread
+	// when the controller starts. It is applied to the controller rather than
+	// used by the reconciler itself.
+	startupRamp time.Duration
+
+	// workers is the number of concurrent reconciles. Like startupRamp, it is
+	// applied to the controller. The controller's default is used when zero.
+	workers int
+}
+
+// healthBounds is the range of health a check type may contribute.
+type healthBounds struct {
+	floor   pbcatalog.Health
+	ceiling pbcatalog.Health
+}
+
+func (b healthBounds) clamp(health pbcatalog.Health) pbcatalog.Health {
+	if health < b.floor {
+		return b.floor
+	}
+	if health > b.ceiling {
+		return b.ceiling
+	}
+	return health
 }
 
-type nodeHealthReconciler struct{}
+func (r *nodeHealthReconciler) checkBoundsFor(checkType string) healthBounds {
+	if r.checkBounds == nil {
+		r.checkBounds = make(map[string]healthBounds)
+	}
+	b, ok := r.checkBounds[checkType]
+	if !ok {
+		b = healthBounds{
+			floor:   pbcatalog.Health_HEALTH_PASSING,
+			ceiling: pbcatalog.Health_HEALTH_MAINTENANCE,
+		}
+	}
+	return b
+}
+
+func newNodeHealthReconciler(opts ...Option) *nodeHealthReconciler {
+	r := &nodeHealthReconciler{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
 
 func (r *nodeHealthReconciler) Reconcile(ctx context.Context, rt controller.Runtime, req controller.Request) error {
 	// The runtime is passed by value so replacing it here for the remainder of this
@@ -36,6 +412,9 @@ func (r *nodeHealthReconciler) Reconcile(ctx context.Context, rt controller.Runt
 	switch {
 	case status.Code(err) == codes.NotFound:
 		rt.Logger.Trace("node has been deleted")
+		if r.grace != nil {
+			r.grace.delete(req.ID)
+		}
 		return nil
 	case err != nil:
 		rt.Logger.Error("the resource service has returned an unexpected error", "error", err)
@@ -44,7 +423,22 @@ func (r *nodeHealthReconciler) Reconcile(ctx context.Context, rt controller.Runt
 
 	res := rsp.Resource
 
-	health, err := getNodeHealth(ctx, rt, req.ID)
+	if r.optedOut(res) {
+		return r.writeUnmanagedStatus(ctx, rt, res)
+	}
+
+	checks, err := r.listNodeChecks(ctx, rt, res.Id)
+	if err != nil {
+		rt.Logger.Error("failed to calculate the nodes health", "error", err)
+		if r.recordErrors {
+			r.recordReconcileError(ctx, rt, res, err)
+		}
+		return err
+	}
+	health := r.stickyHealth(res, checks.health())
+	health, recheck := r.graceHealth(res, health)
+
+	cond, err := r.reportedCondition(checks, health)
 	if err != nil {
 		rt.Logger.Error("failed to calculate the nodes health", "error", err)
 		return err
@@ -53,13 +447,21 @@ func (r *nodeHealthReconciler) Reconcile(ctx context.Context, rt controller.Runt
 	newStatus := &pbresource.Status{
 		ObservedGeneration: res.Generation,
 		Conditions: []*pbresource.Condition{
-			Conditions[health],
+			cond,
 		},
 	}
+	if r.conditionTTL > 0 {
+		newStatus.Ttl = durationpb.New(r.conditionTTL)
+	}
 
-	if resource.EqualStatus(res.Status[StatusKey], newStatus, false) {
+	existing := res.Status[StatusKey]
+	if resource.EqualStatus(existing, newStatus, false) && !r.needsRefresh(existing) {
 		rt.Logger.Trace("resources node health status is unchanged", "health", health.String())
-		return nil
+		return r.scheduleRequeue(recheck)
+	}
+
+	if r.preserveUnknownFields {
+		resource.PreserveUnknownConditionFields(existing, newStatus)
 	}
 
 	_, err = rt.Client.WriteStatus(ctx, &pbresource.WriteStatusRequest{
@@ -73,36 +475,230 @@ func (r *nodeHealthReconciler) Reconcile(ctx context.Context, rt controller.Runt
 		return err
 	}
 
+	recordTransition(rt, existing, health)
+	r.publishTransition(res.Id, existing, health)
+
 	rt.Logger.Trace("resources node health status was updated", "health", health.String())
-	return nil
+	return r.scheduleRequeue(recheck)
+}
+
+// needsRefresh reports whether the existing status should be rewritten, even
+// though it is unchanged, so that it doesn't outlive its TTL.
+func (r *nodeHealthReconciler) needsRefresh(existing *pbresource.Status) bool {
+	if r.conditionTTL <= 0 {
+		return false
+	}
+	return time.Since(existing.UpdatedAt.AsTime()) >= r.conditionTTL/2
+}
+
+// scheduleRequeue requeues the node so that its status is refreshed before its
+// TTL elapses, when a TTL is set, and so that its health is re-checked after
+// recheck, when non-zero.
+func (r *nodeHealthReconciler) scheduleRequeue(recheck time.Duration) error {
+	after := recheck
+	if r.conditionTTL > 0 && (after == 0 || r.conditionTTL/2 < after) {
+		after = r.conditionTTL / 2
+	}
+	if after <= 0 {
+		return nil
+	}
+	return controller.RequeueAfter(after)
+}
+
+func (r *nodeHealthReconciler) optedOut(res *pbresource.Resource) bool {
+	if r.optOutKey == "" {
+		return false
+	}
+	v, ok := res.Metadata[r.optOutKey]
+	return ok && v == r.optOutValue
 }
 
-func getNodeHealth(ctx context.Context, rt controller.Runtime, nodeRef *pbresource.ID) (pbcatalog.Health, error) {
-	rsp, err := rt.Client.ListByOwner(ctx, &pbresource.ListByOwnerRequest{
-		Owner: nodeRef,
+// writeUnmanagedStatus reports a node that has opted out of health management
+// with the unmanaged condition, replacing any it previously had.
+func (r *nodeHealthReconciler) writeUnmanagedStatus(ctx context.Context, rt controller.Runtime, res *pbresource.Resource) error {
+	if r.grace != nil {
+		r.grace.delete(res.Id)
+	}
+
+	newStatus := &pbresource.Status{
+		ObservedGeneration: res.Generation,
+		Conditions:         []*pbresource.Condition{r.localize(ConditionUnmanaged)},
+	}
+	if resource.EqualStatus(res.Status[StatusKey], newStatus, false) {
+		rt.Logger.Trace("node has opted out of health management")
+		return nil
+	}
+
+	_, err := rt.Client.WriteStatus(ctx, &pbresource.WriteStatusRequest{
+		Id:     res.Id,
+		Key:    StatusKey,
+		Status: newStatus,
 	})
+	if err != nil {
+		rt.Logger.Error("error encountered when attempting to update the resources node health status", "error", err)
+		return err
+	}
+
+	rt.Logger.Trace("node has opted out of health management, marked it as unmanaged")
+	return nil
+}
+
+// recordReconcileError writes reconcileErr to the node's status, keeping the
+// conditions and observed generation from the last successful reconcile. A
+// failure to write the status is logged rather than returned so that the
+// original error is the one propagated to the controller.
+func (r *nodeHealthReconciler) recordReconcileError(ctx context.Context, rt controller.Runtime, res *pbresource.Resource, reconcileErr error) {
+	newStatus := &pbresource.Status{ObservedGeneration: res.Generation}
+	if existing, ok := res.Status[StatusKey]; ok {
+		newStatus.ObservedGeneration = existing.ObservedGeneration
+		newStatus.Conditions = existing.Conditions
+	}
+	newStatus.LastReconcileError = &pbresource.ReconcileError{
+		Message:    reconcileErr.Error(),
+		OccurredAt: timestamppb.Now(),
+	}
 
+	_, err := rt.Client.WriteStatus(ctx, &pbresource.WriteStatusRequest{
+		Id:     res.Id,
+		Key:    StatusKey,
+		Status: newStatus,
+	})
 	if err != nil {
-		return pbcatalog.Health_HEALTH_CRITICAL, err
+		rt.Logger.Error("error encountered when attempting to record the reconcile error in the resources node health status", "error", err)
+	}
+}
+
+// stickyHealth returns the health to report for the node given its computed
+// health. The health previously reported for the node is kept if it is at
+// least the sticky severity and the node hasn't been acknowledged.
+func (r *nodeHealthReconciler) stickyHealth(node *pbresource.Resource, health pbcatalog.Health) pbcatalog.Health {
+	if r.stickyAckKey == "" {
+		return health
+	}
+	if _, acked := node.Metadata[r.stickyAckKey]; acked {
+		return health
 	}
 
-	health := pbcatalog.Health_HEALTH_PASSING
+	stored, ok := node.Status[StatusKey]
+	if !ok || len(stored.Conditions) == 0 {
+		return health
+	}
+	reported, _ := HealthFromReason(stored.Conditions[0].Reason)
+	if r.rank(reported) >= r.rank(r.stickySeverity) && r.rank(reported) > r.rank(health) {
+		return reported
+	}
+	return health
+}
 
-	for _, res := range rsp.Resources {
-		if resource.EqualType(res.Id.Type, pbcatalog.HealthStatusType) {
-			var hs pbcatalog.HealthStatus
-			if err := res.Data.UnmarshalTo(&hs); err != nil {
-				// This should be impossible as the resource service + type validations the
-				// catalog is performing will ensure that no data gets written where unmarshalling
-				// to this type will error.
-				return pbcatalog.Health_HEALTH_CRITICAL, fmt.Errorf("error unmarshalling health status data: %w", err)
-			}
+// condition returns the healthy condition for the given health, with any
+// configured state override and translation applied.
+func (r *nodeHealthReconciler) condition(health pbcatalog.Health) *pbresource.Condition {
+	cond := r.localize(Conditions[health])
+	state, ok := r.conditionStates[health]
+	if !ok || state == cond.State {
+		return cond
+	}
 
-			if hs.Status > health {
-				health = hs.Status
-			}
-		}
+	if cond == Conditions[health] {
+		cond = proto.Clone(cond).(*pbresource.Condition)
+	}
+	cond.State = state
+	return cond
+}
+
+// reportedCondition returns the healthy condition to write for a node with the
+// given checks and health. It differs from condition(health) when the node's
+// failing checks are listed in its message, when its health was estimated from
+// a sample of its HealthStatus resources, or when it is in maintenance with a
+// critical check and the maintenance conflict policy is
+// MaintenanceConflictDistinctReason.
+func (r *nodeHealthReconciler) reportedCondition(checks *nodeChecks, health pbcatalog.Health) (*pbresource.Condition, error) {
+	cond := r.condition(health)
+
+	if health == pbcatalog.Health_HEALTH_WARNING || health == pbcatalog.Health_HEALTH_CRITICAL {
+		cond = r.failingChecksCondition(checks, cond)
+	}
+
+	if checks.sampled() {
+		return r.sampledCondition(cond), nil
+	}
+
+	if health != pbcatalog.Health_HEALTH_MAINTENANCE {
+		return cond, nil
+	}
+
+	if r.maintenanceConflict == MaintenanceConflictDistinctReason && checks.criticalInMaintenance() {
+		// Keep any state override configured for MAINTENANCE.
+		conflict := proto.Clone(r.localize(ConditionCriticalInMaintenance)).(*pbresource.Condition)
+		conflict.State = cond.State
+		return conflict, nil
+	}
+
+	if r.maintenanceMessage == nil {
+		return cond, nil
+	}
+	return r.maintenanceCondition(checks, cond)
+}
+
+// maintenanceCondition returns cond with its message rendered from the
+// configured maintenance template and the most recently written HealthStatus
+// in maintenance. cond is returned as is when that status has no description.
+func (r *nodeHealthReconciler) maintenanceCondition(checks *nodeChecks, cond *pbresource.Condition) (*pbresource.Condition, error) {
+	maintenance := checks.maintenance
+	if maintenance == nil || maintenance.Description == "" {
+		return cond, nil
+	}
+
+	var msg strings.Builder
+	if err := r.maintenanceMessage.Execute(&msg, maintenance); err != nil {
+		return nil, fmt.Errorf("error rendering the maintenance message: %w", err)
+	}
+
+	cond = proto.Clone(cond).(*pbresource.Condition)
+	cond.Message = msg.String()
+	return cond, nil
+}
+
+// localize returns cond with its message translated into the configured
+// locale. cond is returned as is when there's no translation.
+func (r *nodeHealthReconciler) localize(cond *pbresource.Condition) *pbresource.Condition {
+	if r.locale == "" || r.locale == DefaultLocale {
+		return cond
+	}
+	msg := r.messages.message(r.locale, cond.Message)
+	if msg == cond.Message {
+		return cond
+	}
+
+	cond = proto.Clone(cond).(*pbresource.Condition)
+	cond.Message = msg
+	return cond
+}
+
+func (r *nodeHealthReconciler) getNodeHealth(ctx context.Context, rt controller.Runtime, nodeRef *pbresource.ID) (pbcatalog.Health, error) {
+	health, _, err := r.getNodeHealthAndScore(ctx, rt, nodeRef)
+	return health, err
+}
+
+// getNodeHealthAndScore returns the health of the given node and, when a
+// health score has been enabled with WithHealthScore, its score. The score is
+// nil otherwise.
+func (r *nodeHealthReconciler) getNodeHealthAndScore(ctx context.Context, rt controller.Runtime, nodeRef *pbresource.ID) (pbcatalog.Health, *float64, error) {
+	checks, err := r.listNodeChecks(ctx, rt, nodeRef)
+	if err != nil {
+		return pbcatalog.Health_HEALTH_CRITICAL, nil, err
 	}
+	health, score := r.healthAndScore(checks)
+	return health, score, nil
+}
 
-	return health, nil
+// healthAndScore aggregates the health of a node with the given checks and,
+// when a health score has been enabled, returns its score.
+func (r *nodeHealthReconciler) healthAndScore(checks *nodeChecks) (pbcatalog.Health, *float64) {
+	health := checks.health()
+	if r.scoreWeights == nil {
+		return health, nil
+	}
+	score := checks.score.value()
+	return health, &score
 }
@@ -6,19 +6,27 @@ package nodehealth
 import (
 	"context"
 	"fmt"
-	"github.com/hashicorp/consul/agent/structs"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
 
 	mockres "github.com/hashicorp/consul/agent/grpc-external/services/resource"
 	svctest "github.com/hashicorp/consul/agent/grpc-external/services/resource/testing"
+	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/internal/catalog/internal/types"
 	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/resource/expiry"
 	"github.com/hashicorp/consul/internal/resource/resourcetest"
 	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
 	"github.com/hashicorp/consul/proto-public/pbresource"
@@ -93,6 +101,7 @@ func (suite *nodeHealthControllerTestSuite) SetupTest() {
 	}
 	client := svctest.RunResourceServiceWithConfig(suite.T(), cfg, types.Register, types.RegisterDNSPolicy)
 	suite.resourceClient = resourcetest.NewClient(client)
+	suite.ctl = nodeHealthReconciler{}
 	suite.runtime = controller.Runtime{Client: suite.resourceClient, Logger: testutil.Logger(suite.T())}
 	suite.isEnterprise = structs.NodeEnterpriseMetaInDefaultPartition().PartitionOrEmpty() == "default"
 }
@@ -109,7 +118,7 @@ func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthListError() {
 			"irrelevant",
 			tenancy,
 		)
-		health, err := getNodeHealth(context.Background(), suite.runtime, ref)
+		health, err := suite.ctl.getNodeHealth(context.Background(), suite.runtime, ref)
 		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, health)
 		require.Error(suite.T(), err)
 		require.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
@@ -124,7 +133,7 @@ func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthNoNode() {
 		// existing but with no associated HealthStatus resources.
 		ref := resourceID(pbcatalog.NodeType, "foo", tenancy)
 		ref.Uid = ulid.Make().String()
-		health, err := getNodeHealth(context.Background(), suite.runtime, ref)
+		health, err := suite.ctl.getNodeHealth(context.Background(), suite.runtime, ref)
 
 		require.NoError(suite.T(), err)
 		require.Equal(suite.T(), pbcatalog.Health_HEALTH_PASSING, health)
@@ -134,7 +143,7 @@ func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthNoNode() {
 func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthNoStatus() {
 	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
 
-		health, err := getNodeHealth(context.Background(), suite.runtime, suite.nodeNoHealth)
+		health, err := suite.ctl.getNodeHealth(context.Background(), suite.runtime, suite.nodeNoHealth)
 		require.NoError(suite.T(), err)
 		require.Equal(suite.T(), pbcatalog.Health_HEALTH_PASSING, health)
 	})
@@ -143,7 +152,7 @@ func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthNoStatus() {
 func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthPassingStatus() {
 	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
 
-		health, err := getNodeHealth(context.Background(), suite.runtime, suite.nodePassing)
+		health, err := suite.ctl.getNodeHealth(context.Background(), suite.runtime, suite.nodePassing)
 		require.NoError(suite.T(), err)
 		require.Equal(suite.T(), pbcatalog.Health_HEALTH_PASSING, health)
 	})
@@ -152,7 +161,7 @@ func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthPassingStatus() {
 func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthCriticalStatus() {
 	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
 
-		health, err := getNodeHealth(context.Background(), suite.runtime, suite.nodeCritical)
+		health, err := suite.ctl.getNodeHealth(context.Background(), suite.runtime, suite.nodeCritical)
 		require.NoError(suite.T(), err)
 		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, health)
 	})
@@ -161,7 +170,7 @@ func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthCriticalStatus() {
 func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthWarningStatus() {
 	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
 
-		health, err := getNodeHealth(context.Background(), suite.runtime, suite.nodeWarning)
+		health, err := suite.ctl.getNodeHealth(context.Background(), suite.runtime, suite.nodeWarning)
 		require.NoError(suite.T(), err)
 		require.Equal(suite.T(), pbcatalog.Health_HEALTH_WARNING, health)
 	})
@@ -170,12 +179,251 @@ func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthWarningStatus() {
 func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthMaintenanceStatus() {
 	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
 
-		health, err := getNodeHealth(context.Background(), suite.runtime, suite.nodeMaintenance)
+		health, err := suite.ctl.getNodeHealth(context.Background(), suite.runtime, suite.nodeMaintenance)
 		require.NoError(suite.T(), err)
 		require.Equal(suite.T(), pbcatalog.Health_HEALTH_MAINTENANCE, health)
 	})
 }
 
+func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthCheckBounds() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		ctl := newNodeHealthReconciler(
+			WithCheckCeiling("noisy", pbcatalog.Health_HEALTH_WARNING),
+			WithCheckFloor("security", pbcatalog.Health_HEALTH_CRITICAL),
+		)
+
+		writeCheck := func(node *pbresource.ID, name, checkType string, health pbcatalog.Health) {
+			resourcetest.Resource(pbcatalog.HealthStatusType, name).
+				WithData(suite.T(), &pbcatalog.HealthStatus{Type: checkType, Status: health}).
+				WithOwner(node).
+				WithTenancy(tenancy).
+				Write(suite.T(), suite.resourceClient)
+		}
+
+		// A check with a WARNING ceiling reporting CRITICAL only contributes WARNING.
+		noisyNode := suite.writeNode("test-node-noisy", tenancy)
+		writeCheck(noisyNode, "noisy-check", "noisy", pbcatalog.Health_HEALTH_CRITICAL)
+
+		health, err := ctl.getNodeHealth(context.Background(), suite.runtime, noisyNode)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_WARNING, health)
+
+		// Other check types are unaffected.
+		writeCheck(noisyNode, "other-check", "other", pbcatalog.Health_HEALTH_CRITICAL)
+		health, err = ctl.getNodeHealth(context.Background(), suite.runtime, noisyNode)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, health)
+
+		// A check with a CRITICAL floor reporting PASSING contributes CRITICAL.
+		securityNode := suite.writeNode("test-node-security", tenancy)
+		writeCheck(securityNode, "security-check", "security", pbcatalog.Health_HEALTH_PASSING)
+
+		health, err = ctl.getNodeHealth(context.Background(), suite.runtime, securityNode)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, health)
+
+		// Without any bounds configured the checks are taken at face value.
+		health, err = suite.ctl.getNodeHealth(context.Background(), suite.runtime, securityNode)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_PASSING, health)
+	})
+}
+
+// listByOwnerCountClient counts the ListByOwner calls made through it.
+type listByOwnerCountClient struct {
+	pbresource.ResourceServiceClient
+	calls int
+}
+
+func (c *listByOwnerCountClient) ListByOwner(ctx context.Context, in *pbresource.ListByOwnerRequest, opts ...grpc.CallOption) (*pbresource.ListByOwnerResponse, error) {
+	c.calls++
+	return c.ResourceServiceClient.ListByOwner(ctx, in, opts...)
+}
+
+func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthPaged() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		node := suite.writeNode("test-node-paged", tenancy)
+
+		// The critical check sorts last, so it's only seen on the final page.
+		count := 2*childrenPageSize + 1
+		for i := 0; i < count; i++ {
+			health := pbcatalog.Health_HEALTH_PASSING
+			if i == count-1 {
+				health = pbcatalog.Health_HEALTH_CRITICAL
+			}
+			resourcetest.Resource(pbcatalog.HealthStatusType, fmt.Sprintf("check-%03d", i)).
+				WithData(suite.T(), &pbcatalog.HealthStatus{Type: "tcp", Status: health}).
+				WithOwner(node).
+				WithTenancy(tenancy).
+				Write(suite.T(), suite.resourceClient)
+		}
+
+		client := &listByOwnerCountClient{ResourceServiceClient: suite.resourceClient}
+		rt := controller.Runtime{Client: client, Logger: suite.runtime.Logger}
+
+		checks, err := suite.ctl.listNodeChecks(context.Background(), rt, node)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), count, checks.count)
+		require.Equal(suite.T(), 3, client.calls)
+
+		// The checks are folded in as they're listed, so none are held.
+		require.Empty(suite.T(), checks.statuses)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, checks.health())
+
+		health, err := suite.ctl.getNodeHealth(context.Background(), rt, node)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, health)
+
+		// A custom strategy is given all of the node's checks at once.
+		var aggregated [][]*pbcatalog.HealthStatus
+		ctl := newNodeHealthReconciler()
+		ctl.strategy = AggregationStrategyFunc(func(statuses []*pbcatalog.HealthStatus) pbcatalog.Health {
+			aggregated = append(aggregated, statuses)
+			return AggregateHealth(statuses)
+		})
+		health, err = ctl.getNodeHealth(context.Background(), rt, node)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, health)
+		require.Len(suite.T(), aggregated, 1)
+		require.Len(suite.T(), aggregated[0], count)
+		require.Equal(suite.T(), "tcp", aggregated[0][0].Type)
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthReporterFilter() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		node := suite.writeNode("test-node-reporters", tenancy)
+
+		writeCheck := func(name, reporter string, health pbcatalog.Health) {
+			resourcetest.Resource(pbcatalog.HealthStatusType, name).
+				WithData(suite.T(), &pbcatalog.HealthStatus{Type: "tcp", Status: health}).
+				WithMeta("reporter", reporter).
+				WithOwner(node).
+				WithTenancy(tenancy).
+				Write(suite.T(), suite.resourceClient)
+		}
+		writeCheck("agent-a-check", "agent-a", pbcatalog.Health_HEALTH_PASSING)
+		writeCheck("agent-b-check", "agent-b", pbcatalog.Health_HEALTH_CRITICAL)
+
+		// All reporters are considered by default.
+		health, err := suite.ctl.getNodeHealth(context.Background(), suite.runtime, node)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, health)
+
+		for reporter, expected := range map[string]pbcatalog.Health{
+			"agent-a": pbcatalog.Health_HEALTH_PASSING,
+			"agent-b": pbcatalog.Health_HEALTH_CRITICAL,
+			// A reporter without any checks leaves the node passing.
+			"agent-c": pbcatalog.Health_HEALTH_PASSING,
+		} {
+			ctl := newNodeHealthReconciler(WithReporterFilter("reporter", reporter))
+			health, err := ctl.getNodeHealth(context.Background(), suite.runtime, node)
+			require.NoError(suite.T(), err)
+			require.Equal(suite.T(), expected, health, reporter)
+		}
+	})
+}
+
+var registerMinSeverity sync.Once
+
+func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthCustomStrategy() {
+	// Treat a node as only as unhealthy as its least severe check.
+	registerMinSeverity.Do(func() {
+		RegisterAggregationStrategy("test-min-severity", AggregationStrategyFunc(func(statuses []*pbcatalog.HealthStatus) pbcatalog.Health {
+			health := pbcatalog.Health_HEALTH_MAINTENANCE
+			for _, hs := range statuses {
+				if hs.Status < health {
+					health = hs.Status
+				}
+			}
+			return health
+		}))
+	})
+	require.Panics(suite.T(), func() {
+		RegisterAggregationStrategy(MaxSeverityStrategy, AggregationStrategyFunc(AggregateHealth))
+	})
+	require.Panics(suite.T(), func() {
+		NodeHealthController(WithAggregationStrategy("not-registered"))
+	})
+
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		health, err := newNodeHealthReconciler(WithAggregationStrategy(MaxSeverityStrategy)).
+			getNodeHealth(context.Background(), suite.runtime, suite.nodeCritical)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, health)
+
+		health, err = newNodeHealthReconciler(WithAggregationStrategy("test-min-severity")).
+			getNodeHealth(context.Background(), suite.runtime, suite.nodeCritical)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_PASSING, health)
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthCustomPrecedence() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		// Each node has checks of its own health and every less severe one,
+		// ordered as in precedenceHealth.
+		cases := map[string]struct {
+			precedence map[pbcatalog.Health]int
+			expected   map[*pbresource.ID]pbcatalog.Health
+		}{
+			"default": {
+				expected: map[*pbresource.ID]pbcatalog.Health{
+					suite.nodeNoHealth:    pbcatalog.Health_HEALTH_PASSING,
+					suite.nodePassing:     pbcatalog.Health_HEALTH_PASSING,
+					suite.nodeWarning:     pbcatalog.Health_HEALTH_WARNING,
+					suite.nodeCritical:    pbcatalog.Health_HEALTH_CRITICAL,
+					suite.nodeMaintenance: pbcatalog.Health_HEALTH_MAINTENANCE,
+				},
+			},
+			"warning demoted to passing": {
+				precedence: map[pbcatalog.Health]int{
+					pbcatalog.Health_HEALTH_WARNING: int(pbcatalog.Health_HEALTH_PASSING),
+				},
+				expected: map[*pbresource.ID]pbcatalog.Health{
+					suite.nodeNoHealth:    pbcatalog.Health_HEALTH_PASSING,
+					suite.nodePassing:     pbcatalog.Health_HEALTH_PASSING,
+					suite.nodeWarning:     pbcatalog.Health_HEALTH_PASSING,
+					suite.nodeCritical:    pbcatalog.Health_HEALTH_CRITICAL,
+					suite.nodeMaintenance: pbcatalog.Health_HEALTH_MAINTENANCE,
+				},
+			},
+			"warning above critical": {
+				precedence: map[pbcatalog.Health]int{
+					pbcatalog.Health_HEALTH_PASSING:     0,
+					pbcatalog.Health_HEALTH_CRITICAL:    1,
+					pbcatalog.Health_HEALTH_WARNING:     2,
+					pbcatalog.Health_HEALTH_MAINTENANCE: 3,
+				},
+				expected: map[*pbresource.ID]pbcatalog.Health{
+					suite.nodeNoHealth:    pbcatalog.Health_HEALTH_PASSING,
+					suite.nodePassing:     pbcatalog.Health_HEALTH_PASSING,
+					suite.nodeWarning:     pbcatalog.Health_HEALTH_WARNING,
+					suite.nodeCritical:    pbcatalog.Health_HEALTH_WARNING,
+					suite.nodeMaintenance: pbcatalog.Health_HEALTH_MAINTENANCE,
+				},
+			},
+		}
+		for name, tc := range cases {
+			ctl := newNodeHealthReconciler(WithHealthPrecedence(tc.precedence))
+			for node, expected := range tc.expected {
+				health, err := ctl.getNodeHealth(context.Background(), suite.runtime, node)
+				require.NoError(suite.T(), err)
+				require.Equal(suite.T(), expected, health, "%s: %s", name, node.Name)
+			}
+		}
+
+		// The last aggregation option given wins.
+		ctl := newNodeHealthReconciler(
+			WithHealthPrecedence(map[pbcatalog.Health]int{pbcatalog.Health_HEALTH_WARNING: 0}),
+			WithAggregationStrategy(MaxSeverityStrategy),
+		)
+		health, err := ctl.getNodeHealth(context.Background(), suite.runtime, suite.nodeWarning)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_WARNING, health)
+	})
+}
+
 func (suite *nodeHealthControllerTestSuite) TestReconcileNodeNotFound() {
 	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
 		// This test ensures that removed nodes are ignored. In particular we don't
@@ -280,6 +528,792 @@ func (suite *nodeHealthControllerTestSuite) TestReconcile_StatusMaintenance() {
 	})
 }
 
+func (suite *nodeHealthControllerTestSuite) TestReconcile_ConditionStateOverride() {
+	suite.ctl = *newNodeHealthReconciler(WithConditionState(pbcatalog.Health_HEALTH_WARNING, pbresource.Condition_STATE_UNKNOWN))
+
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		suite.testReconcileStatus(suite.nodeWarning, &pbresource.Condition{
+			Type:    StatusConditionHealthy,
+			State:   pbresource.Condition_STATE_UNKNOWN,
+			Reason:  "HEALTH_WARNING",
+			Message: NodeUnhealthyMessage,
+		})
+
+		// Health levels without an override keep the default state.
+		suite.testReconcileStatus(suite.nodeCritical, &pbresource.Condition{
+			Type:    StatusConditionHealthy,
+			State:   pbresource.Condition_STATE_FALSE,
+			Reason:  "HEALTH_CRITICAL",
+			Message: NodeUnhealthyMessage,
+		})
+	})
+
+	// The shared conditions must not have been modified.
+	require.Equal(suite.T(), pbresource.Condition_STATE_FALSE, ConditionWarning.State)
+}
+
+func (suite *nodeHealthControllerTestSuite) TestDeleteRequiresPassing() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		for _, id := range []*pbresource.ID{suite.nodePassing, suite.nodeCritical} {
+			require.NoError(suite.T(), suite.ctl.Reconcile(context.Background(), suite.runtime, controller.Request{
+				ID: id,
+			}))
+		}
+
+		_, err := suite.resourceClient.Delete(context.Background(), &pbresource.DeleteRequest{
+			Id:           suite.nodeCritical,
+			Precondition: PassingDeletePrecondition(),
+		})
+		require.Error(suite.T(), err)
+		require.Equal(suite.T(), codes.FailedPrecondition, status.Code(err))
+		suite.resourceClient.RequireResourceExists(suite.T(), suite.nodeCritical)
+
+		_, err = suite.resourceClient.Delete(context.Background(), &pbresource.DeleteRequest{
+			Id:           suite.nodePassing,
+			Precondition: PassingDeletePrecondition(),
+		})
+		require.NoError(suite.T(), err)
+		suite.resourceClient.RequireResourceNotFound(suite.T(), suite.nodePassing)
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestReconcile_OptOutLabel() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		ctl := newNodeHealthReconciler(WithOptOutLabel("consul.io/health", "disabled"))
+		reconcile := func() {
+			suite.T().Helper()
+			require.NoError(suite.T(), ctl.Reconcile(context.Background(), suite.runtime, controller.Request{
+				ID: suite.nodeCritical,
+			}))
+		}
+		writeNode := func(labels map[string]string) {
+			b := resourcetest.Resource(pbcatalog.NodeType, suite.nodeCritical.Name).
+				WithData(suite.T(), nodeData).
+				WithTenancy(tenancy)
+			for k, v := range labels {
+				b = b.WithMeta(k, v)
+			}
+			b.Write(suite.T(), suite.resourceClient)
+		}
+
+		reconcile()
+		suite.requireReconciled(suite.T(), suite.nodeCritical, "HEALTH_CRITICAL")
+
+		// Labelling the node with a different value doesn't opt it out.
+		writeNode(map[string]string{"consul.io/health": "enabled"})
+		reconcile()
+		suite.requireReconciled(suite.T(), suite.nodeCritical, "HEALTH_CRITICAL")
+
+		// Opting out replaces the existing condition with the unmanaged one.
+		writeNode(map[string]string{"consul.io/health": "disabled"})
+		reconcile()
+		res := suite.resourceClient.RequireResourceExists(suite.T(), suite.nodeCritical)
+		prototest.AssertDeepEqual(suite.T(), []*pbresource.Condition{ConditionUnmanaged}, res.Status[StatusKey].GetConditions())
+		_, err := ReportedHealth(res)
+		require.ErrorIs(suite.T(), err, ErrNodeUnmanaged)
+
+		// Subsequent reconciles don't write the status again.
+		reconcile()
+		suite.resourceClient.RequireVersionUnchanged(suite.T(), res.Id, res.Version)
+
+		// Removing the label resumes management.
+		writeNode(nil)
+		reconcile()
+		suite.requireReconciled(suite.T(), suite.nodeCritical, "HEALTH_CRITICAL")
+	})
+}
+
+// listByOwnerErrClient fails ListByOwner calls with err while it is set.
+type listByOwnerErrClient struct {
+	pbresource.ResourceServiceClient
+	err error
+}
+
+func (c *listByOwnerErrClient) ListByOwner(ctx context.Context, in *pbresource.ListByOwnerRequest, opts ...grpc.CallOption) (*pbresource.ListByOwnerResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.ResourceServiceClient.ListByOwner(ctx, in, opts...)
+}
+
+func (suite *nodeHealthControllerTestSuite) TestReconcile_LastErrorRecording() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		ctl := newNodeHealthReconciler(WithLastErrorRecording())
+		client := &listByOwnerErrClient{ResourceServiceClient: suite.resourceClient}
+		rt := controller.Runtime{Client: client, Logger: suite.runtime.Logger}
+
+		require.NoError(suite.T(), ctl.Reconcile(context.Background(), rt, controller.Request{
+			ID: suite.nodeCritical,
+		}))
+		suite.requireReconciled(suite.T(), suite.nodeCritical, "HEALTH_CRITICAL")
+
+		// A failed reconcile records the error but leaves the condition alone.
+		client.err = status.Error(codes.Unavailable, "list failed")
+		err := ctl.Reconcile(context.Background(), rt, controller.Request{
+			ID: suite.nodeCritical,
+		})
+		require.Error(suite.T(), err)
+
+		res := suite.resourceClient.RequireResourceExists(suite.T(), suite.nodeCritical)
+		lastErr := res.Status[StatusKey].GetLastReconcileError()
+		require.NotNil(suite.T(), lastErr)
+		require.Equal(suite.T(), err.Error(), lastErr.Message)
+		require.NotNil(suite.T(), lastErr.OccurredAt)
+		suite.requireReconciled(suite.T(), suite.nodeCritical, "HEALTH_CRITICAL")
+
+		// The next successful reconcile clears the error.
+		client.err = nil
+		require.NoError(suite.T(), ctl.Reconcile(context.Background(), rt, controller.Request{
+			ID: suite.nodeCritical,
+		}))
+		res = suite.resourceClient.RequireResourceExists(suite.T(), suite.nodeCritical)
+		require.Nil(suite.T(), res.Status[StatusKey].GetLastReconcileError())
+		suite.requireReconciled(suite.T(), suite.nodeCritical, "HEALTH_CRITICAL")
+
+		// A node whose first reconcile fails has only the error recorded, and
+		// is reported as not yet reconciled rather than missing its condition.
+		node := suite.writeNode("test-node-first-failure", tenancy)
+		client.err = status.Error(codes.Unavailable, "list failed")
+		require.Error(suite.T(), ctl.Reconcile(context.Background(), rt, controller.Request{ID: node}))
+		res = suite.resourceClient.RequireResourceExists(suite.T(), node)
+		require.Empty(suite.T(), res.Status[StatusKey].GetConditions())
+		require.NotNil(suite.T(), res.Status[StatusKey].GetLastReconcileError())
+		_, err = ReportedHealth(res)
+		require.Equal(suite.T(), ErrNodeUnreconciled, err)
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestReconcile_ExpectedChecks() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		ctl := newNodeHealthReconciler(WithExpectedChecks(pbcatalog.Health_HEALTH_WARNING, "tcp", "http"))
+		node := suite.writeNode("test-node-expected-checks", tenancy)
+
+		writeCheck := func(checkType string) *pbresource.Resource {
+			return resourcetest.Resource(pbcatalog.HealthStatusType, checkType+"-check").
+				WithData(suite.T(), &pbcatalog.HealthStatus{Type: checkType, Status: pbcatalog.Health_HEALTH_PASSING}).
+				WithOwner(node).
+				WithTenancy(tenancy).
+				Write(suite.T(), suite.resourceClient)
+		}
+		writeCheck("tcp")
+		httpCheck := writeCheck("http")
+
+		reconcile := func() {
+			require.NoError(suite.T(), ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: node}))
+		}
+
+		reconcile()
+		suite.requireReconciled(suite.T(), node, "HEALTH_PASSING")
+
+		// Removing an expected check drives the node to the missing check severity.
+		_, err := suite.resourceClient.Delete(context.Background(), &pbresource.DeleteRequest{Id: httpCheck.Id})
+		require.NoError(suite.T(), err)
+		reconcile()
+		suite.requireReconciled(suite.T(), node, "HEALTH_WARNING")
+
+		// A check worse than the missing check severity still takes precedence.
+		resourcetest.Resource(pbcatalog.HealthStatusType, "tcp-check").
+			WithData(suite.T(), &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_CRITICAL}).
+			WithOwner(node).
+			WithTenancy(tenancy).
+			Write(suite.T(), suite.resourceClient)
+		reconcile()
+		suite.requireReconciled(suite.T(), node, "HEALTH_CRITICAL")
+
+		// Without an expected checks policy missing checks are not flagged.
+		health, err := suite.ctl.getNodeHealth(context.Background(), suite.runtime, suite.nodeNoHealth)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_PASSING, health)
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestReconcile_EventSink() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		var transitions []HealthTransition
+		ctl := newNodeHealthReconciler(WithEventSink(EventSinkFunc(func(transition HealthTransition) {
+			transitions = append(transitions, transition)
+		})))
+		node := suite.writeNode("test-node-event-sink", tenancy)
+
+		reconcile := func() {
+			err := ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: node})
+			require.NoError(suite.T(), err)
+		}
+
+		// The first health reported for a node is a transition from HEALTH_ANY.
+		reconcile()
+		suite.requireReconciled(suite.T(), node, "HEALTH_PASSING")
+		require.Len(suite.T(), transitions, 1)
+		prototest.AssertDeepEqual(suite.T(), node, transitions[0].Node)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_ANY, transitions[0].Previous)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_PASSING, transitions[0].Current)
+		require.False(suite.T(), transitions[0].Time.IsZero())
+
+		// Reconciling without a change of health publishes nothing.
+		reconcile()
+		require.Len(suite.T(), transitions, 1)
+
+		resourcetest.Resource(pbcatalog.HealthStatusType, "event-sink-check").
+			WithData(suite.T(), &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_CRITICAL}).
+			WithOwner(node).
+			WithTenancy(tenancy).
+			Write(suite.T(), suite.resourceClient)
+		reconcile()
+		suite.requireReconciled(suite.T(), node, "HEALTH_CRITICAL")
+		require.Len(suite.T(), transitions, 2)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_PASSING, transitions[1].Previous)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, transitions[1].Current)
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestReconcile_ConditionTTL() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		const ttl = 500 * time.Millisecond
+		ctl := newNodeHealthReconciler(WithConditionTTL(ttl))
+		node := suite.writeNode("test-node-condition-ttl", tenancy)
+
+		reconcile := func() {
+			err := ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: node})
+			require.Equal(suite.T(), controller.RequeueAfter(ttl/2), err)
+		}
+		stale := func() bool {
+			live, err := ReadLiveNodeHealth(context.Background(), suite.resourceClient, node)
+			require.NoError(suite.T(), err)
+			return live.StoredConditionStale
+		}
+
+		readStatus := func() *pbresource.Status {
+			rsp, err := suite.resourceClient.Read(context.Background(), &pbresource.ReadRequest{Id: node})
+			require.NoError(suite.T(), err)
+			return rsp.Resource.Status[StatusKey]
+		}
+
+		reconcile()
+		suite.requireReconciled(suite.T(), node, "HEALTH_PASSING")
+		written := readStatus()
+		require.Equal(suite.T(), ttl, written.Ttl.AsDuration())
+		require.False(suite.T(), stale())
+
+		// A condition that isn't refreshed within its TTL is reported stale.
+		time.Sleep(ttl + 100*time.Millisecond)
+		require.True(suite.T(), stale())
+
+		// Reconciling refreshes the condition even though the health is unchanged.
+		reconcile()
+		suite.requireReconciled(suite.T(), node, "HEALTH_PASSING")
+		require.True(suite.T(), readStatus().UpdatedAt.AsTime().After(written.UpdatedAt.AsTime()))
+		require.False(suite.T(), stale())
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestReconcile_FailingChecksMessage() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		ctl := newNodeHealthReconciler(WithFailingChecksMessage(2))
+		node := suite.writeNode("test-node-failing-checks", tenancy)
+
+		writeCheck := func(name string, health pbcatalog.Health) {
+			resourcetest.Resource(pbcatalog.HealthStatusType, name).
+				WithData(suite.T(), &pbcatalog.HealthStatus{Type: "tcp", Status: health}).
+				WithOwner(node).
+				WithTenancy(tenancy).
+				Write(suite.T(), suite.resourceClient)
+		}
+		reconcile := func() *pbresource.Resource {
+			require.NoError(suite.T(), ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: node}))
+			return suite.resourceClient.RequireResourceExists(suite.T(), node)
+		}
+
+		writeCheck("ping", pbcatalog.Health_HEALTH_PASSING)
+		writeCheck("memory", pbcatalog.Health_HEALTH_WARNING)
+
+		res := reconcile()
+		require.Equal(suite.T(), "HEALTH_WARNING", res.Status[StatusKey].Conditions[0].Reason)
+		require.Equal(suite.T(),
+			NodeUnhealthyMessage+": memory (HEALTH_WARNING)",
+			res.Status[StatusKey].Conditions[0].Message)
+
+		// Critical checks are listed first, bounded by the limit.
+		writeCheck("disk", pbcatalog.Health_HEALTH_CRITICAL)
+		writeCheck("cpu", pbcatalog.Health_HEALTH_CRITICAL)
+
+		res = reconcile()
+		require.Equal(suite.T(), "HEALTH_CRITICAL", res.Status[StatusKey].Conditions[0].Reason)
+		require.Equal(suite.T(),
+			NodeUnhealthyMessage+": cpu (HEALTH_CRITICAL), disk (HEALTH_CRITICAL) and 1 more",
+			res.Status[StatusKey].Conditions[0].Message)
+
+		// The status isn't rewritten while the failing checks are unchanged.
+		require.Equal(suite.T(), res.Version, reconcile().Version)
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestReconcile_UnknownFieldPreservation() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		// A field added to Condition by a newer version of the schema, which
+		// this controller doesn't know about.
+		extra := protowire.AppendTag(nil, 1000, protowire.BytesType)
+		extra = protowire.AppendString(extra, "extra")
+
+		for desc, tc := range map[string]struct {
+			opts     []Option
+			preserve bool
+		}{
+			"preserved": {opts: []Option{WithUnknownFieldPreservation()}, preserve: true},
+			"dropped":   {},
+		} {
+			suite.T().Run(desc, func(t *testing.T) {
+				node := resourcetest.Resource(pbcatalog.NodeType, "test-node-unknown-fields-"+desc).
+					WithData(t, nodeData).
+					WithTenancy(tenancy).
+					Write(t, suite.resourceClient)
+
+				cond := proto.Clone(ConditionPassing).(*pbresource.Condition)
+				cond.ProtoReflect().SetUnknown(extra)
+				_, err := suite.resourceClient.WriteStatus(context.Background(), &pbresource.WriteStatusRequest{
+					Id:  node.Id,
+					Key: StatusKey,
+					Status: &pbresource.Status{
+						ObservedGeneration: node.Generation,
+						Conditions:         []*pbresource.Condition{cond},
+					},
+				})
+				require.NoError(t, err)
+
+				// Make the node critical so that its status is rewritten.
+				resourcetest.Resource(pbcatalog.HealthStatusType, "unknown-fields-"+desc).
+					WithData(t, &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_CRITICAL}).
+					WithOwner(node.Id).
+					WithTenancy(tenancy).
+					Write(t, suite.resourceClient)
+
+				ctl := newNodeHealthReconciler(tc.opts...)
+				require.NoError(t, ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: node.Id}))
+				suite.requireReconciled(t, node.Id, "HEALTH_CRITICAL")
+
+				rsp, err := suite.resourceClient.Read(context.Background(), &pbresource.ReadRequest{Id: node.Id})
+				require.NoError(t, err)
+				unknown := rsp.Resource.Status[StatusKey].Conditions[0].ProtoReflect().GetUnknown()
+				if tc.preserve {
+					require.Equal(t, extra, []byte(unknown))
+				} else {
+					require.Empty(t, unknown)
+				}
+
+				// The shared condition values are left untouched.
+				require.Empty(t, ConditionCritical.ProtoReflect().GetUnknown())
+			})
+		}
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestReconcile_Locale() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		const frenchUnhealthy = "Un ou plusieurs contrôles de santé du nœud échouent"
+		catalog := MessageCatalog{
+			"fr": {NodeUnhealthyMessage: frenchUnhealthy},
+		}
+
+		readCondition := func(t *testing.T) *pbresource.Condition {
+			rsp, err := suite.resourceClient.Read(context.Background(), &pbresource.ReadRequest{Id: suite.nodeWarning})
+			require.NoError(t, err)
+			return rsp.Resource.Status[StatusKey].Conditions[0]
+		}
+
+		for desc, tc := range map[string]struct {
+			opts    []Option
+			message string
+		}{
+			"default":      {message: NodeUnhealthyMessage},
+			"english":      {opts: []Option{WithLocale(catalog, DefaultLocale)}, message: NodeUnhealthyMessage},
+			"french":       {opts: []Option{WithLocale(catalog, "fr")}, message: frenchUnhealthy},
+			"untranslated": {opts: []Option{WithLocale(catalog, "de")}, message: NodeUnhealthyMessage},
+		} {
+			suite.T().Run(desc, func(t *testing.T) {
+				ctl := newNodeHealthReconciler(tc.opts...)
+				require.NoError(t, ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: suite.nodeWarning}))
+
+				cond := readCondition(t)
+				require.Equal(t, "HEALTH_WARNING", cond.Reason)
+				require.Equal(t, tc.message, cond.Message)
+			})
+		}
+
+		// The shared condition values are left untouched.
+		require.Equal(suite.T(), NodeUnhealthyMessage, ConditionWarning.Message)
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestReconcile_Sampling() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		ctl := newNodeHealthReconciler(WithSampling(10, 5))
+
+		// writeChecks writes count passing checks, of which the one at index
+		// critical is critical instead.
+		writeChecks := func(node *pbresource.ID, count, critical int) {
+			for i := 0; i < count; i++ {
+				health := pbcatalog.Health_HEALTH_PASSING
+				if i == critical {
+					health = pbcatalog.Health_HEALTH_CRITICAL
+				}
+				resourcetest.Resource(pbcatalog.HealthStatusType, fmt.Sprintf("%s-check-%02d", node.Name, i)).
+					WithData(suite.T(), &pbcatalog.HealthStatus{Type: "tcp", Status: health}).
+					WithOwner(node).
+					WithTenancy(tenancy).
+					Write(suite.T(), suite.resourceClient)
+			}
+		}
+
+		readCondition := func(t *testing.T, id *pbresource.ID) *pbresource.Condition {
+			rsp, err := suite.resourceClient.Read(context.Background(), &pbresource.ReadRequest{Id: id})
+			require.NoError(t, err)
+			return rsp.Resource.Status[StatusKey].Conditions[0]
+		}
+
+		// The sample is the first 5 checks by name, which includes the critical
+		// one. The reason is the usual one, with sampling noted in the message.
+		sampledCritical := suite.writeNode("test-node-sampled-critical", tenancy)
+		writeChecks(sampledCritical, 20, 2)
+		for i := 0; i < 2; i++ {
+			require.NoError(suite.T(), ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: sampledCritical}))
+			suite.requireReconciled(suite.T(), sampledCritical, "HEALTH_CRITICAL")
+		}
+		require.Equal(suite.T(), NodeUnhealthyMessage+"; "+NodeSampledMessage, readCondition(suite.T(), sampledCritical).Message)
+
+		// A critical check outside the sample isn't seen.
+		sampledPassing := suite.writeNode("test-node-sampled-passing", tenancy)
+		writeChecks(sampledPassing, 20, 19)
+		require.NoError(suite.T(), ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: sampledPassing}))
+		suite.requireReconciled(suite.T(), sampledPassing, "HEALTH_PASSING")
+		require.Equal(suite.T(), NodeHealthyMessage+"; "+NodeSampledMessage, readCondition(suite.T(), sampledPassing).Message)
+
+		// Nodes at or below the threshold are aggregated exactly.
+		small := suite.writeNode("test-node-not-sampled", tenancy)
+		writeChecks(small, 10, 9)
+		require.NoError(suite.T(), ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: small}))
+		suite.requireReconciled(suite.T(), small, "HEALTH_CRITICAL")
+		require.Equal(suite.T(), NodeUnhealthyMessage, readCondition(suite.T(), small).Message)
+	})
+}
+
+func TestListNodeChildren_Sampling(t *testing.T) {
+	client := &listByOwnerCounter{ResourceServiceClient: svctest.RunResourceService(t, types.Register)}
+	rt := controller.Runtime{Client: client, Logger: testutil.Logger(t)}
+
+	node := resourcetest.Resource(pbcatalog.NodeType, "node").
+		WithData(t, nodeData).
+		Write(t, client)
+	for i := 0; i < 3*childrenPageSize; i++ {
+		resourcetest.Resource(pbcatalog.HealthStatusType, fmt.Sprintf("check-%03d", i)).
+			WithData(t, &pbcatalog.HealthStatus{Type: fmt.Sprintf("tcp-%03d", i), Status: pbcatalog.Health_HEALTH_PASSING}).
+			WithOwner(node.Id).
+			Write(t, client)
+	}
+
+	// Once the node is known to exceed the threshold, the rest of its
+	// children aren't listed.
+	ctl := newNodeHealthReconciler(WithSampling(10, 5))
+	checks, err := ctl.listNodeChecks(context.Background(), rt, node.Id)
+	require.NoError(t, err)
+	require.Equal(t, childrenPageSize, checks.count)
+	require.True(t, checks.sampled())
+	require.Equal(t, int64(1), client.calls.Load())
+
+	require.Len(t, checks.sample, 5)
+	for i, hs := range checks.sample {
+		require.Equal(t, fmt.Sprintf("tcp-%03d", i), hs.Type)
+	}
+}
+
+// listByOwnerCounter counts the ListByOwner calls made to the resource service.
+type listByOwnerCounter struct {
+	pbresource.ResourceServiceClient
+	calls atomic.Int64
+}
+
+func (c *listByOwnerCounter) ListByOwner(ctx context.Context, in *pbresource.ListByOwnerRequest, opts ...grpc.CallOption) (*pbresource.ListByOwnerResponse, error) {
+	c.calls.Add(1)
+	return c.ResourceServiceClient.ListByOwner(ctx, in, opts...)
+}
+
+func (suite *nodeHealthControllerTestSuite) TestGetNodeHealthScore() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		ctl := newNodeHealthReconciler(WithHealthScore(map[pbcatalog.Health]float64{
+			pbcatalog.Health_HEALTH_CRITICAL: 3,
+		}))
+
+		writeNode := func(name string, checks ...pbcatalog.Health) *pbresource.ID {
+			node := suite.writeNode(name, tenancy)
+			for i, health := range checks {
+				resourcetest.Resource(pbcatalog.HealthStatusType, fmt.Sprintf("%s-check-%d", name, i)).
+					WithData(suite.T(), &pbcatalog.HealthStatus{Type: "tcp", Status: health}).
+					WithOwner(node).
+					WithTenancy(tenancy).
+					Write(suite.T(), suite.resourceClient)
+			}
+			return node
+		}
+		score := func(node *pbresource.ID) float64 {
+			_, score, err := ctl.getNodeHealthAndScore(context.Background(), suite.runtime, node)
+			require.NoError(suite.T(), err)
+			require.NotNil(suite.T(), score)
+			return *score
+		}
+
+		passing := score(writeNode("score-passing", pbcatalog.Health_HEALTH_PASSING, pbcatalog.Health_HEALTH_PASSING))
+		critical := score(writeNode("score-critical", pbcatalog.Health_HEALTH_CRITICAL, pbcatalog.Health_HEALTH_CRITICAL))
+		mixed := score(writeNode("score-mixed", pbcatalog.Health_HEALTH_PASSING, pbcatalog.Health_HEALTH_CRITICAL))
+
+		require.Equal(suite.T(), float64(MaxHealthScore), passing)
+		require.Equal(suite.T(), float64(0), critical)
+		require.Greater(suite.T(), mixed, critical)
+		require.Less(suite.T(), mixed, passing)
+		// The critical check's weight of 3 pulls the score towards critical.
+		require.Equal(suite.T(), float64(25), mixed)
+
+		// Without a health score policy no score is computed.
+		_, noScore, err := suite.ctl.getNodeHealthAndScore(context.Background(), suite.runtime, suite.nodePassing)
+		require.NoError(suite.T(), err)
+		require.Nil(suite.T(), noScore)
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestReconcile_StickyHealth() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		const ackKey = "consul.io/health-acknowledged"
+		ctl := newNodeHealthReconciler(WithStickyHealth(pbcatalog.Health_HEALTH_CRITICAL, ackKey))
+		node := suite.writeNode("test-node-sticky", tenancy)
+
+		writeCheck := func(health pbcatalog.Health) {
+			resourcetest.Resource(pbcatalog.HealthStatusType, "sticky-check").
+				WithData(suite.T(), &pbcatalog.HealthStatus{Type: "tcp", Status: health}).
+				WithOwner(node).
+				WithTenancy(tenancy).
+				Write(suite.T(), suite.resourceClient)
+		}
+		reconcile := func() {
+			require.NoError(suite.T(), ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: node}))
+		}
+
+		// Health below the sticky severity isn't sticky.
+		writeCheck(pbcatalog.Health_HEALTH_WARNING)
+		reconcile()
+		suite.requireReconciled(suite.T(), node, "HEALTH_WARNING")
+		writeCheck(pbcatalog.Health_HEALTH_PASSING)
+		reconcile()
+		suite.requireReconciled(suite.T(), node, "HEALTH_PASSING")
+
+		// Once critical, a recovered check doesn't clear the node's health.
+		writeCheck(pbcatalog.Health_HEALTH_CRITICAL)
+		reconcile()
+		suite.requireReconciled(suite.T(), node, "HEALTH_CRITICAL")
+		writeCheck(pbcatalog.Health_HEALTH_PASSING)
+		reconcile()
+		suite.requireReconciled(suite.T(), node, "HEALTH_CRITICAL")
+
+		// Acknowledging the node recomputes its health normally.
+		resourcetest.Resource(pbcatalog.NodeType, node.Name).
+			WithData(suite.T(), nodeData).
+			WithMeta(ackKey, "true").
+			WithTenancy(tenancy).
+			Write(suite.T(), suite.resourceClient)
+		reconcile()
+		suite.requireReconciled(suite.T(), node, "HEALTH_PASSING")
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestReconcile_SeverityPrecedence() {
+	// Ranking WARNING above CRITICAL makes it the most severe health, both when
+	// aggregating checks and when comparing against configured severities.
+	precedence := WithHealthPrecedence(map[pbcatalog.Health]int{pbcatalog.Health_HEALTH_WARNING: 5})
+
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		writeCheck := func(node *pbresource.ID, checkType string, health pbcatalog.Health) {
+			resourcetest.Resource(pbcatalog.HealthStatusType, node.Name+"-"+checkType).
+				WithData(suite.T(), &pbcatalog.HealthStatus{Type: checkType, Status: health}).
+				WithOwner(node).
+				WithTenancy(tenancy).
+				Write(suite.T(), suite.resourceClient)
+		}
+		reconcile := func(ctl *nodeHealthReconciler, node *pbresource.ID) {
+			require.NoError(suite.T(), ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: node}))
+		}
+
+		// A warning check outranks the missing http check's severity.
+		expected := newNodeHealthReconciler(precedence, WithExpectedChecks(pbcatalog.Health_HEALTH_CRITICAL, "tcp", "http"))
+		node := suite.writeNode("test-node-precedence-expected", tenancy)
+		writeCheck(node, "tcp", pbcatalog.Health_HEALTH_WARNING)
+		reconcile(expected, node)
+		suite.requireReconciled(suite.T(), node, "HEALTH_WARNING")
+
+		// A warning outranks the sticky severity, so it is kept once reported.
+		sticky := newNodeHealthReconciler(precedence, WithStickyHealth(pbcatalog.Health_HEALTH_CRITICAL, "consul.io/health-acknowledged"))
+		node = suite.writeNode("test-node-precedence-sticky", tenancy)
+		writeCheck(node, "tcp", pbcatalog.Health_HEALTH_WARNING)
+		reconcile(sticky, node)
+		suite.requireReconciled(suite.T(), node, "HEALTH_WARNING")
+		writeCheck(node, "tcp", pbcatalog.Health_HEALTH_PASSING)
+		reconcile(sticky, node)
+		suite.requireReconciled(suite.T(), node, "HEALTH_WARNING")
+
+		// Nor does a critical check replace it, as it is outranked by the warning.
+		writeCheck(node, "tcp", pbcatalog.Health_HEALTH_CRITICAL)
+		reconcile(sticky, node)
+		suite.requireReconciled(suite.T(), node, "HEALTH_WARNING")
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestReconcile_UnhealthyGracePeriod() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		const grace = time.Minute
+		ctl := newNodeHealthReconciler(WithUnhealthyGracePeriod(grace))
+		now := time.Now()
+		ctl.grace.now = func() time.Time { return now }
+		node := suite.writeNode("test-node-grace", tenancy)
+
+		writeCheck := func(health pbcatalog.Health) {
+			resourcetest.Resource(pbcatalog.HealthStatusType, "grace-check").
+				WithData(suite.T(), &pbcatalog.HealthStatus{Type: "tcp", Status: health}).
+				WithOwner(node).
+				WithTenancy(tenancy).
+				Write(suite.T(), suite.resourceClient)
+		}
+		reconcile := func() error {
+			return ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: node})
+		}
+
+		writeCheck(pbcatalog.Health_HEALTH_PASSING)
+		require.NoError(suite.T(), reconcile())
+		suite.requireReconciled(suite.T(), node, "HEALTH_PASSING")
+
+		// The node stays PASSING, and is re-checked, until the grace period elapses.
+		writeCheck(pbcatalog.Health_HEALTH_CRITICAL)
+		require.Equal(suite.T(), controller.RequeueAfter(grace), reconcile())
+		suite.requireReconciled(suite.T(), node, "HEALTH_PASSING")
+
+		now = now.Add(40 * time.Second)
+		require.Equal(suite.T(), controller.RequeueAfter(20*time.Second), reconcile())
+		suite.requireReconciled(suite.T(), node, "HEALTH_PASSING")
+
+		now = now.Add(20 * time.Second)
+		require.NoError(suite.T(), reconcile())
+		suite.requireReconciled(suite.T(), node, "HEALTH_CRITICAL")
+
+		// Recovery is reported immediately.
+		writeCheck(pbcatalog.Health_HEALTH_PASSING)
+		require.NoError(suite.T(), reconcile())
+		suite.requireReconciled(suite.T(), node, "HEALTH_PASSING")
+
+		// A blip that recovers within the grace period is never reported, and
+		// the next one gets a full grace period.
+		writeCheck(pbcatalog.Health_HEALTH_WARNING)
+		require.Equal(suite.T(), controller.RequeueAfter(grace), reconcile())
+		now = now.Add(30 * time.Second)
+		writeCheck(pbcatalog.Health_HEALTH_PASSING)
+		require.NoError(suite.T(), reconcile())
+		suite.requireReconciled(suite.T(), node, "HEALTH_PASSING")
+
+		writeCheck(pbcatalog.Health_HEALTH_CRITICAL)
+		require.Equal(suite.T(), controller.RequeueAfter(grace), reconcile())
+		suite.requireReconciled(suite.T(), node, "HEALTH_PASSING")
+
+		// Entering maintenance is reported immediately.
+		writeCheck(pbcatalog.Health_HEALTH_MAINTENANCE)
+		require.NoError(suite.T(), reconcile())
+		suite.requireReconciled(suite.T(), node, "HEALTH_MAINTENANCE")
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestReconcile_MaintenanceConflictPolicy() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		node := suite.writeNode("test-node-maintenance-conflict", tenancy)
+		checks := make(map[string]*pbresource.ID)
+		for name, health := range map[string]pbcatalog.Health{
+			"conflict-maintenance": pbcatalog.Health_HEALTH_MAINTENANCE,
+			"conflict-critical":    pbcatalog.Health_HEALTH_CRITICAL,
+		} {
+			checks[name] = resourcetest.Resource(pbcatalog.HealthStatusType, name).
+				WithData(suite.T(), &pbcatalog.HealthStatus{Type: "tcp", Status: health}).
+				WithOwner(node).
+				WithTenancy(tenancy).
+				Write(suite.T(), suite.resourceClient).Id
+		}
+
+		cases := map[string]struct {
+			opts   []Option
+			reason string
+		}{
+			"default": {
+				reason: "HEALTH_MAINTENANCE",
+			},
+			"prefer maintenance": {
+				opts:   []Option{WithMaintenanceConflictPolicy(MaintenanceConflictPreferMaintenance)},
+				reason: "HEALTH_MAINTENANCE",
+			},
+			"prefer critical": {
+				opts:   []Option{WithMaintenanceConflictPolicy(MaintenanceConflictPreferCritical)},
+				reason: "HEALTH_CRITICAL",
+			},
+			"distinct reason": {
+				opts:   []Option{WithMaintenanceConflictPolicy(MaintenanceConflictDistinctReason)},
+				reason: ReasonCriticalInMaintenance,
+			},
+		}
+		for desc, tc := range cases {
+			suite.T().Run(desc, func(t *testing.T) {
+				ctl := newNodeHealthReconciler(tc.opts...)
+				require.NoError(t, ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: node}))
+				suite.requireReconciled(t, node, tc.reason)
+			})
+		}
+
+		// Without the critical check there is no conflict.
+		suite.resourceClient.MustDelete(suite.T(), checks["conflict-critical"])
+		ctl := newNodeHealthReconciler(WithMaintenanceConflictPolicy(MaintenanceConflictDistinctReason))
+		require.NoError(suite.T(), ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: node}))
+		suite.requireReconciled(suite.T(), node, "HEALTH_MAINTENANCE")
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestReconcile_MaintenanceMessage() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		node := suite.writeNode("test-node-maintenance-message", tenancy)
+		writeMaintenance := func(name, description string) *pbresource.ID {
+			return resourcetest.Resource(pbcatalog.HealthStatusType, name).
+				WithData(suite.T(), &pbcatalog.HealthStatus{
+					Type:        "maintenance",
+					Status:      pbcatalog.Health_HEALTH_MAINTENANCE,
+					Description: description,
+				}).
+				WithOwner(node).
+				WithTenancy(tenancy).
+				Write(suite.T(), suite.resourceClient).Id
+		}
+
+		ctl := newNodeHealthReconciler(WithMaintenanceMessage("In maintenance: {{.Description}}"))
+		requireMessage := func(message string) {
+			require.NoError(suite.T(), ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: node}))
+			res := suite.resourceClient.RequireResourceExists(suite.T(), node)
+			conditions := res.Status[StatusKey].GetConditions()
+			require.Len(suite.T(), conditions, 1)
+			require.Equal(suite.T(), "HEALTH_MAINTENANCE", conditions[0].Reason)
+			require.Equal(suite.T(), message, conditions[0].Message)
+		}
+
+		// The most recently written maintenance status is used.
+		kernel := writeMaintenance("kernel-upgrade", "kernel upgrade")
+		writeMaintenance("disk-replacement", "disk replacement")
+		requireMessage("In maintenance: disk replacement")
+
+		writeMaintenance("kernel-upgrade", "kernel upgrade, take 2")
+		requireMessage("In maintenance: kernel upgrade, take 2")
+
+		// Statuses with no description fall back to the default message.
+		suite.resourceClient.MustDelete(suite.T(), kernel)
+		writeMaintenance("disk-replacement", "")
+		requireMessage(NodeUnhealthyMessage)
+	})
+}
+
 func (suite *nodeHealthControllerTestSuite) TestReconcile_AvoidRereconciliationWrite() {
 	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
 
@@ -308,39 +1342,41 @@ func (suite *nodeHealthControllerTestSuite) waitForReconciliation(id *pbresource
 	suite.T().Helper()
 
 	retry.Run(suite.T(), func(r *retry.R) {
-		rsp, err := suite.resourceClient.Read(context.Background(), &pbresource.ReadRequest{
-			Id: id,
-		})
-		require.NoError(r, err)
+		suite.requireReconciled(r, id, reason)
+	})
+}
 
-		nodeHealthStatus, found := rsp.Resource.Status[StatusKey]
-		require.True(r, found)
-		require.Equal(r, rsp.Resource.Generation, nodeHealthStatus.ObservedGeneration)
-		require.Len(r, nodeHealthStatus.Conditions, 1)
-		require.Equal(r, reason, nodeHealthStatus.Conditions[0].Reason)
+func (suite *nodeHealthControllerTestSuite) requireReconciled(t require.TestingT, id *pbresource.ID, reason string) {
+	rsp, err := suite.resourceClient.Read(context.Background(), &pbresource.ReadRequest{
+		Id: id,
 	})
+	require.NoError(t, err)
+
+	nodeHealthStatus, found := rsp.Resource.Status[StatusKey]
+	require.True(t, found)
+	require.Equal(t, rsp.Resource.Generation, nodeHealthStatus.ObservedGeneration)
+	require.Len(t, nodeHealthStatus.Conditions, 1)
+	require.Equal(t, reason, nodeHealthStatus.Conditions[0].Reason)
 }
+
 func (suite *nodeHealthControllerTestSuite) TestController() {
 	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		ctx := testutil.TestContext(suite.T())
 
-		// create the controller manager
-		mgr := controller.NewManager(suite.resourceClient, testutil.Logger(suite.T()))
-
-		// register our controller
-		mgr.Register(NodeHealthController())
-		mgr.SetRaftLeader(true)
-		ctx, cancel := context.WithCancel(context.Background())
-		suite.T().Cleanup(cancel)
+		// drive the controller synchronously so that each reconcile can be
+		// asserted on as soon as it has happened
+		ctl := controller.NewTestController(NodeHealthController(), suite.resourceClient)
 
-		// run the manager
-		go mgr.Run(ctx)
+		node := suite.resourceClient.RequireResourceExists(suite.T(), suite.nodePassing)
+		require.NoError(suite.T(), ctl.Notify(ctx, node))
+		require.NoError(suite.T(), ctl.Drain(ctx))
 
-		// ensure that the node health eventually gets set.
-		suite.waitForReconciliation(suite.nodePassing, "HEALTH_PASSING")
+		// ensure that the node health gets set.
+		suite.requireReconciled(suite.T(), suite.nodePassing, "HEALTH_PASSING")
 
 		// rewrite the resource - this will cause the nodes health
 		// to be rereconciled but wont result in any health change
-		resourcetest.Resource(pbcatalog.NodeType, suite.nodePassing.Name).
+		node = resourcetest.Resource(pbcatalog.NodeType, suite.nodePassing.Name).
 			WithData(suite.T(), &pbcatalog.Node{
 				Addresses: []*pbcatalog.NodeAddress{
 					{
@@ -351,19 +1387,259 @@ func (suite *nodeHealthControllerTestSuite) TestController() {
 			WithTenancy(tenancy).
 			Write(suite.T(), suite.resourceClient)
 
-		// wait for rereconciliation to happen
+		require.NoError(suite.T(), ctl.Notify(ctx, node))
+		require.Len(suite.T(), ctl.Pending(), 1)
+		require.NoError(suite.T(), ctl.Drain(ctx))
+		suite.requireReconciled(suite.T(), suite.nodePassing, "HEALTH_PASSING")
+
+		hs := resourcetest.Resource(pbcatalog.HealthStatusType, "failure").
+			WithData(suite.T(), &pbcatalog.HealthStatus{Type: "fake", Status: pbcatalog.Health_HEALTH_CRITICAL}).
+			WithOwner(suite.nodePassing).
+			WithTenancy(tenancy).
+			Write(suite.T(), suite.resourceClient)
+
+		// the health status is mapped to a reconcile of its owning node
+		require.NoError(suite.T(), ctl.Notify(ctx, hs))
+		req, ok, err := ctl.Step(ctx)
+		require.NoError(suite.T(), err)
+		require.True(suite.T(), ok)
+		prototest.AssertDeepEqual(suite.T(), suite.nodePassing, req.ID)
+		require.Empty(suite.T(), ctl.Pending())
+
+		suite.requireReconciled(suite.T(), suite.nodePassing, "HEALTH_CRITICAL")
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestController_MovedCheck() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		ctx := testutil.TestContext(suite.T())
+		ctl := controller.NewTestController(NodeHealthController(), suite.resourceClient)
+
+		nodeA := suite.writeNode("test-node-a", tenancy)
+		nodeB := suite.writeNode("test-node-b", tenancy)
+		for _, id := range []*pbresource.ID{nodeA, nodeB} {
+			require.NoError(suite.T(), ctl.Notify(ctx, suite.resourceClient.RequireResourceExists(suite.T(), id)))
+		}
+		require.NoError(suite.T(), ctl.Drain(ctx))
+		suite.requireReconciled(suite.T(), nodeA, "HEALTH_PASSING")
+		suite.requireReconciled(suite.T(), nodeB, "HEALTH_PASSING")
+
+		hs := resourcetest.Resource(pbcatalog.HealthStatusType, "moving").
+			WithData(suite.T(), &pbcatalog.HealthStatus{Type: "fake", Status: pbcatalog.Health_HEALTH_CRITICAL}).
+			WithOwner(nodeA).
+			WithTenancy(tenancy).
+			Write(suite.T(), suite.resourceClient)
+		require.NoError(suite.T(), ctl.Notify(ctx, hs))
+		require.NoError(suite.T(), ctl.Drain(ctx))
+		suite.requireReconciled(suite.T(), nodeA, "HEALTH_CRITICAL")
+
+		// The owner of a HealthStatus can't be changed, as the write would only
+		// be mapped to the new owner and node A would stay critical.
+		moved := proto.Clone(hs).(*pbresource.Resource)
+		moved.Owner = nodeB
+		_, err := suite.resourceClient.Write(ctx, &pbresource.WriteRequest{Resource: moved})
+		require.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+
+		// Moving it by deleting it and writing it again is mapped to both
+		// nodes, so node A recovers as node B becomes critical.
+		_, err = suite.resourceClient.Delete(ctx, &pbresource.DeleteRequest{Id: hs.Id})
+		require.NoError(suite.T(), err)
+		require.NoError(suite.T(), ctl.Notify(ctx, hs))
+
+		moved = resourcetest.Resource(pbcatalog.HealthStatusType, "moving").
+			WithData(suite.T(), &pbcatalog.HealthStatus{Type: "fake", Status: pbcatalog.Health_HEALTH_CRITICAL}).
+			WithOwner(nodeB).
+			WithTenancy(tenancy).
+			Write(suite.T(), suite.resourceClient)
+		require.NoError(suite.T(), ctl.Notify(ctx, moved))
+		require.NoError(suite.T(), ctl.Drain(ctx))
+
+		suite.requireReconciled(suite.T(), nodeA, "HEALTH_PASSING")
+		suite.requireReconciled(suite.T(), nodeB, "HEALTH_CRITICAL")
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestController_PauseResume() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		mgr := controller.NewManager(suite.resourceClient, testutil.Logger(suite.T()))
+		mgr.Register(NodeHealthController())
+		mgr.SetRaftLeader(true)
+		ctx, cancel := context.WithCancel(context.Background())
+		suite.T().Cleanup(cancel)
+
+		go mgr.Run(ctx)
+
 		suite.waitForReconciliation(suite.nodePassing, "HEALTH_PASSING")
 
+		// Pause returns once the controller has stopped, so the check written
+		// next can't be reconciled until it is resumed.
+		require.NoError(suite.T(), mgr.Pause(ControllerName))
+		require.Error(suite.T(), mgr.Pause("not-a-controller"))
+
 		resourcetest.Resource(pbcatalog.HealthStatusType, "failure").
 			WithData(suite.T(), &pbcatalog.HealthStatus{Type: "fake", Status: pbcatalog.Health_HEALTH_CRITICAL}).
 			WithOwner(suite.nodePassing).
 			WithTenancy(tenancy).
 			Write(suite.T(), suite.resourceClient)
 
+		// While paused the node's status must not be updated.
+		for i := 0; i < 5; i++ {
+			time.Sleep(100 * time.Millisecond)
+			res := suite.resourceClient.RequireResourceExists(suite.T(), suite.nodePassing)
+			require.Equal(suite.T(), "HEALTH_PASSING", res.Status[StatusKey].Conditions[0].Reason)
+		}
+
+		// Resuming re-lists the nodes and catches up on the missed change.
+		require.NoError(suite.T(), mgr.Resume(ControllerName))
 		suite.waitForReconciliation(suite.nodePassing, "HEALTH_CRITICAL")
 	})
 }
 
+func (suite *nodeHealthControllerTestSuite) TestController_ExpiredHealthStatus() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		mgr := controller.NewManager(suite.resourceClient, testutil.Logger(suite.T()))
+		mgr.Register(NodeHealthController())
+		mgr.Register(expiry.SweeperController(pbcatalog.HealthStatusType))
+		mgr.SetRaftLeader(true)
+		ctx, cancel := context.WithCancel(context.Background())
+		suite.T().Cleanup(cancel)
+
+		go mgr.Run(ctx)
+
+		suite.waitForReconciliation(suite.nodePassing, "HEALTH_PASSING")
+
+		// A TTL check that fails and is never refreshed.
+		hs := resourcetest.Resource(pbcatalog.HealthStatusType, "ttl-check").
+			WithData(suite.T(), &pbcatalog.HealthStatus{Type: "ttl", Status: pbcatalog.Health_HEALTH_CRITICAL}).
+			WithOwner(suite.nodePassing).
+			WithTenancy(tenancy).
+			WithMeta(resource.ExpireAtKey, time.Now().Add(2*time.Second).Format(time.RFC3339)).
+			Write(suite.T(), suite.resourceClient)
+		suite.waitForReconciliation(suite.nodePassing, "HEALTH_CRITICAL")
+
+		// Once it expires, it's deleted and the node recomputes to passing.
+		retry.Run(suite.T(), func(r *retry.R) {
+			suite.resourceClient.RequireResourceNotFound(r, hs.Id)
+		})
+		suite.waitForReconciliation(suite.nodePassing, "HEALTH_PASSING")
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestController_ExternalEnqueue() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		mgr := controller.NewManager(suite.resourceClient, testutil.Logger(suite.T()))
+		mgr.Register(NodeHealthController())
+		mgr.SetRaftLeader(true)
+		ctx, cancel := context.WithCancel(context.Background())
+		suite.T().Cleanup(cancel)
+
+		go mgr.Run(ctx)
+
+		suite.waitForReconciliation(suite.nodePassing, "HEALTH_PASSING")
+		suite.waitForReconciliation(suite.nodeMaintenance, "HEALTH_MAINTENANCE")
+
+		// Let the reconciles triggered by the status writes settle before
+		// watching for reconciles.
+		time.Sleep(500 * time.Millisecond)
+
+		reconciled := make(chan *pbresource.ID, 16)
+		go mgr.WatchReconcileEvents(ctx, ControllerName, func(event controller.ReconcileEvent) error {
+			reconciled <- event.ID
+			return nil
+		})
+		time.Sleep(100 * time.Millisecond)
+
+		require.NoError(suite.T(), mgr.Enqueue(suite.nodePassing))
+
+		var count int
+		timeout := time.After(500 * time.Millisecond)
+	collect:
+		for {
+			select {
+			case id := <-reconciled:
+				prototest.AssertDeepEqual(suite.T(), suite.nodePassing, id)
+				count++
+			case <-timeout:
+				break collect
+			}
+		}
+		require.Equal(suite.T(), 1, count)
+
+		// Enqueueing a type without a controller is an error.
+		require.Error(suite.T(), mgr.Enqueue(&pbresource.ID{Type: pbcatalog.ServiceType}))
+	})
+}
+
+func TestReportedHealth(t *testing.T) {
+	withStatus := func(status *pbresource.Status) *pbresource.Resource {
+		return &pbresource.Resource{Status: map[string]*pbresource.Status{StatusKey: status}}
+	}
+	withCondition := func(state pbresource.Condition_State, reason string) *pbresource.Resource {
+		return withStatus(&pbresource.Status{Conditions: []*pbresource.Condition{{
+			Type:   StatusConditionHealthy,
+			State:  state,
+			Reason: reason,
+		}}})
+	}
+
+	cases := map[string]struct {
+		node   *pbresource.Resource
+		health pbcatalog.Health
+		err    error
+	}{
+		"unreconciled": {
+			node:   &pbresource.Resource{},
+			health: pbcatalog.Health_HEALTH_CRITICAL,
+			err:    ErrNodeUnreconciled,
+		},
+		"no-conditions": {
+			node:   withStatus(&pbresource.Status{}),
+			health: pbcatalog.Health_HEALTH_CRITICAL,
+			err:    ErrNodeHealthConditionNotFound,
+		},
+		"reconcile-error-only": {
+			node: withStatus(&pbresource.Status{
+				LastReconcileError: &pbresource.ReconcileError{Message: "list failed"},
+			}),
+			health: pbcatalog.Health_HEALTH_CRITICAL,
+			err:    ErrNodeUnreconciled,
+		},
+		"warning": {
+			node:   withCondition(pbresource.Condition_STATE_FALSE, "HEALTH_WARNING"),
+			health: pbcatalog.Health_HEALTH_WARNING,
+		},
+		"remapped-state": {
+			node:   withCondition(pbresource.Condition_STATE_TRUE, "HEALTH_CRITICAL"),
+			health: pbcatalog.Health_HEALTH_CRITICAL,
+		},
+		"critical-in-maintenance": {
+			node:   withCondition(pbresource.Condition_STATE_FALSE, ReasonCriticalInMaintenance),
+			health: pbcatalog.Health_HEALTH_MAINTENANCE,
+		},
+		"unmanaged": {
+			node:   withStatus(&pbresource.Status{Conditions: []*pbresource.Condition{ConditionUnmanaged}}),
+			health: pbcatalog.Health_HEALTH_CRITICAL,
+			err:    ErrNodeUnmanaged,
+		},
+		"unknown-reason-true": {
+			node:   withCondition(pbresource.Condition_STATE_TRUE, "CUSTOM"),
+			health: pbcatalog.Health_HEALTH_PASSING,
+		},
+		"unknown-reason-false": {
+			node:   withCondition(pbresource.Condition_STATE_FALSE, "CUSTOM"),
+			health: pbcatalog.Health_HEALTH_CRITICAL,
+			err:    ErrNodeHealthInvalid,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			health, err := ReportedHealth(tc.node)
+			require.Equal(t, tc.err, err)
+			require.Equal(t, tc.health, health)
+		})
+	}
+}
+
 func TestNodeHealthController(t *testing.T) {
 	suite.Run(t, new(nodeHealthControllerTestSuite))
 }
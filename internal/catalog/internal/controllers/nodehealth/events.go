@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	"time"
+
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// HealthTransition describes a change of the health reported for a node.
+type HealthTransition struct {
+	// Node is the ID of the node.
+	Node *pbresource.ID
+
+	// Previous is the health previously reported for the node, or HEALTH_ANY if
+	// none had been reported yet.
+	Previous pbcatalog.Health
+
+	// Current is the health now reported for the node.
+	Current pbcatalog.Health
+
+	// Time is when the new health was written.
+	Time time.Time
+}
+
+// EventSink receives the node health transitions observed by the controller.
+// PublishHealthTransition is called from the reconciler once the node's new
+// health has been written, so it should return quickly.
+type EventSink interface {
+	PublishHealthTransition(HealthTransition)
+}
+
+// EventSinkFunc is an adapter to allow the use of ordinary functions as an
+// EventSink.
+type EventSinkFunc func(HealthTransition)
+
+// PublishHealthTransition calls f(transition).
+func (f EventSinkFunc) PublishHealthTransition(transition HealthTransition) {
+	f(transition)
+}
+
+// previousHealth returns the health reported by the node's existing status, if
+// any.
+func previousHealth(existing *pbresource.Status) (pbcatalog.Health, bool) {
+	if existing == nil || len(existing.Conditions) == 0 {
+		return pbcatalog.Health_HEALTH_ANY, false
+	}
+	health, ok := HealthFromReason(existing.Conditions[0].Reason)
+	if !ok {
+		return pbcatalog.Health_HEALTH_ANY, false
+	}
+	return health, true
+}
+
+// publishTransition publishes a change of the node's reported health from that
+// in its existing status to the event sink, if there is one.
+func (r *nodeHealthReconciler) publishTransition(id *pbresource.ID, existing *pbresource.Status, health pbcatalog.Health) {
+	if r.eventSink == nil {
+		return
+	}
+	previous, _ := previousHealth(existing)
+	if previous == health {
+		return
+	}
+	r.eventSink.PublishHealthTransition(HealthTransition{
+		Node:     id,
+		Previous: previous,
+		Current:  health,
+		Time:     time.Now(),
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// WithFailingChecksMessage makes the message of the WARNING and CRITICAL
+// conditions list the node's HealthStatus resources that aren't passing, most
+// severe first, e.g. "One or more node health checks are not passing: disk
+// (HEALTH_CRITICAL), memory (HEALTH_WARNING)". At most limit checks are
+// listed, followed by how many more there are, to keep the status small.
+//
+// As the message is compared when deciding whether a node's status needs
+// rewriting, the status is rewritten whenever the listed checks change.
+func WithFailingChecksMessage(limit int) Option {
+	if limit <= 0 {
+		panic("failing checks limit must be positive")
+	}
+	return func(r *nodeHealthReconciler) {
+		r.failingChecksLimit = limit
+	}
+}
+
+// failingCheck is a HealthStatus resource that isn't passing.
+type failingCheck struct {
+	name   string
+	health pbcatalog.Health
+}
+
+// failingChecksCondition returns cond with the node's failing checks appended
+// to its message. cond is returned as is when listing failing checks isn't
+// enabled or none of the checks are failing.
+func (r *nodeHealthReconciler) failingChecksCondition(checks *nodeChecks, cond *pbresource.Condition) *pbresource.Condition {
+	if r.failingChecksLimit == 0 || checks.failingCount == 0 {
+		return cond
+	}
+
+	names := make([]string, len(checks.failing))
+	for i, check := range checks.failing {
+		names[i] = fmt.Sprintf("%s (%s)", check.name, check.health)
+	}
+
+	var msg strings.Builder
+	msg.WriteString(cond.Message)
+	msg.WriteString(": ")
+	msg.WriteString(strings.Join(names, ", "))
+	if more := checks.failingCount - len(checks.failing); more > 0 {
+		fmt.Fprintf(&msg, " and %d more", more)
+	}
+
+	cond = proto.Clone(cond).(*pbresource.Condition)
+	cond.Message = msg.String()
+	return cond
+}
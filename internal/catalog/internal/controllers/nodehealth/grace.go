@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/internal/resource"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// WithUnhealthyGracePeriod keeps a node that is reported PASSING reported that
+// way until its health has been non-passing for the given period, so that brief
+// blips of its checks don't make it flap. The node is requeued to be re-checked
+// once the period elapses. Recovery to PASSING, and entering MAINTENANCE, are
+// reported immediately, as are health levels whose condition is STATE_TRUE (see
+// WithConditionState).
+//
+// The time at which nodes became unhealthy is only kept in memory, so the
+// period restarts when the controller does.
+func WithUnhealthyGracePeriod(period time.Duration) Option {
+	return func(r *nodeHealthReconciler) {
+		r.grace = &gracePeriods{
+			period: period,
+			since:  make(map[resource.ReferenceKey]time.Time),
+			now:    time.Now,
+		}
+	}
+}
+
+// gracePeriods tracks when each node reported PASSING started being unhealthy.
+type gracePeriods struct {
+	period time.Duration
+
+	mu    sync.Mutex
+	since map[resource.ReferenceKey]time.Time
+
+	// now returns the current time. It is replaced in tests.
+	now func() time.Time
+}
+
+// pending returns how much longer the node can be reported PASSING despite
+// having become unhealthy, or zero if its unhealthy health should be reported
+// right away.
+func (g *gracePeriods) pending(id *pbresource.ID) time.Duration {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	key := resource.NewReferenceKey(id)
+	now := g.now()
+	since, ok := g.since[key]
+	if !ok {
+		since = now
+		g.since[key] = since
+	}
+
+	remaining := g.period - now.Sub(since)
+	if remaining <= 0 {
+		delete(g.since, key)
+		return 0
+	}
+	return remaining
+}
+
+func (g *gracePeriods) delete(id *pbresource.ID) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.since, resource.NewReferenceKey(id))
+}
+
+// graceHealth returns the health to report for the node given its computed
+// health, along with how long until it must be re-checked because it is being
+// reported PASSING within its grace period.
+func (r *nodeHealthReconciler) graceHealth(node *pbresource.Resource, health pbcatalog.Health) (pbcatalog.Health, time.Duration) {
+	if r.grace == nil {
+		return health, 0
+	}
+
+	previous, _ := previousHealth(node.Status[StatusKey])
+	if previous != pbcatalog.Health_HEALTH_PASSING ||
+		health == pbcatalog.Health_HEALTH_PASSING ||
+		health == pbcatalog.Health_HEALTH_MAINTENANCE ||
+		r.condition(health).State != pbresource.Condition_STATE_FALSE {
+		r.grace.delete(node.Id)
+		return health, 0
+	}
+
+	remaining := r.grace.pending(node.Id)
+	if remaining == 0 {
+		return health, 0
+	}
+	return pbcatalog.Health_HEALTH_PASSING, remaining
+}
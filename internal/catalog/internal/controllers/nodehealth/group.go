@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/consul/internal/controller"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// GroupHealth is the aggregated health of a group of nodes.
+type GroupHealth struct {
+	// Health is the health of the group's nodes aggregated in the same way as a
+	// node's HealthStatus resources are, e.g. with the precedence given to
+	// WithHealthPrecedence. A group without any nodes is PASSING.
+	Health pbcatalog.Health
+
+	// Nodes contains the health of each node in the group.
+	Nodes []*GroupMemberHealth
+}
+
+// GroupMemberHealth is the health of a single node within a group.
+type GroupMemberHealth struct {
+	ID     *pbresource.ID
+	Health pbcatalog.Health
+}
+
+// NodeGroupHealth aggregates the health of every node in the given tenancy whose
+// metadata has label set to value, such as all nodes with rack=R1. Each node's
+// health is computed in the same way the node health controller would compute
+// it, with the given options applied. The nodes are listed with a single List
+// call, as each page of a List is as costly for the resource service to
+// compute as listing all of them.
+//
+// All reads are made with the given client, so nodes and health statuses that
+// the caller is not authorized to read are excluded from the result as they are
+// filtered out by the resource service.
+func NodeGroupHealth(
+	ctx context.Context,
+	client pbresource.ResourceServiceClient,
+	tenancy *pbresource.Tenancy,
+	label, value string,
+	opts ...Option,
+) (*GroupHealth, error) {
+	if label == "" {
+		return nil, errors.New("label is required")
+	}
+
+	r := newNodeHealthReconciler(opts...)
+	rt := controller.Runtime{Client: client}
+
+	rsp, err := client.List(ctx, &pbresource.ListRequest{
+		Type:    pbcatalog.NodeType,
+		Tenancy: tenancy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	group := &GroupHealth{Health: pbcatalog.Health_HEALTH_PASSING}
+	var healths []pbcatalog.Health
+	for _, node := range rsp.Resources {
+		if v, ok := node.Metadata[label]; !ok || v != value {
+			continue
+		}
+
+		health, err := r.getNodeHealth(ctx, rt, node.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		group.Nodes = append(group.Nodes, &GroupMemberHealth{ID: node.Id, Health: health})
+		healths = append(healths, health)
+	}
+
+	if len(healths) > 0 {
+		group.Health = r.aggregateHealths(healths...)
+	}
+	return group, nil
+}
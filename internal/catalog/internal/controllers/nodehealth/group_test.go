@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/hashicorp/consul/internal/resource/resourcetest"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+func (suite *nodeHealthControllerTestSuite) writeRackNode(name, rack string, tenancy *pbresource.Tenancy, health pbcatalog.Health) *pbresource.ID {
+	id := resourcetest.Resource(pbcatalog.NodeType, name).
+		WithData(suite.T(), nodeData).
+		WithMeta("rack", rack).
+		WithTenancy(tenancy).
+		Write(suite.T(), suite.resourceClient).Id
+
+	resourcetest.Resource(pbcatalog.HealthStatusType, fmt.Sprintf("%s-check", name)).
+		WithData(suite.T(), &pbcatalog.HealthStatus{Type: "tcp", Status: health}).
+		WithOwner(id).
+		WithTenancy(tenancy).
+		Write(suite.T(), suite.resourceClient)
+
+	return id
+}
+
+func (suite *nodeHealthControllerTestSuite) TestNodeGroupHealth() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		suite.writeRackNode("r1-a", "R1", tenancy, pbcatalog.Health_HEALTH_PASSING)
+		r1Critical := suite.writeRackNode("r1-b", "R1", tenancy, pbcatalog.Health_HEALTH_CRITICAL)
+		suite.writeRackNode("r2-a", "R2", tenancy, pbcatalog.Health_HEALTH_PASSING)
+		suite.writeRackNode("r2-b", "R2", tenancy, pbcatalog.Health_HEALTH_PASSING)
+
+		r1, err := NodeGroupHealth(context.Background(), suite.resourceClient, tenancy, "rack", "R1")
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, r1.Health)
+		require.Len(suite.T(), r1.Nodes, 2)
+		for _, member := range r1.Nodes {
+			if member.ID.Name == r1Critical.Name {
+				require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, member.Health)
+			} else {
+				require.Equal(suite.T(), pbcatalog.Health_HEALTH_PASSING, member.Health)
+			}
+		}
+
+		r2, err := NodeGroupHealth(context.Background(), suite.resourceClient, tenancy, "rack", "R2")
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_PASSING, r2.Health)
+		require.Len(suite.T(), r2.Nodes, 2)
+
+		// A group without any nodes is passing.
+		r3, err := NodeGroupHealth(context.Background(), suite.resourceClient, tenancy, "rack", "R3")
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_PASSING, r3.Health)
+		require.Empty(suite.T(), r3.Nodes)
+
+		_, err = NodeGroupHealth(context.Background(), suite.resourceClient, tenancy, "", "R1")
+		require.Error(suite.T(), err)
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestNodeGroupHealthPrecedence() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		suite.writeRackNode("r4-a", "R4", tenancy, pbcatalog.Health_HEALTH_WARNING)
+		suite.writeRackNode("r4-b", "R4", tenancy, pbcatalog.Health_HEALTH_CRITICAL)
+
+		// Nodes are aggregated with the configured precedence, as checks are.
+		group, err := NodeGroupHealth(context.Background(), suite.resourceClient, tenancy, "rack", "R4",
+			WithHealthPrecedence(map[pbcatalog.Health]int{pbcatalog.Health_HEALTH_WARNING: 10}))
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_WARNING, group.Health)
+		require.Len(suite.T(), group.Nodes, 2)
+	})
+}
+
+func (suite *nodeHealthControllerTestSuite) TestNodeGroupHealthListsOnce() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		count := 65
+		for i := 0; i < count; i++ {
+			health := pbcatalog.Health_HEALTH_PASSING
+			if i == count-1 {
+				health = pbcatalog.Health_HEALTH_CRITICAL
+			}
+			suite.writeRackNode(fmt.Sprintf("r5-%03d", i), "R5", tenancy, health)
+		}
+
+		client := &listCountClient{ResourceServiceClient: suite.resourceClient}
+		group, err := NodeGroupHealth(context.Background(), client, tenancy, "rack", "R5")
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, group.Health)
+		require.Len(suite.T(), group.Nodes, count)
+		require.Equal(suite.T(), 1, client.calls)
+	})
+}
+
+// listCountClient counts the List calls made through it.
+type listCountClient struct {
+	pbresource.ResourceServiceClient
+	calls int
+}
+
+func (c *listCountClient) List(ctx context.Context, in *pbresource.ListRequest, opts ...grpc.CallOption) (*pbresource.ListResponse, error) {
+	c.calls++
+	return c.ResourceServiceClient.List(ctx, in, opts...)
+}
+
+func (suite *nodeHealthControllerTestSuite) TestBulkNodeHealth() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		missing := resourceID(pbcatalog.NodeType, "bulk-missing", tenancy)
+		ids := []*pbresource.ID{
+			suite.nodePassing,
+			missing,
+			suite.nodeCritical,
+		}
+
+		results, err := BulkNodeHealth(context.Background(), suite.resourceClient, ids, MissingNodePassing)
+		require.NoError(suite.T(), err)
+		require.Len(suite.T(), results, 3)
+
+		require.Equal(suite.T(), suite.nodePassing, results[0].ID)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_PASSING, results[0].Health)
+		require.Equal(suite.T(), map[pbcatalog.Health]int{pbcatalog.Health_HEALTH_PASSING: 2}, results[0].CheckCounts)
+		require.False(suite.T(), results[0].NotFound)
+		require.NoError(suite.T(), results[0].Err)
+
+		require.Equal(suite.T(), missing, results[1].ID)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_PASSING, results[1].Health)
+		require.Empty(suite.T(), results[1].CheckCounts)
+		require.False(suite.T(), results[1].NotFound)
+
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, results[2].Health)
+		require.Equal(suite.T(), map[pbcatalog.Health]int{
+			pbcatalog.Health_HEALTH_PASSING:  2,
+			pbcatalog.Health_HEALTH_WARNING:  2,
+			pbcatalog.Health_HEALTH_CRITICAL: 2,
+		}, results[2].CheckCounts)
+
+		// Missing nodes can be marked as not found instead.
+		results, err = BulkNodeHealth(context.Background(), suite.resourceClient, ids, MissingNodeNotFound)
+		require.NoError(suite.T(), err)
+		require.Len(suite.T(), results, 3)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_PASSING, results[0].Health)
+		require.True(suite.T(), results[1].NotFound)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_ANY, results[1].Health)
+		require.Nil(suite.T(), results[1].CheckCounts)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, results[2].Health)
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/resource"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// LiveNodeHealth contains both the health persisted in a node's status by the
+// node health controller and the health computed from its current HealthStatus
+// resources. The two differ when the controller has yet to reconcile a change.
+type LiveNodeHealth struct {
+	// Node is the node as it was read.
+	Node *pbresource.Resource
+
+	// StoredCondition is the healthy condition last written by the controller,
+	// or nil if the node has not been reconciled yet. It may be stale.
+	StoredCondition *pbresource.Condition
+
+	// StoredConditionStale is true when the stored condition was written with
+	// a TTL (see WithConditionTTL) and hasn't been refreshed within it, which
+	// suggests the controller is no longer reconciling the node.
+	StoredConditionStale bool
+
+	// LiveCondition is the healthy condition the controller would write if it
+	// reconciled the node now.
+	LiveCondition *pbresource.Condition
+
+	// LiveHealth is the health computed from the node's current HealthStatus
+	// resources.
+	LiveHealth pbcatalog.Health
+
+	// LiveScore is the health score computed from the node's current
+	// HealthStatus resources, or nil unless WithHealthScore was given.
+	LiveScore *float64
+}
+
+// ReadLiveNodeHealth reads the node with the given ID and recomputes its health
+// on the fly, in the same way the node health controller would with the given
+// options applied, returning it alongside the stored condition.
+func ReadLiveNodeHealth(ctx context.Context, client pbresource.ResourceServiceClient, id *pbresource.ID, opts ...Option) (*LiveNodeHealth, error) {
+	if !resource.EqualType(id.Type, pbcatalog.NodeType) {
+		return nil, fmt.Errorf("expected a %s, got %s", resource.ToGVK(pbcatalog.NodeType), resource.ToGVK(id.Type))
+	}
+
+	rsp, err := client.Read(ctx, &pbresource.ReadRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return liveNodeHealth(ctx, client, rsp.Resource, opts...)
+}
+
+// liveNodeHealth recomputes the health of the given node, which has already
+// been read, as ReadLiveNodeHealth does.
+func liveNodeHealth(ctx context.Context, client pbresource.ResourceServiceClient, node *pbresource.Resource, opts ...Option) (*LiveNodeHealth, error) {
+	r := newNodeHealthReconciler(opts...)
+	checks, err := r.listNodeChecks(ctx, controller.Runtime{Client: client}, node.Id)
+	if err != nil {
+		return nil, err
+	}
+	health, score := r.healthAndScore(checks)
+	health = r.stickyHealth(node, health)
+
+	cond, err := r.reportedCondition(checks, health)
+	if err != nil {
+		return nil, err
+	}
+
+	live := &LiveNodeHealth{
+		Node:          node,
+		LiveCondition: cond,
+		LiveHealth:    health,
+		LiveScore:     score,
+	}
+	if stored, ok := node.Status[StatusKey]; ok && len(stored.Conditions) > 0 {
+		live.StoredCondition = stored.Conditions[0]
+		live.StoredConditionStale = resource.IsStatusStale(stored, time.Now())
+	}
+	return live, nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/resource/resourcetest"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/prototest"
+)
+
+func (suite *nodeHealthControllerTestSuite) TestReadLiveNodeHealth() {
+	suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		// Before the first reconcile there is no stored condition.
+		live, err := ReadLiveNodeHealth(context.Background(), suite.resourceClient, suite.nodePassing)
+		require.NoError(suite.T(), err)
+		require.Nil(suite.T(), live.StoredCondition)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_PASSING, live.LiveHealth)
+
+		require.NoError(suite.T(), suite.ctl.Reconcile(context.Background(), suite.runtime, controller.Request{
+			ID: suite.nodePassing,
+		}))
+
+		resourcetest.Resource(pbcatalog.HealthStatusType, "failure").
+			WithData(suite.T(), &pbcatalog.HealthStatus{Type: "fake", Status: pbcatalog.Health_HEALTH_CRITICAL}).
+			WithOwner(suite.nodePassing).
+			WithTenancy(tenancy).
+			Write(suite.T(), suite.resourceClient)
+
+		// The node has not been reconciled since the critical status was
+		// written, so the stored condition is stale.
+		live, err = ReadLiveNodeHealth(context.Background(), suite.resourceClient, suite.nodePassing)
+		require.NoError(suite.T(), err)
+		prototest.AssertDeepEqual(suite.T(), ConditionPassing, live.StoredCondition)
+		prototest.AssertDeepEqual(suite.T(), ConditionCritical, live.LiveCondition)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, live.LiveHealth)
+
+		_, err = ReadLiveNodeHealth(context.Background(), suite.resourceClient, &pbresource.ID{
+			Type:    pbcatalog.HealthStatusType,
+			Tenancy: tenancy,
+			Name:    "failure",
+		})
+		require.Error(suite.T(), err)
+	})
+}
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+// DefaultLocale is the locale of the condition messages defined in this
+// package, such as NodeHealthyMessage.
+const DefaultLocale = "en"
+
+// MessageCatalog contains translations of the condition messages written by the
+// controller, keyed by locale and then by the message in the DefaultLocale,
+// e.g. catalog["fr"][NodeHealthyMessage].
+type MessageCatalog map[string]map[string]string
+
+// message returns the translation of msg into locale, or msg itself if the
+// catalog doesn't have one.
+func (c MessageCatalog) message(locale, msg string) string {
+	if translated, ok := c[locale][msg]; ok {
+		return translated
+	}
+	return msg
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	"github.com/armon/go-metrics"
+	"github.com/armon/go-metrics/prometheus"
+
+	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/telemetry"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+var metricHealthTransition = []string{"catalog", "node_health", "transition"}
+
+var Counters = []prometheus.CounterDefinition{
+	{
+		Name: metricHealthTransition,
+		Help: "Increments whenever the health reported for a node changes, labeled with the previous and new health.",
+	},
+}
+
+// recordTransition counts a change of the node's reported health from that in
+// its existing status, if any.
+func recordTransition(rt controller.Runtime, existing *pbresource.Status, health pbcatalog.Health) {
+	from := "none"
+	if previous, ok := previousHealth(existing); ok {
+		if previous == health {
+			return
+		}
+		from = previous.String()
+	}
+
+	telemetry.OrDefault(rt.Metrics).IncrCounter(metricHealthTransition, 1, []metrics.Label{
+		{Name: "from", Value: from},
+		{Name: "to", Value: health.String()},
+	})
+}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// sampleListed reports whether enough HealthStatus resources have been listed,
+// count of them, to know that the node's health will be sampled. The rest of
+// its children needn't be listed then.
+func (r *nodeHealthReconciler) sampleListed(count int) bool {
+	return r.sampleThreshold > 0 && r.sampleSize > 0 &&
+		count > r.sampleThreshold && count > r.sampleSize
+}
+
+// sampledCondition returns cond with a note that the node's health was
+// estimated from a sample appended to its message. The reason is unchanged so
+// that callers matching on it see the health as usual.
+func (r *nodeHealthReconciler) sampledCondition(cond *pbresource.Condition) *pbresource.Condition {
+	cond = proto.Clone(cond).(*pbresource.Condition)
+	cond.Message += "; " + r.messages.message(r.locale, NodeSampledMessage)
+	return cond
+}
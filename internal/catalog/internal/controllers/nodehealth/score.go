@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+)
+
+// MaxHealthScore is the score of a node whose checks are all passing, or that
+// has no checks.
+const MaxHealthScore = 100
+
+// healthScores is the score contributed by a check of each health. Checks in
+// maintenance score as if critical, as the node isn't serving.
+var healthScores = map[pbcatalog.Health]float64{
+	pbcatalog.Health_HEALTH_PASSING:     MaxHealthScore,
+	pbcatalog.Health_HEALTH_WARNING:     MaxHealthScore / 2,
+	pbcatalog.Health_HEALTH_CRITICAL:    0,
+	pbcatalog.Health_HEALTH_MAINTENANCE: 0,
+}
+
+// healthScore is the average of the scores of a node's checks, weighted by
+// their health. Health levels without a weight have a weight of 1, and a
+// negative weight is treated as 0.
+type healthScore struct {
+	total, sum float64
+}
+
+// add adds a check of the given health to the score.
+func (s *healthScore) add(health pbcatalog.Health, weights map[pbcatalog.Health]float64) {
+	weight, ok := weights[health]
+	if !ok {
+		weight = 1
+	}
+	if weight <= 0 {
+		return
+	}
+	s.total += weight
+	s.sum += weight * healthScores[health]
+}
+
+// value returns the score, which is MaxHealthScore when no checks with a
+// positive weight have been added.
+func (s *healthScore) value() float64 {
+	if s.total == 0 {
+		return MaxHealthScore
+	}
+	return s.sum / s.total
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/internal/resource"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// Source computes the health of nodes for the resource service's node health
+// endpoints, in the same way the node health controller would with the options
+// it was created with.
+type Source struct {
+	opts []Option
+}
+
+// NewSource returns a Source that computes the health of nodes with the given
+// options applied.
+func NewSource(opts ...Option) *Source {
+	return &Source{opts: opts}
+}
+
+// BulkNodeHealth computes the health of the requested nodes with
+// BulkNodeHealth.
+func (s *Source) BulkNodeHealth(ctx context.Context, client pbresource.ResourceServiceClient, req *pbresource.BulkNodeHealthRequest) (*pbresource.BulkNodeHealthResponse, error) {
+	for _, id := range req.Ids {
+		if err := requireNodeID(id); err != nil {
+			return nil, err
+		}
+	}
+
+	missing := MissingNodePassing
+	if req.ReportMissingNodes {
+		missing = MissingNodeNotFound
+	}
+	results, err := BulkNodeHealth(ctx, client, req.Ids, missing, s.opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pbresource.BulkNodeHealthResponse{Results: make([]*pbresource.NodeHealth, 0, len(results))}
+	for _, result := range results {
+		nh := &pbresource.NodeHealth{
+			Id:       result.ID,
+			NotFound: result.NotFound,
+			Status:   status.New(codes.OK, "").Proto(),
+		}
+		switch {
+		case result.Err != nil:
+			nh.Status = status.Convert(result.Err).Proto()
+		case !result.NotFound:
+			nh.Health = result.Health.String()
+			nh.CheckCounts = make(map[string]uint32, len(result.CheckCounts))
+			for health, count := range result.CheckCounts {
+				nh.CheckCounts[health.String()] = uint32(count)
+			}
+		}
+		rsp.Results = append(rsp.Results, nh)
+	}
+	return rsp, nil
+}
+
+// NodeGroupHealth aggregates the health of the requested group of nodes with
+// NodeGroupHealth.
+func (s *Source) NodeGroupHealth(ctx context.Context, client pbresource.ResourceServiceClient, req *pbresource.NodeGroupHealthRequest) (*pbresource.NodeGroupHealthResponse, error) {
+	if req.Label == "" {
+		return nil, status.Error(codes.InvalidArgument, "label is required")
+	}
+
+	group, err := NodeGroupHealth(ctx, client, req.Tenancy, req.Label, req.Value, s.opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pbresource.NodeGroupHealthResponse{
+		Health: group.Health.String(),
+		Nodes:  make([]*pbresource.NodeHealth, 0, len(group.Nodes)),
+	}
+	for _, member := range group.Nodes {
+		rsp.Nodes = append(rsp.Nodes, &pbresource.NodeHealth{
+			Id:     member.ID,
+			Health: member.Health.String(),
+			Status: status.New(codes.OK, "").Proto(),
+		})
+	}
+	return rsp, nil
+}
+
+// LiveNodeHealth recomputes the health of the given node, which the caller has
+// already read, as ReadLiveNodeHealth does.
+func (s *Source) LiveNodeHealth(ctx context.Context, client pbresource.ResourceServiceClient, node *pbresource.Resource) (*pbresource.LiveNodeHealth, error) {
+	if err := requireNodeID(node.Id); err != nil {
+		return nil, err
+	}
+
+	live, err := liveNodeHealth(ctx, client, node, s.opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pbresource.LiveNodeHealth{
+		StoredCondition:      live.StoredCondition,
+		StoredConditionStale: live.StoredConditionStale,
+		LiveCondition:        live.LiveCondition,
+		LiveHealth:           live.LiveHealth.String(),
+	}, nil
+}
+
+// requireNodeID returns an InvalidArgument error unless id is the ID of a node.
+func requireNodeID(id *pbresource.ID) error {
+	if id == nil || !resource.EqualType(id.Type, pbcatalog.NodeType) {
+		return status.Errorf(codes.InvalidArgument, "expected the ID of a %s", resource.ToGVK(pbcatalog.NodeType))
+	}
+	return nil
+}
@@ -4,6 +4,9 @@
 package nodehealth
 
 import (
+	"errors"
+	"fmt"
+
 	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
 	"github.com/hashicorp/consul/proto-public/pbresource"
 )
@@ -12,8 +15,25 @@ const (
 	StatusKey              = "consul.io/node-health"
 	StatusConditionHealthy = "healthy"
 
-	NodeHealthyMessage   = "All node health checks are passing"
-	NodeUnhealthyMessage = "One or more node health checks are not passing"
+	NodeHealthyMessage               = "All node health checks are passing"
+	NodeUnhealthyMessage             = "One or more node health checks are not passing"
+	NodeCriticalInMaintenanceMessage = "The node is in maintenance and one or more node health checks are critical"
+
+	// ReasonCriticalInMaintenance is the reason of the healthy condition of
+	// nodes in maintenance with a critical check, when the controller is
+	// configured with MaintenanceConflictDistinctReason.
+	ReasonCriticalInMaintenance = "HEALTH_CRITICAL_IN_MAINTENANCE"
+
+	// ReasonUnmanaged is the reason of the healthy condition of nodes that have
+	// opted out of health management. See WithOptOutLabel.
+	ReasonUnmanaged = "UNMANAGED"
+
+	NodeUnmanagedMessage = "The node has opted out of node health management"
+
+	// NodeSampledMessage is appended to the message of the healthy condition of
+	// nodes whose health was estimated from a sample of their HealthStatus
+	// resources. See WithSampling.
+	NodeSampledMessage = "health was estimated from a sample of the node's checks"
 )
 
 var (
@@ -45,6 +65,23 @@ var (
 		Message: NodeUnhealthyMessage,
 	}
 
+	ConditionCriticalInMaintenance = &pbresource.Condition{
+		Type:    StatusConditionHealthy,
+		State:   pbresource.Condition_STATE_FALSE,
+		Reason:  ReasonCriticalInMaintenance,
+		Message: NodeCriticalInMaintenanceMessage,
+	}
+
+	// ConditionUnmanaged is the healthy condition of nodes that have opted
+	// out of health management. Their health is neither true nor false as it
+	// isn't computed.
+	ConditionUnmanaged = &pbresource.Condition{
+		Type:    StatusConditionHealthy,
+		State:   pbresource.Condition_STATE_UNKNOWN,
+		Reason:  ReasonUnmanaged,
+		Message: NodeUnmanagedMessage,
+	}
+
 	Conditions = map[pbcatalog.Health]*pbresource.Condition{
 		pbcatalog.Health_HEALTH_PASSING:     ConditionPassing,
 		pbcatalog.Health_HEALTH_WARNING:     ConditionWarning,
@@ -52,3 +89,73 @@ var (
 		pbcatalog.Health_HEALTH_MAINTENANCE: ConditionMaintenance,
 	}
 )
+
+// HealthFromReason returns the health reported by a healthy condition with the
+// given reason, and false if the reason isn't one written by the controller.
+// Nodes with ReasonCriticalInMaintenance are in MAINTENANCE.
+func HealthFromReason(reason string) (pbcatalog.Health, bool) {
+	if reason == ReasonCriticalInMaintenance {
+		return pbcatalog.Health_HEALTH_MAINTENANCE, true
+	}
+	health, ok := pbcatalog.Health_value[reason]
+	return pbcatalog.Health(health), ok
+}
+
+var (
+	// ErrNodeUnreconciled is returned by ReportedHealth for nodes whose health
+	// hasn't been reconciled yet, including those whose status only records
+	// the error from a failed reconcile.
+	ErrNodeUnreconciled = errors.New("Node health has not been reconciled yet")
+
+	// ErrNodeUnmanaged is returned by ReportedHealth for nodes that have opted
+	// out of health management, whose health doesn't apply to anything on them.
+	ErrNodeUnmanaged = errors.New("Node has opted out of node health management")
+
+	// ErrNodeHealthInvalid is returned by ReportedHealth for nodes whose healthy
+	// condition has a reason that isn't one written by the controller.
+	ErrNodeHealthInvalid = errors.New("Node health has invalid reason")
+
+	// ErrNodeHealthConditionNotFound is returned by ReportedHealth for nodes
+	// whose node health status has no healthy condition.
+	ErrNodeHealthConditionNotFound = fmt.Errorf("Node health status is missing the %s condition", StatusConditionHealthy)
+)
+
+// ReportedHealth returns the health last reported for the node by the node
+// health controller. The health is taken from the reason of the healthy
+// condition, as its state may have been remapped with WithConditionState.
+func ReportedHealth(node *pbresource.Resource) (pbcatalog.Health, error) {
+	healthStatus, ok := node.Status[StatusKey]
+	if !ok || (len(healthStatus.Conditions) == 0 && healthStatus.LastReconcileError != nil) {
+		return pbcatalog.Health_HEALTH_CRITICAL, ErrNodeUnreconciled
+	}
+
+	for _, condition := range healthStatus.Conditions {
+		if condition.Type != StatusConditionHealthy {
+			continue
+		}
+		if condition.Reason == ReasonUnmanaged {
+			return pbcatalog.Health_HEALTH_CRITICAL, ErrNodeUnmanaged
+		}
+		if health, valid := HealthFromReason(condition.Reason); valid {
+			return health, nil
+		}
+		if condition.State == pbresource.Condition_STATE_TRUE {
+			return pbcatalog.Health_HEALTH_PASSING, nil
+		}
+		return pbcatalog.Health_HEALTH_CRITICAL, ErrNodeHealthInvalid
+	}
+	return pbcatalog.Health_HEALTH_CRITICAL, ErrNodeHealthConditionNotFound
+}
+
+// PassingDeletePrecondition returns a precondition that can be set on a Delete
+// request for a node to refuse deleting it unless the node health controller
+// last reported it as PASSING. This prevents accidentally deleting a node that
+// is actively failing and masking an incident.
+func PassingDeletePrecondition() *pbresource.DeletePrecondition {
+	return &pbresource.DeletePrecondition{
+		StatusKey:     StatusKey,
+		ConditionType: StatusConditionHealthy,
+		State:         ConditionPassing.State,
+		Reason:        ConditionPassing.Reason,
+	}
+}
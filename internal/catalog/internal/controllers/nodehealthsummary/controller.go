@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealthsummary
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/hashicorp/consul/internal/catalog/internal/controllers/nodehealth"
+	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/storage"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// ControllerName is the name under which the node health summary controller
+// is registered with the controller Manager.
+const ControllerName = "consul.io/node-health-summary"
+
+// SummaryName is the name of the NodeHealthSummary resource maintained by the
+// controller.
+const SummaryName = "global"
+
+// SummaryID is the ID of the NodeHealthSummary resource maintained by the
+// controller.
+func SummaryID() *pbresource.ID {
+	return &pbresource.ID{
+		Type:    pbcatalog.NodeHealthSummaryType,
+		Tenancy: resource.DefaultClusteredTenancy(),
+		Name:    SummaryName,
+	}
+}
+
+// NodeHealthSummaryController maintains the NodeHealthSummary resource with
+// the number of nodes in each partition by the health reported for them by the
+// node health controller.
+func NodeHealthSummaryController() controller.Controller {
+	return controller.ForType(pbcatalog.NodeHealthSummaryType).
+		WithName(ControllerName).
+		WithWatch(pbcatalog.NodeType, mapNodeToSummary).
+		WithReconciler(&nodeHealthSummaryReconciler{})
+}
+
+// mapNodeToSummary maps every node to the summary, which counts all of them.
+func mapNodeToSummary(_ context.Context, _ controller.Runtime, _ *pbresource.Resource) ([]controller.Request, error) {
+	return []controller.Request{{ID: SummaryID()}}, nil
+}
+
+type nodeHealthSummaryReconciler struct{}
+
+func (r *nodeHealthSummaryReconciler) Reconcile(ctx context.Context, rt controller.Runtime, req controller.Request) error {
+	// The runtime is passed by value so replacing it here for the remainder of this
+	// reconciliation request processing will not affect future invocations.
+	rt.Logger = rt.Logger.With("resource-id", req.ID)
+
+	rt.Logger.Trace("reconciling node health summary")
+
+	// Only the summary with the well-known name is maintained.
+	if req.ID.Name != SummaryName {
+		rt.Logger.Trace("ignoring node health summary with an unexpected name")
+		return nil
+	}
+
+	summary, err := r.computeSummary(ctx, rt)
+	if err != nil {
+		rt.Logger.Error("failed to count the nodes by health", "error", err)
+		return err
+	}
+
+	var version string
+	rsp, err := rt.Client.Read(ctx, &pbresource.ReadRequest{Id: SummaryID()})
+	switch {
+	case status.Code(err) == codes.NotFound:
+	case err != nil:
+		rt.Logger.Error("the resource service has returned an unexpected error", "error", err)
+		return err
+	default:
+		existing, err := resource.Decode[*pbcatalog.NodeHealthSummary](rsp.Resource)
+		if err != nil {
+			rt.Logger.Error("error unmarshalling node health summary data", "error", err)
+			return err
+		}
+		if proto.Equal(existing.Data, summary) {
+			rt.Logger.Trace("node health summary is unchanged")
+			return nil
+		}
+		version = existing.Resource.Version
+	}
+
+	data, err := anypb.New(summary)
+	if err != nil {
+		return err
+	}
+	_, err = rt.Client.Write(ctx, &pbresource.WriteRequest{
+		Resource: &pbresource.Resource{
+			Id:      SummaryID(),
+			Version: version,
+			Data:    data,
+		},
+	})
+	if err != nil {
+		rt.Logger.Error("error encountered when attempting to update the node health summary", "error", err)
+		return err
+	}
+
+	rt.Logger.Trace("node health summary was updated")
+	return nil
+}
+
+// computeSummary counts the nodes in each partition by their health. Nodes
+// that have opted out of node health management aren't counted, nor are those
+// whose health hasn't been reconciled yet; the summary is reconciled again once
+// it has been.
+func (r *nodeHealthSummaryReconciler) computeSummary(ctx context.Context, rt controller.Runtime) (*pbcatalog.NodeHealthSummary, error) {
+	rsp, err := rt.Client.List(ctx, &pbresource.ListRequest{
+		Type: pbcatalog.NodeType,
+		Tenancy: &pbresource.Tenancy{
+			Partition: storage.Wildcard,
+			PeerName:  resource.DefaultPeerName,
+			Namespace: storage.Wildcard,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &pbcatalog.NodeHealthSummary{Partitions: make(map[string]*pbcatalog.NodeHealthCounts)}
+	for _, node := range rsp.Resources {
+		health, err := nodehealth.ReportedHealth(node)
+		if err != nil {
+			continue
+		}
+
+		partition := node.Id.Tenancy.Partition
+		counts, ok := summary.Partitions[partition]
+		if !ok {
+			counts = &pbcatalog.NodeHealthCounts{}
+			summary.Partitions[partition] = counts
+		}
+		switch health {
+		case pbcatalog.Health_HEALTH_PASSING:
+			counts.Passing++
+		case pbcatalog.Health_HEALTH_WARNING:
+			counts.Warning++
+		case pbcatalog.Health_HEALTH_CRITICAL:
+			counts.Critical++
+		case pbcatalog.Health_HEALTH_MAINTENANCE:
+			counts.Maintenance++
+		}
+	}
+	return summary, nil
+}
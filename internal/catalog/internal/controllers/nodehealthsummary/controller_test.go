@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodehealthsummary
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	svctest "github.com/hashicorp/consul/agent/grpc-external/services/resource/testing"
+	"github.com/hashicorp/consul/internal/catalog/internal/controllers/nodehealth"
+	"github.com/hashicorp/consul/internal/catalog/internal/types"
+	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/resource/resourcetest"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/prototest"
+	"github.com/hashicorp/consul/sdk/testutil"
+)
+
+var nodeData = &pbcatalog.Node{
+	Addresses: []*pbcatalog.NodeAddress{
+		{
+			Host: "127.0.0.1",
+		},
+	},
+}
+
+type nodeHealthSummaryControllerTestSuite struct {
+	suite.Suite
+
+	resourceClient *resourcetest.Client
+	runtime        controller.Runtime
+
+	ctl nodeHealthSummaryReconciler
+}
+
+func (suite *nodeHealthSummaryControllerTestSuite) SetupTest() {
+	client := svctest.RunResourceService(suite.T(), types.Register)
+	suite.resourceClient = resourcetest.NewClient(client)
+	suite.runtime = controller.Runtime{Client: suite.resourceClient, Logger: testutil.Logger(suite.T())}
+}
+
+func (suite *nodeHealthSummaryControllerTestSuite) writeNode(name string) *pbresource.ID {
+	return resourcetest.Resource(pbcatalog.NodeType, name).
+		WithData(suite.T(), nodeData).
+		Write(suite.T(), suite.resourceClient).Id
+}
+
+// writeNodeHealth writes the status the node health controller would write for
+// a node with the given health.
+func (suite *nodeHealthSummaryControllerTestSuite) writeNodeHealth(id *pbresource.ID, health pbcatalog.Health) {
+	node := suite.resourceClient.RequireResourceExists(suite.T(), id)
+	_, err := suite.resourceClient.WriteStatus(context.Background(), &pbresource.WriteStatusRequest{
+		Id:  node.Id,
+		Key: nodehealth.StatusKey,
+		Status: &pbresource.Status{
+			ObservedGeneration: node.Generation,
+			Conditions:         []*pbresource.Condition{nodehealth.Conditions[health]},
+		},
+	})
+	require.NoError(suite.T(), err)
+}
+
+func (suite *nodeHealthSummaryControllerTestSuite) reconcile() {
+	err := suite.ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: SummaryID()})
+	require.NoError(suite.T(), err)
+}
+
+func (suite *nodeHealthSummaryControllerTestSuite) requireCounts(t resourcetest.T, res *pbresource.Resource, expected *pbcatalog.NodeHealthCounts) {
+	summary := resourcetest.MustDecode[*pbcatalog.NodeHealthSummary](t, res)
+	prototest.AssertDeepEqual(t, map[string]*pbcatalog.NodeHealthCounts{
+		resource.DefaultPartitionName: expected,
+	}, summary.Data.Partitions)
+}
+
+func (suite *nodeHealthSummaryControllerTestSuite) TestReconcile_Counts() {
+	for i, health := range []pbcatalog.Health{
+		pbcatalog.Health_HEALTH_PASSING,
+		pbcatalog.Health_HEALTH_PASSING,
+		pbcatalog.Health_HEALTH_WARNING,
+		pbcatalog.Health_HEALTH_CRITICAL,
+		pbcatalog.Health_HEALTH_MAINTENANCE,
+	} {
+		suite.writeNodeHealth(suite.writeNode(fmt.Sprintf("node-%d", i)), health)
+	}
+
+	// Nodes whose health hasn't been reconciled yet aren't counted.
+	suite.writeNode("unreconciled")
+
+	suite.reconcile()
+	res := suite.resourceClient.RequireResourceExists(suite.T(), SummaryID())
+	suite.requireCounts(suite.T(), res, &pbcatalog.NodeHealthCounts{
+		Passing:     2,
+		Warning:     1,
+		Critical:    1,
+		Maintenance: 1,
+	})
+}
+
+func (suite *nodeHealthSummaryControllerTestSuite) TestReconcile_RemappedState() {
+	node := suite.resourceClient.RequireResourceExists(suite.T(), suite.writeNode("node-1"))
+
+	// A node whose critical health is reported with a true state, as with
+	// nodehealth.WithConditionState, is still counted as critical.
+	_, err := suite.resourceClient.WriteStatus(context.Background(), &pbresource.WriteStatusRequest{
+		Id:  node.Id,
+		Key: nodehealth.StatusKey,
+		Status: &pbresource.Status{
+			ObservedGeneration: node.Generation,
+			Conditions: []*pbresource.Condition{{
+				Type:   nodehealth.StatusConditionHealthy,
+				State:  pbresource.Condition_STATE_TRUE,
+				Reason: pbcatalog.Health_HEALTH_CRITICAL.String(),
+			}},
+		},
+	})
+	require.NoError(suite.T(), err)
+
+	suite.reconcile()
+	res := suite.resourceClient.RequireResourceExists(suite.T(), SummaryID())
+	suite.requireCounts(suite.T(), res, &pbcatalog.NodeHealthCounts{Critical: 1})
+}
+
+func (suite *nodeHealthSummaryControllerTestSuite) TestReconcile_Unchanged() {
+	node := suite.writeNode("node-1")
+	suite.writeNodeHealth(node, pbcatalog.Health_HEALTH_PASSING)
+
+	suite.reconcile()
+	res := suite.resourceClient.RequireResourceExists(suite.T(), SummaryID())
+
+	// The summary isn't rewritten when the counts are unchanged.
+	suite.reconcile()
+	suite.resourceClient.RequireVersionUnchanged(suite.T(), SummaryID(), res.Version)
+
+	suite.writeNodeHealth(node, pbcatalog.Health_HEALTH_CRITICAL)
+	suite.reconcile()
+	res = suite.resourceClient.RequireVersionChanged(suite.T(), SummaryID(), res.Version)
+	suite.requireCounts(suite.T(), res, &pbcatalog.NodeHealthCounts{Critical: 1})
+}
+
+func (suite *nodeHealthSummaryControllerTestSuite) TestReconcile_OtherName() {
+	id := SummaryID()
+	id.Name = "other"
+
+	err := suite.ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: id})
+	require.NoError(suite.T(), err)
+	suite.resourceClient.RequireResourceNotFound(suite.T(), SummaryID())
+}
+
+func (suite *nodeHealthSummaryControllerTestSuite) TestController() {
+	mgr := controller.NewManager(suite.resourceClient, testutil.Logger(suite.T()))
+	mgr.Register(nodehealth.NodeHealthController())
+	mgr.Register(NodeHealthSummaryController())
+	mgr.SetRaftLeader(true)
+	ctx, cancel := context.WithCancel(context.Background())
+	suite.T().Cleanup(cancel)
+	go mgr.Run(ctx)
+
+	var nodes []*pbresource.ID
+	for i := 0; i < 4; i++ {
+		nodes = append(nodes, suite.writeNode(fmt.Sprintf("node-%d", i)))
+	}
+	waitForCounts := func(expected *pbcatalog.NodeHealthCounts) {
+		suite.resourceClient.WaitForResourceState(suite.T(), SummaryID(), func(t resourcetest.T, res *pbresource.Resource) {
+			suite.requireCounts(t, res, expected)
+		})
+	}
+	waitForCounts(&pbcatalog.NodeHealthCounts{Passing: 4})
+
+	// Flipping several nodes updates their counts.
+	writeCheck := func(node *pbresource.ID, health pbcatalog.Health) {
+		resourcetest.Resource(pbcatalog.HealthStatusType, node.Name+"-check").
+			WithData(suite.T(), &pbcatalog.HealthStatus{Type: "fake", Status: health}).
+			WithOwner(node).
+			Write(suite.T(), suite.resourceClient)
+	}
+	writeCheck(nodes[0], pbcatalog.Health_HEALTH_CRITICAL)
+	writeCheck(nodes[1], pbcatalog.Health_HEALTH_CRITICAL)
+	writeCheck(nodes[2], pbcatalog.Health_HEALTH_WARNING)
+	waitForCounts(&pbcatalog.NodeHealthCounts{Passing: 1, Warning: 1, Critical: 2})
+
+	writeCheck(nodes[1], pbcatalog.Health_HEALTH_PASSING)
+	waitForCounts(&pbcatalog.NodeHealthCounts{Passing: 2, Warning: 1, Critical: 1})
+
+	// Deleted nodes are no longer counted.
+	suite.resourceClient.MustDelete(suite.T(), nodes[3])
+	waitForCounts(&pbcatalog.NodeHealthCounts{Passing: 1, Warning: 1, Critical: 1})
+}
+
+func TestNodeHealthSummaryController(t *testing.T) {
+	suite.Run(t, new(nodeHealthSummaryControllerTestSuite))
+}
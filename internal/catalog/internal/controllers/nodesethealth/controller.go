@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodesethealth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/internal/catalog/internal/controllers/nodehealth"
+	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/resource/mappers/bimapper"
+	"github.com/hashicorp/consul/internal/storage"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// ControllerName is the name under which the node set health controller is
+// registered with the controller Manager.
+const ControllerName = "consul.io/node-set-health"
+
+// Option configures the node set health controller.
+type Option func(*nodeSetHealthReconciler)
+
+// WithNodeHealthOptions aggregates the health of each set's members with the
+// aggregation strategy the node health controller is configured with by the
+// given options, so that e.g. a health precedence applies to sets as it does to
+// nodes.
+func WithNodeHealthOptions(opts ...nodehealth.Option) Option {
+	return func(r *nodeSetHealthReconciler) {
+		r.aggregate = nodehealth.HealthAggregator(opts...)
+	}
+}
+
+// NodeSetHealthController maintains the status of each NodeSetHealth resource
+// with the aggregate health of its member nodes, as reported by the node health
+// controller.
+func NodeSetHealthController(opts ...Option) controller.Controller {
+	r := newNodeSetHealthReconciler(opts...)
+	return controller.ForType(pbcatalog.NodeSetHealthType).
+		WithName(ControllerName).
+		WithWatch(pbcatalog.NodeType, r.mapNodeToSets).
+		WithReconciler(r)
+}
+
+// mapNodeToSets maps a node to the node sets in its partition that select it,
+// and to those it was a member of when they were last reconciled, so that the
+// sets a node has just left because its name or metadata has changed are
+// updated too.
+func (r *nodeSetHealthReconciler) mapNodeToSets(ctx context.Context, rt controller.Runtime, res *pbresource.Resource) ([]controller.Request, error) {
+	rsp, err := rt.Client.List(ctx, &pbresource.ListRequest{
+		Type: pbcatalog.NodeSetHealthType,
+		Tenancy: &pbresource.Tenancy{
+			Partition: res.Id.Tenancy.Partition,
+			PeerName:  res.Id.Tenancy.PeerName,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[resource.ReferenceKey]struct{})
+	var reqs []controller.Request
+	add := func(id *pbresource.ID) {
+		key := resource.NewReferenceKey(id)
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		reqs = append(reqs, controller.Request{ID: id})
+	}
+
+	for _, set := range rsp.Resources {
+		sel, err := resource.Decode[*pbcatalog.NodeSetHealth](set)
+		if err != nil {
+			return nil, err
+		}
+		if selects(sel.Data.Selector, res) {
+			add(set.Id)
+		}
+	}
+	for _, id := range r.members.ItemIDsForLink(res.Id) {
+		// Tracked IDs have the default namespace, but node sets are partition
+		// scoped.
+		id.Tenancy.Namespace = ""
+		add(id)
+	}
+	return reqs, nil
+}
+
+type nodeSetHealthReconciler struct {
+	// aggregate computes a set's health from the healths of its members.
+	aggregate func(healths ...pbcatalog.Health) pbcatalog.Health
+
+	// members tracks the member nodes of each set as of its last reconcile.
+	members *bimapper.Mapper
+}
+
+func newNodeSetHealthReconciler(opts ...Option) *nodeSetHealthReconciler {
+	r := &nodeSetHealthReconciler{
+		aggregate: nodehealth.MaxSeverity,
+		members:   bimapper.New(pbcatalog.NodeSetHealthType, pbcatalog.NodeType),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+func (r *nodeSetHealthReconciler) Reconcile(ctx context.Context, rt controller.Runtime, req controller.Request) error {
+	// The runtime is passed by value so replacing it here for the remainder of this
+	// reconciliation request processing will not affect future invocations.
+	rt.Logger = rt.Logger.With("resource-id", req.ID)
+
+	rt.Logger.Trace("reconciling node set health")
+
+	rsp, err := rt.Client.Read(ctx, &pbresource.ReadRequest{Id: req.ID})
+	switch {
+	case status.Code(err) == codes.NotFound:
+		rt.Logger.Trace("node set has been deleted")
+		r.members.UntrackItem(req.ID)
+		return nil
+	case err != nil:
+		rt.Logger.Error("the resource service has returned an unexpected error", "error", err)
+		return err
+	}
+
+	set, err := resource.Decode[*pbcatalog.NodeSetHealth](rsp.Resource)
+	if err != nil {
+		rt.Logger.Error("error unmarshalling node set data", "error", err)
+		return err
+	}
+
+	health, counts, err := r.computeSetHealth(ctx, rt, set)
+	if err != nil {
+		rt.Logger.Error("failed to calculate the node sets health", "error", err)
+		return err
+	}
+
+	newStatus := &pbresource.Status{
+		ObservedGeneration: set.Resource.Generation,
+		Conditions: []*pbresource.Condition{
+			condition(health, counts),
+		},
+	}
+
+	if resource.EqualStatus(set.Resource.Status[StatusKey], newStatus, false) {
+		rt.Logger.Trace("node set health status is unchanged", "health", health.String())
+		return nil
+	}
+
+	_, err = rt.Client.WriteStatus(ctx, &pbresource.WriteStatusRequest{
+		Id:     set.Resource.Id,
+		Key:    StatusKey,
+		Status: newStatus,
+	})
+	if err != nil {
+		rt.Logger.Error("error encountered when attempting to update the node set health status", "error", err)
+		return err
+	}
+
+	rt.Logger.Trace("node set health status was updated", "health", health.String())
+	return nil
+}
+
+// computeSetHealth returns the aggregate health of the set's member nodes, and
+// the number of members with each health. Members whose health hasn't been
+// reported yet are counted as unknown, and those that have opted out of node
+// health management as unmanaged, and neither contribute to the set's health.
+func (r *nodeSetHealthReconciler) computeSetHealth(
+	ctx context.Context,
+	rt controller.Runtime,
+	set *resource.DecodedResource[*pbcatalog.NodeSetHealth],
+) (pbcatalog.Health, memberCounts, error) {
+	// Nodes are namespace scoped, so the members of a set are those selected
+	// from every namespace in its partition.
+	rsp, err := rt.Client.List(ctx, &pbresource.ListRequest{
+		Type: pbcatalog.NodeType,
+		Tenancy: &pbresource.Tenancy{
+			Partition: set.Id.Tenancy.Partition,
+			PeerName:  set.Id.Tenancy.PeerName,
+			Namespace: storage.Wildcard,
+		},
+	})
+	if err != nil {
+		return pbcatalog.Health_HEALTH_CRITICAL, memberCounts{}, err
+	}
+
+	counts := memberCounts{healths: make(map[pbcatalog.Health]int)}
+	var (
+		members []resource.ReferenceOrID
+		healths []pbcatalog.Health
+	)
+	for _, node := range rsp.Resources {
+		if !selects(set.Data.Selector, node) {
+			continue
+		}
+		members = append(members, node.Id)
+
+		health, err := nodehealth.ReportedHealth(node)
+		switch {
+		case errors.Is(err, nodehealth.ErrNodeUnreconciled):
+			counts.unknown++
+			continue
+		case errors.Is(err, nodehealth.ErrNodeUnmanaged):
+			counts.unmanaged++
+			continue
+		case err != nil:
+			return pbcatalog.Health_HEALTH_CRITICAL, memberCounts{}, fmt.Errorf("node %q: %w", node.Id.Name, err)
+		}
+		counts.healths[health]++
+		healths = append(healths, health)
+	}
+	r.members.TrackItem(set.Id, members)
+
+	return r.aggregate(healths...), counts, nil
+}
+
+// selects reports whether the selector selects the node.
+func selects(selector *pbcatalog.NodeSelector, node *pbresource.Resource) bool {
+	for key, value := range selector.GetMetadata() {
+		if v, ok := node.Metadata[key]; !ok || v != value {
+			return false
+		}
+	}
+
+	if len(selector.GetNames()) == 0 && len(selector.GetPrefixes()) == 0 {
+		return true
+	}
+	for _, name := range selector.GetNames() {
+		if node.Id.Name == name {
+			return true
+		}
+	}
+	for _, prefix := range selector.GetPrefixes() {
+		if strings.HasPrefix(node.Id.Name, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodesethealth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	svctest "github.com/hashicorp/consul/agent/grpc-external/services/resource/testing"
+	"github.com/hashicorp/consul/internal/catalog/internal/controllers/nodehealth"
+	"github.com/hashicorp/consul/internal/catalog/internal/types"
+	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/resource/resourcetest"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/prototest"
+	"github.com/hashicorp/consul/sdk/testutil"
+)
+
+var nodeData = &pbcatalog.Node{
+	Addresses: []*pbcatalog.NodeAddress{
+		{
+			Host: "127.0.0.1",
+		},
+	},
+}
+
+type nodeSetHealthControllerTestSuite struct {
+	suite.Suite
+
+	resourceClient *resourcetest.Client
+	runtime        controller.Runtime
+
+	ctl *nodeSetHealthReconciler
+}
+
+func (suite *nodeSetHealthControllerTestSuite) SetupTest() {
+	client := svctest.RunResourceService(suite.T(), types.Register)
+	suite.resourceClient = resourcetest.NewClient(client)
+	suite.runtime = controller.Runtime{Client: suite.resourceClient, Logger: testutil.Logger(suite.T())}
+	suite.ctl = newNodeSetHealthReconciler()
+}
+
+func (suite *nodeSetHealthControllerTestSuite) writeNode(name, rack string) *pbresource.ID {
+	return resourcetest.Resource(pbcatalog.NodeType, name).
+		WithData(suite.T(), nodeData).
+		WithMeta("rack", rack).
+		Write(suite.T(), suite.resourceClient).Id
+}
+
+// writeNodeHealth writes the status the node health controller would write for
+// a node with the given health.
+func (suite *nodeSetHealthControllerTestSuite) writeNodeHealth(id *pbresource.ID, health pbcatalog.Health) {
+	node := suite.resourceClient.RequireResourceExists(suite.T(), id)
+	_, err := suite.resourceClient.WriteStatus(context.Background(), &pbresource.WriteStatusRequest{
+		Id:  node.Id,
+		Key: nodehealth.StatusKey,
+		Status: &pbresource.Status{
+			ObservedGeneration: node.Generation,
+			Conditions:         []*pbresource.Condition{nodehealth.Conditions[health]},
+		},
+	})
+	require.NoError(suite.T(), err)
+}
+
+func (suite *nodeSetHealthControllerTestSuite) writeSet(name string, selector *pbcatalog.NodeSelector) *pbresource.ID {
+	return resourcetest.Resource(pbcatalog.NodeSetHealthType, name).
+		WithData(suite.T(), &pbcatalog.NodeSetHealth{Selector: selector}).
+		Write(suite.T(), suite.resourceClient).Id
+}
+
+func (suite *nodeSetHealthControllerTestSuite) reconcile(id *pbresource.ID) error {
+	return suite.ctl.Reconcile(context.Background(), suite.runtime, controller.Request{ID: id})
+}
+
+func (suite *nodeSetHealthControllerTestSuite) requireCondition(id *pbresource.ID, health pbcatalog.Health, message string) {
+	res := suite.resourceClient.RequireResourceExists(suite.T(), id)
+	conditions := res.Status[StatusKey].GetConditions()
+	require.Len(suite.T(), conditions, 1)
+	require.Equal(suite.T(), health.String(), conditions[0].Reason)
+	require.Equal(suite.T(), message, conditions[0].Message)
+}
+
+func (suite *nodeSetHealthControllerTestSuite) TestReconcile_MemberCritical() {
+	members := []*pbresource.ID{
+		suite.writeNode("node-1", "R1"),
+		suite.writeNode("node-2", "R1"),
+		suite.writeNode("node-3", "R1"),
+	}
+	for _, id := range members {
+		suite.writeNodeHealth(id, pbcatalog.Health_HEALTH_PASSING)
+	}
+
+	// A critical node in another rack isn't a member of the set.
+	other := suite.writeNode("node-4", "R2")
+	suite.writeNodeHealth(other, pbcatalog.Health_HEALTH_CRITICAL)
+
+	set := suite.writeSet("rack-1", &pbcatalog.NodeSelector{Metadata: map[string]string{"rack": "R1"}})
+
+	require.NoError(suite.T(), suite.reconcile(set))
+	suite.requireCondition(set, pbcatalog.Health_HEALTH_PASSING, "3 member nodes: 3 passing")
+
+	suite.writeNodeHealth(members[1], pbcatalog.Health_HEALTH_CRITICAL)
+
+	require.NoError(suite.T(), suite.reconcile(set))
+	suite.requireCondition(set, pbcatalog.Health_HEALTH_CRITICAL, "3 member nodes: 2 passing, 1 critical")
+}
+
+func (suite *nodeSetHealthControllerTestSuite) TestReconcile_NoMembers() {
+	set := suite.writeSet("empty", &pbcatalog.NodeSelector{Names: []string{"missing"}})
+
+	require.NoError(suite.T(), suite.reconcile(set))
+	suite.requireCondition(set, pbcatalog.Health_HEALTH_PASSING, NodeSetEmptyMessage)
+}
+
+func (suite *nodeSetHealthControllerTestSuite) TestReconcile_MemberUnreconciled() {
+	member := suite.writeNode("node-1", "R1")
+	suite.writeNodeHealth(suite.writeNode("node-2", "R1"), pbcatalog.Health_HEALTH_PASSING)
+	set := suite.writeSet("rack-1", &pbcatalog.NodeSelector{Metadata: map[string]string{"rack": "R1"}})
+
+	// The set can't be known to be passing while a member's health is unknown.
+	require.NoError(suite.T(), suite.reconcile(set))
+	suite.requireCondition(set, pbcatalog.Health_HEALTH_PASSING, "2 member nodes: 1 passing, 1 unknown")
+	res := suite.resourceClient.RequireResourceExists(suite.T(), set)
+	require.Equal(suite.T(), pbresource.Condition_STATE_UNKNOWN, res.Status[StatusKey].Conditions[0].State)
+
+	// Unknown members don't hide a critical one.
+	suite.writeNodeHealth(suite.writeNode("node-3", "R1"), pbcatalog.Health_HEALTH_CRITICAL)
+	require.NoError(suite.T(), suite.reconcile(set))
+	suite.requireCondition(set, pbcatalog.Health_HEALTH_CRITICAL, "3 member nodes: 1 passing, 1 critical, 1 unknown")
+
+	suite.writeNodeHealth(member, pbcatalog.Health_HEALTH_PASSING)
+	require.NoError(suite.T(), suite.reconcile(set))
+	suite.requireCondition(set, pbcatalog.Health_HEALTH_CRITICAL, "3 member nodes: 2 passing, 1 critical")
+}
+
+func (suite *nodeSetHealthControllerTestSuite) TestReconcile_MemberUnmanaged() {
+	suite.writeNodeHealth(suite.writeNode("node-1", "R1"), pbcatalog.Health_HEALTH_PASSING)
+	node := suite.resourceClient.RequireResourceExists(suite.T(), suite.writeNode("node-2", "R1"))
+	_, err := suite.resourceClient.WriteStatus(context.Background(), &pbresource.WriteStatusRequest{
+		Id:  node.Id,
+		Key: nodehealth.StatusKey,
+		Status: &pbresource.Status{
+			ObservedGeneration: node.Generation,
+			Conditions:         []*pbresource.Condition{nodehealth.ConditionUnmanaged},
+		},
+	})
+	require.NoError(suite.T(), err)
+	set := suite.writeSet("rack-1", &pbcatalog.NodeSelector{Metadata: map[string]string{"rack": "R1"}})
+
+	// A member that has opted out of node health management doesn't make the
+	// set's health unknown.
+	require.NoError(suite.T(), suite.reconcile(set))
+	suite.requireCondition(set, pbcatalog.Health_HEALTH_PASSING, "2 member nodes: 1 passing, 1 unmanaged")
+	res := suite.resourceClient.RequireResourceExists(suite.T(), set)
+	require.Equal(suite.T(), pbresource.Condition_STATE_TRUE, res.Status[StatusKey].Conditions[0].State)
+}
+
+func (suite *nodeSetHealthControllerTestSuite) TestReconcile_HealthPrecedence() {
+	suite.writeNodeHealth(suite.writeNode("node-1", "R1"), pbcatalog.Health_HEALTH_WARNING)
+	suite.writeNodeHealth(suite.writeNode("node-2", "R1"), pbcatalog.Health_HEALTH_CRITICAL)
+	set := suite.writeSet("rack-1", &pbcatalog.NodeSelector{Metadata: map[string]string{"rack": "R1"}})
+
+	// The precedence the node health controller is configured with applies to
+	// sets too.
+	suite.ctl = newNodeSetHealthReconciler(WithNodeHealthOptions(nodehealth.WithHealthPrecedence(map[pbcatalog.Health]int{
+		pbcatalog.Health_HEALTH_WARNING: 10,
+	})))
+	require.NoError(suite.T(), suite.reconcile(set))
+	suite.requireCondition(set, pbcatalog.Health_HEALTH_WARNING, "2 member nodes: 1 warning, 1 critical")
+}
+
+func (suite *nodeSetHealthControllerTestSuite) TestReconcile_RemappedState() {
+	suite.writeNodeHealth(suite.writeNode("node-1", "R1"), pbcatalog.Health_HEALTH_PASSING)
+	node := suite.resourceClient.RequireResourceExists(suite.T(), suite.writeNode("node-2", "R1"))
+	set := suite.writeSet("rack-1", &pbcatalog.NodeSelector{Metadata: map[string]string{"rack": "R1"}})
+
+	// A member whose warning health is reported with a true state, as with
+	// nodehealth.WithConditionState, is still warning.
+	_, err := suite.resourceClient.WriteStatus(context.Background(), &pbresource.WriteStatusRequest{
+		Id:  node.Id,
+		Key: nodehealth.StatusKey,
+		Status: &pbresource.Status{
+			ObservedGeneration: node.Generation,
+			Conditions: []*pbresource.Condition{{
+				Type:   nodehealth.StatusConditionHealthy,
+				State:  pbresource.Condition_STATE_TRUE,
+				Reason: pbcatalog.Health_HEALTH_WARNING.String(),
+			}},
+		},
+	})
+	require.NoError(suite.T(), err)
+
+	require.NoError(suite.T(), suite.reconcile(set))
+	suite.requireCondition(set, pbcatalog.Health_HEALTH_WARNING, "2 member nodes: 1 passing, 1 warning")
+}
+
+func (suite *nodeSetHealthControllerTestSuite) TestMapNodeToSets() {
+	id := suite.writeNode("node-1", "R1")
+	rack1 := suite.writeSet("rack-1", &pbcatalog.NodeSelector{Metadata: map[string]string{"rack": "R1"}})
+	rack2 := suite.writeSet("rack-2", &pbcatalog.NodeSelector{Metadata: map[string]string{"rack": "R2"}})
+
+	// Only the sets that select the node are mapped.
+	node := suite.resourceClient.RequireResourceExists(suite.T(), id)
+	reqs, err := suite.ctl.mapNodeToSets(context.Background(), suite.runtime, node)
+	require.NoError(suite.T(), err)
+	prototest.AssertElementsMatch(suite.T(), []controller.Request{{ID: rack1}}, reqs)
+
+	// Once the node has moved racks, the set it was last reconciled as a
+	// member of is mapped too, so that it's updated for the node leaving.
+	suite.writeNodeHealth(id, pbcatalog.Health_HEALTH_PASSING)
+	require.NoError(suite.T(), suite.reconcile(rack1))
+	node = resourcetest.Resource(pbcatalog.NodeType, id.Name).
+		WithData(suite.T(), nodeData).
+		WithMeta("rack", "R2").
+		Write(suite.T(), suite.resourceClient)
+
+	// The tracked memberships don't record the sets' Uids.
+	rack1NoUid := proto.Clone(rack1).(*pbresource.ID)
+	rack1NoUid.Uid = ""
+
+	reqs, err = suite.ctl.mapNodeToSets(context.Background(), suite.runtime, node)
+	require.NoError(suite.T(), err)
+	prototest.AssertElementsMatch(suite.T(), []controller.Request{{ID: rack1NoUid}, {ID: rack2}}, reqs)
+
+	// After the set has been reconciled without it, only the new rack is.
+	require.NoError(suite.T(), suite.reconcile(rack1))
+	reqs, err = suite.ctl.mapNodeToSets(context.Background(), suite.runtime, node)
+	require.NoError(suite.T(), err)
+	prototest.AssertElementsMatch(suite.T(), []controller.Request{{ID: rack2}}, reqs)
+}
+
+func (suite *nodeSetHealthControllerTestSuite) TestController() {
+	mgr := controller.NewManager(suite.resourceClient, testutil.Logger(suite.T()))
+	mgr.Register(nodehealth.NodeHealthController())
+	mgr.Register(NodeSetHealthController())
+	mgr.SetRaftLeader(true)
+	ctx, cancel := context.WithCancel(context.Background())
+	suite.T().Cleanup(cancel)
+	go mgr.Run(ctx)
+
+	suite.writeNode("node-1", "R1")
+	member := suite.writeNode("node-2", "R1")
+	set := suite.writeSet("rack-1", &pbcatalog.NodeSelector{Prefixes: []string{"node-"}})
+
+	suite.resourceClient.WaitForStatusCondition(suite.T(), set, StatusKey, condition(
+		pbcatalog.Health_HEALTH_PASSING,
+		memberCounts{healths: map[pbcatalog.Health]int{pbcatalog.Health_HEALTH_PASSING: 2}},
+	))
+
+	// Flipping a member node critical updates the set's summary.
+	resourcetest.Resource(pbcatalog.HealthStatusType, "failure").
+		WithData(suite.T(), &pbcatalog.HealthStatus{Type: "fake", Status: pbcatalog.Health_HEALTH_CRITICAL}).
+		WithOwner(member).
+		Write(suite.T(), suite.resourceClient)
+
+	suite.resourceClient.WaitForStatusCondition(suite.T(), set, StatusKey, condition(
+		pbcatalog.Health_HEALTH_CRITICAL,
+		memberCounts{healths: map[pbcatalog.Health]int{
+			pbcatalog.Health_HEALTH_PASSING:  1,
+			pbcatalog.Health_HEALTH_CRITICAL: 1,
+		}},
+	))
+}
+
+func TestNodeSetHealthController(t *testing.T) {
+	suite.Run(t, new(nodeSetHealthControllerTestSuite))
+}
+
+func TestSelects(t *testing.T) {
+	node := resourcetest.Resource(pbcatalog.NodeType, "web-1").
+		WithMeta("rack", "R1").
+		Build()
+
+	cases := map[string]struct {
+		selector *pbcatalog.NodeSelector
+		selected bool
+	}{
+		"empty": {
+			selector: &pbcatalog.NodeSelector{},
+			selected: true,
+		},
+		"name": {
+			selector: &pbcatalog.NodeSelector{Names: []string{"db-1", "web-1"}},
+			selected: true,
+		},
+		"other name": {
+			selector: &pbcatalog.NodeSelector{Names: []string{"db-1"}},
+		},
+		"prefix": {
+			selector: &pbcatalog.NodeSelector{Prefixes: []string{"web-"}},
+			selected: true,
+		},
+		"metadata": {
+			selector: &pbcatalog.NodeSelector{Metadata: map[string]string{"rack": "R1"}},
+			selected: true,
+		},
+		"other metadata": {
+			selector: &pbcatalog.NodeSelector{Metadata: map[string]string{"rack": "R2"}},
+		},
+		"name and other metadata": {
+			selector: &pbcatalog.NodeSelector{
+				Names:    []string{"web-1"},
+				Metadata: map[string]string{"rack": "R2"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.selected, selects(tc.selector, node))
+		})
+	}
+}
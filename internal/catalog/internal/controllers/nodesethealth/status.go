@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nodesethealth
+
+import (
+	"fmt"
+	"strings"
+
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+const (
+	StatusKey              = "consul.io/node-set-health"
+	StatusConditionHealthy = "healthy"
+
+	NodeSetEmptyMessage = "The node set has no member nodes"
+)
+
+// healths are the member healths counted in the condition message, in order
+// of increasing severity.
+var healths = []pbcatalog.Health{
+	pbcatalog.Health_HEALTH_PASSING,
+	pbcatalog.Health_HEALTH_WARNING,
+	pbcatalog.Health_HEALTH_CRITICAL,
+	pbcatalog.Health_HEALTH_MAINTENANCE,
+}
+
+// memberCounts are the number of members of a node set with each health, whose
+// health is unknown because it hasn't been reported yet, and that have opted
+// out of node health management.
+type memberCounts struct {
+	healths   map[pbcatalog.Health]int
+	unknown   int
+	unmanaged int
+}
+
+// condition returns the healthy condition of a node set with the given
+// aggregate health of the members whose health is known. Its state is unknown
+// rather than true when those members are passing but others are unknown.
+func condition(health pbcatalog.Health, counts memberCounts) *pbresource.Condition {
+	cond := &pbresource.Condition{
+		Type:    StatusConditionHealthy,
+		State:   pbresource.Condition_STATE_FALSE,
+		Reason:  health.String(),
+		Message: countsMessage(counts),
+	}
+	if health == pbcatalog.Health_HEALTH_PASSING {
+		cond.State = pbresource.Condition_STATE_TRUE
+		if counts.unknown > 0 {
+			cond.State = pbresource.Condition_STATE_UNKNOWN
+		}
+	}
+	return cond
+}
+
+// countsMessage describes the number of members with each health, e.g.
+// "3 member nodes: 2 passing, 1 critical".
+func countsMessage(counts memberCounts) string {
+	var total int
+	var parts []string
+	for _, health := range healths {
+		if counts.healths[health] == 0 {
+			continue
+		}
+		total += counts.healths[health]
+		name := strings.ToLower(strings.TrimPrefix(health.String(), "HEALTH_"))
+		parts = append(parts, fmt.Sprintf("%d %s", counts.healths[health], name))
+	}
+	if counts.unknown > 0 {
+		total += counts.unknown
+		parts = append(parts, fmt.Sprintf("%d unknown", counts.unknown))
+	}
+	if counts.unmanaged > 0 {
+		total += counts.unmanaged
+		parts = append(parts, fmt.Sprintf("%d unmanaged", counts.unmanaged))
+	}
+
+	if total == 0 {
+		return NodeSetEmptyMessage
+	}
+	return fmt.Sprintf("%d member nodes: %s", total, strings.Join(parts, ", "))
+}
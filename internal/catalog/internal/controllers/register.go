@@ -7,19 +7,28 @@ import (
 	"github.com/hashicorp/consul/internal/catalog/internal/controllers/endpoints"
 	"github.com/hashicorp/consul/internal/catalog/internal/controllers/failover"
 	"github.com/hashicorp/consul/internal/catalog/internal/controllers/nodehealth"
+	"github.com/hashicorp/consul/internal/catalog/internal/controllers/nodehealthsummary"
+	"github.com/hashicorp/consul/internal/catalog/internal/controllers/nodesethealth"
 	"github.com/hashicorp/consul/internal/catalog/internal/controllers/workloadhealth"
 	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/resource/expiry"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
 )
 
 type Dependencies struct {
 	WorkloadHealthNodeMapper workloadhealth.NodeMapper
 	EndpointsWorkloadMapper  endpoints.WorkloadMapper
 	FailoverMapper           failover.FailoverMapper
+	NodeHealthOptions        []nodehealth.Option
+	WorkloadHealthOptions    []workloadhealth.Option
 }
 
 func Register(mgr *controller.Manager, deps Dependencies) {
-	mgr.Register(nodehealth.NodeHealthController())
-	mgr.Register(workloadhealth.WorkloadHealthController(deps.WorkloadHealthNodeMapper))
+	mgr.Register(nodehealth.NodeHealthController(deps.NodeHealthOptions...))
+	mgr.Register(nodesethealth.NodeSetHealthController(nodesethealth.WithNodeHealthOptions(deps.NodeHealthOptions...)))
+	mgr.Register(nodehealthsummary.NodeHealthSummaryController())
+	mgr.Register(workloadhealth.WorkloadHealthController(deps.WorkloadHealthNodeMapper, deps.WorkloadHealthOptions...))
 	mgr.Register(endpoints.ServiceEndpointsController(deps.EndpointsWorkloadMapper))
 	mgr.Register(failover.FailoverPolicyController(deps.FailoverMapper))
+	mgr.Register(expiry.SweeperController(pbcatalog.HealthStatusType))
 }
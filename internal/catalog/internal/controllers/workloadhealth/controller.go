@@ -19,9 +19,10 @@ import (
 )
 
 var (
-	errNodeUnreconciled            = errors.New("Node health has not been reconciled yet")
-	errNodeHealthInvalid           = errors.New("Node health has invalid reason")
-	errNodeHealthConditionNotFound = fmt.Errorf("Node health status is missing the %s condition", nodehealth.StatusConditionHealthy)
+	errNodeUnreconciled            = nodehealth.ErrNodeUnreconciled
+	errNodeUnmanaged               = nodehealth.ErrNodeUnmanaged
+	errNodeHealthInvalid           = nodehealth.ErrNodeHealthInvalid
+	errNodeHealthConditionNotFound = nodehealth.ErrNodeHealthConditionNotFound
 )
 
 // The NodeMapper interface is used to provide an implementation around being able to
@@ -45,19 +46,42 @@ type NodeMapper interface {
 	NodeIDFromWorkload(workload *pbresource.Resource, workloadData *pbcatalog.Workload) *pbresource.ID
 }
 
-func WorkloadHealthController(nodeMap NodeMapper) controller.Controller {
+// Option configures the workload health controller.
+type Option func(*workloadHealthReconciler)
+
+// WithNodeMaintenanceInheritance makes workloads inherit maintenance from their
+// node. While a workload's node is in MAINTENANCE the workload's health is
+// reported as MAINTENANCE with the ConditionNodeMaintenanceInherited condition,
+// regardless of the workload's own health checks, so that alerts based on them
+// are suppressed.
+func WithNodeMaintenanceInheritance() Option {
+	return func(r *workloadHealthReconciler) {
+		r.inheritNodeMaintenance = true
+	}
+}
+
+func WorkloadHealthController(nodeMap NodeMapper, opts ...Option) controller.Controller {
 	if nodeMap == nil {
 		panic("No NodeMapper was provided to the WorkloadHealthController constructor")
 	}
 
+	r := &workloadHealthReconciler{nodeMap: nodeMap}
+	for _, opt := range opts {
+		opt(r)
+	}
+
 	return controller.ForType(pbcatalog.WorkloadType).
 		WithWatch(pbcatalog.HealthStatusType, controller.MapOwnerFiltered(pbcatalog.WorkloadType)).
 		WithWatch(pbcatalog.NodeType, nodeMap.MapNodeToWorkloads).
-		WithReconciler(&workloadHealthReconciler{nodeMap: nodeMap})
+		WithReconciler(r)
 }
 
 type workloadHealthReconciler struct {
 	nodeMap NodeMapper
+
+	// inheritNodeMaintenance overrides the health of workloads on nodes in
+	// maintenance.
+	inheritNodeMaintenance bool
 }
 
 func (r *workloadHealthReconciler) Reconcile(ctx context.Context, rt controller.Runtime, req controller.Request) error {
@@ -90,6 +114,7 @@ func (r *workloadHealthReconciler) Reconcile(ctx context.Context, rt controller.
 	}
 
 	nodeHealth := pbcatalog.Health_HEALTH_PASSING
+	nodeManaged := workload.NodeName != ""
 	if workload.NodeName != "" {
 		nodeID := r.nodeMap.NodeIDFromWorkload(res, &workload)
 		r.nodeMap.TrackWorkload(res.Id, nodeID)
@@ -100,7 +125,12 @@ func (r *workloadHealthReconciler) Reconcile(ctx context.Context, rt controller.
 		// before we configured the node mapper to map subsequent events to this
 		// workload.
 		nodeHealth, err = getNodeHealth(ctx, rt, nodeID)
-		if err != nil {
+		switch {
+		case errors.Is(err, errNodeUnmanaged):
+			// The node has opted out of health management so only the
+			// workload's own health checks determine its health.
+			nodeHealth, nodeManaged = pbcatalog.Health_HEALTH_PASSING, false
+		case err != nil:
 			rt.Logger.Error("error looking up node health", "error", err, "node-id", nodeID)
 			return err
 		}
@@ -109,16 +139,32 @@ func (r *workloadHealthReconciler) Reconcile(ctx context.Context, rt controller.
 		r.nodeMap.UntrackWorkload(res.Id)
 	}
 
-	// passing the workload from the response because getWorkloadHealth uses
-	// resourceClient.ListByOwner which requires ownerID have a Uid and this is the
-	// safest way for application and test code to ensure Uid is provided.
-	workloadHealth, err := getWorkloadHealth(ctx, rt, rsp.Resource.Id)
-	if err != nil {
-		// This should be impossible under normal operations and will not be exercised
-		// within the unit tests. This can only fail if the resource service fails
-		// or allows admission of invalid health statuses.
-		rt.Logger.Error("error aggregating workload health statuses", "error", err)
-		return err
+	var (
+		workloadHealth pbcatalog.Health
+		condition      *pbresource.Condition
+	)
+	if r.inheritNodeMaintenance && nodeHealth == pbcatalog.Health_HEALTH_MAINTENANCE {
+		// The workload's own health checks are ignored for as long as its node
+		// is in maintenance.
+		workloadHealth = pbcatalog.Health_HEALTH_MAINTENANCE
+		condition = ConditionNodeMaintenanceInherited
+	} else {
+		// passing the workload from the response because getWorkloadHealth uses
+		// resourceClient.ListByOwner which requires ownerID have a Uid and this is the
+		// safest way for application and test code to ensure Uid is provided.
+		workloadHealth, err = getWorkloadHealth(ctx, rt, rsp.Resource.Id)
+		if err != nil {
+			// This should be impossible under normal operations and will not be exercised
+			// within the unit tests. This can only fail if the resource service fails
+			// or allows admission of invalid health statuses.
+			rt.Logger.Error("error aggregating workload health statuses", "error", err)
+			return err
+		}
+
+		condition = WorkloadConditions[workloadHealth]
+		if nodeManaged {
+			condition = NodeAndWorkloadConditions[workloadHealth][nodeHealth]
+		}
 	}
 
 	health := nodeHealth
@@ -126,11 +172,6 @@ func (r *workloadHealthReconciler) Reconcile(ctx context.Context, rt controller.
 		health = workloadHealth
 	}
 
-	condition := WorkloadConditions[workloadHealth]
-	if workload.NodeName != "" {
-		condition = NodeAndWorkloadConditions[workloadHealth][nodeHealth]
-	}
-
 	newStatus := &pbresource.Status{
 		ObservedGeneration: res.Generation,
 		Conditions: []*pbresource.Condition{
@@ -171,34 +212,15 @@ func getNodeHealth(ctx context.Context, rt controller.Runtime, nodeRef *pbresour
 		return pbcatalog.Health_HEALTH_CRITICAL, nil
 	case err != nil:
 		return pbcatalog.Health_HEALTH_CRITICAL, err
-	default:
-		healthStatus, ok := rsp.Resource.Status[nodehealth.StatusKey]
-		if !ok {
-			// The Nodes health has never been reconciled and therefore the
-			// workloads health cannot be determined. Returning nil is acceptable
-			// because the controller should sometime soon run reconciliation for
-			// the node which will then trigger rereconciliation of this workload
-			return pbcatalog.Health_HEALTH_CRITICAL, errNodeUnreconciled
-		}
-
-		for _, condition := range healthStatus.Conditions {
-			if condition.Type == nodehealth.StatusConditionHealthy {
-				if condition.State == pbresource.Condition_STATE_TRUE {
-					return pbcatalog.Health_HEALTH_PASSING, nil
-				}
-
-				healthReason, valid := pbcatalog.Health_value[condition.Reason]
-				if !valid {
-					// The Nodes health is unknown - presumably the node health controller
-					// will come along and fix that up momentarily causing this workload
-					// reconciliation to occur again.
-					return pbcatalog.Health_HEALTH_CRITICAL, errNodeHealthInvalid
-				}
-				return pbcatalog.Health(healthReason), nil
-			}
-		}
-		return pbcatalog.Health_HEALTH_CRITICAL, errNodeHealthConditionNotFound
 	}
+
+	// When the Nodes health has never been reconciled the workloads health
+	// cannot be determined. Returning the error is acceptable because the
+	// controller should sometime soon run reconciliation for the node which
+	// will then trigger rereconciliation of this workload. The same goes for a
+	// node whose health is unknown - presumably the node health controller will
+	// come along and fix that up momentarily.
+	return nodehealth.ReportedHealth(rsp.Resource)
 }
 
 func getWorkloadHealth(ctx context.Context, rt controller.Runtime, workloadRef *pbresource.ID) (pbcatalog.Health, error) {
@@ -393,6 +393,42 @@ func (suite *workloadHealthControllerTestSuite) TestReconcile() {
 	}
 }
 
+func (suite *workloadHealthControllerTestSuite) TestReconcile_NodeMaintenanceInheritance() {
+	// With maintenance inheritance enabled, a node in maintenance overrides the
+	// workload's own health checks. Other node health is combined as usual.
+	WithNodeMaintenanceInheritance()(suite.reconciler)
+
+	cases := map[string]struct {
+		nodeHealth     pbcatalog.Health
+		workloadHealth pbcatalog.Health
+		expectedStatus *pbresource.Condition
+	}{
+		"maintenance-node-passing-workload": {
+			nodeHealth:     pbcatalog.Health_HEALTH_MAINTENANCE,
+			workloadHealth: pbcatalog.Health_HEALTH_PASSING,
+			expectedStatus: ConditionNodeMaintenanceInherited,
+		},
+		"maintenance-node-critical-workload": {
+			nodeHealth:     pbcatalog.Health_HEALTH_MAINTENANCE,
+			workloadHealth: pbcatalog.Health_HEALTH_CRITICAL,
+			expectedStatus: ConditionNodeMaintenanceInherited,
+		},
+		"critical-node-warning-workload": {
+			nodeHealth:     pbcatalog.Health_HEALTH_CRITICAL,
+			workloadHealth: pbcatalog.Health_HEALTH_WARNING,
+			expectedStatus: ConditionNodeAndWorkloadCritical,
+		},
+	}
+
+	for name, tcase := range cases {
+		suite.Run(name, func() {
+			suite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+				suite.testReconcileWithNode(tcase.nodeHealth, tcase.workloadHealth, tenancy, tcase.expectedStatus)
+			})
+		})
+	}
+}
+
 func (suite *workloadHealthControllerTestSuite) TestReconcileReadError() {
 	// This test's goal is to prove that errors other than NotFound from the Resource service
 	// when reading the workload to reconcile will be propagate back to the Reconcile caller.
@@ -585,6 +621,57 @@ func (suite *workloadHealthControllerTestSuite) TestController() {
 	})
 }
 
+func (suite *workloadHealthControllerTestSuite) TestController_OptedOutNode() {
+	// A workload on a node that has opted out of node health management gets
+	// its health from its own checks rather than waiting on the node's.
+	mgr := controller.NewManager(suite.client, testutil.Logger(suite.T()))
+	mgr.Register(WorkloadHealthController(suite.mapper))
+	mgr.Register(nodehealth.NodeHealthController(nodehealth.WithOptOutLabel("consul.io/health", "disabled")))
+	mgr.SetRaftLeader(true)
+	ctx, cancel := context.WithCancel(context.Background())
+	suite.T().Cleanup(cancel)
+
+	go mgr.Run(ctx)
+
+	suite.controllerSuite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		writeNode := func(labels map[string]string) *pbresource.Resource {
+			b := resourcetest.Resource(pbcatalog.NodeType, "test-node").
+				WithData(suite.T(), nodeData).
+				WithTenancy(tenancy)
+			for k, v := range labels {
+				b = b.WithMeta(k, v)
+			}
+			return b.Write(suite.T(), suite.client)
+		}
+		node := writeNode(map[string]string{"consul.io/health": "disabled"})
+
+		resourcetest.Resource(pbcatalog.HealthStatusType, "node-status").
+			WithData(suite.T(), &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_CRITICAL}).
+			WithOwner(node.Id).
+			WithTenancy(tenancy).
+			Write(suite.T(), suite.client)
+
+		workload := resourcetest.Resource(pbcatalog.WorkloadType, "test-workload").
+			WithData(suite.T(), workloadData(node.Id.Name)).
+			WithTenancy(tenancy).
+			Write(suite.T(), suite.client)
+		resourcetest.Resource(pbcatalog.HealthStatusType, "test-status").
+			WithData(suite.T(), &pbcatalog.HealthStatus{Type: "tcp", Status: pbcatalog.Health_HEALTH_WARNING}).
+			WithOwner(workload.Id).
+			WithTenancy(tenancy).
+			Write(suite.T(), suite.client)
+
+		// The node's critical check doesn't apply while it is unmanaged.
+		suite.waitForReconciliation(workload.Id, "HEALTH_WARNING")
+		res := suite.checkWorkloadStatus(workload.Id, WorkloadConditions[pbcatalog.Health_HEALTH_WARNING])
+
+		// Resuming management of the node makes its health apply again.
+		writeNode(nil)
+		suite.waitForReconciliation(workload.Id, "HEALTH_CRITICAL")
+		suite.checkWorkloadStatus(res.Id, NodeAndWorkloadConditions[pbcatalog.Health_HEALTH_WARNING][pbcatalog.Health_HEALTH_CRITICAL])
+	})
+}
+
 // wait for reconciliation is a helper to check if a resource has been reconciled and
 // is marked with the expected status.
 func (suite *workloadHealthControllerTestSuite) waitForReconciliation(id *pbresource.ID, reason string) {
@@ -748,6 +835,49 @@ func (suite *getNodeHealthTestSuite) TestUnreconciled() {
 	})
 }
 
+func (suite *getNodeHealthTestSuite) TestReconcileErrorOnly() {
+	// A node whose first reconcile failed has a status recording the error
+	// without any conditions. Its health is treated as not reconciled yet
+	// rather than as missing the healthy condition.
+	suite.controllerSuite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		node := resourcetest.Resource(pbcatalog.NodeType, "reconcile-error").
+			WithData(suite.T(), nodeData).
+			WithTenancy(tenancy).
+			Write(suite.T(), suite.client)
+		_, err := suite.client.WriteStatus(context.Background(), &pbresource.WriteStatusRequest{
+			Id:  node.Id,
+			Key: nodehealth.StatusKey,
+			Status: &pbresource.Status{
+				ObservedGeneration: node.Generation,
+				LastReconcileError: &pbresource.ReconcileError{Message: "list failed"},
+			},
+		})
+		require.NoError(suite.T(), err)
+
+		health, err := getNodeHealth(context.Background(), suite.runtime, node.Id)
+		require.Equal(suite.T(), errNodeUnreconciled, err)
+		require.Equal(suite.T(), pbcatalog.Health_HEALTH_CRITICAL, health)
+	})
+}
+
+func (suite *getNodeHealthTestSuite) TestUnmanaged() {
+	// Nodes that have opted out of node health management are reported as
+	// such rather than as having an unknown health.
+	suite.controllerSuite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		node := resourcetest.Resource(pbcatalog.NodeType, "unmanaged").
+			WithData(suite.T(), nodeData).
+			WithTenancy(tenancy).
+			WithStatus(nodehealth.StatusKey, &pbresource.Status{
+				Conditions: []*pbresource.Condition{nodehealth.ConditionUnmanaged},
+			}).
+			Write(suite.T(), suite.client).
+			GetId()
+
+		_, err := getNodeHealth(context.Background(), suite.runtime, node)
+		require.Equal(suite.T(), errNodeUnmanaged, err)
+	})
+}
+
 func (suite *getNodeHealthTestSuite) TestNoConditions() {
 	// This test's goal is to ensure that if a node's health status doesn't have
 	// the expected condition then its deemed critical. This should never happen
@@ -825,6 +955,39 @@ func (suite *getNodeHealthTestSuite) TestValidHealth() {
 	})
 }
 
+func (suite *getNodeHealthTestSuite) TestRemappedState() {
+	// The node health controller may be configured to report any health with
+	// a true state, in which case the reason must still be taken as the node's
+	// health rather than the node being deemed passing.
+	suite.controllerSuite.runTestCaseWithTenancies(func(tenancy *pbresource.Tenancy) {
+		for _, health := range []pbcatalog.Health{
+			pbcatalog.Health_HEALTH_WARNING,
+			pbcatalog.Health_HEALTH_CRITICAL,
+			pbcatalog.Health_HEALTH_MAINTENANCE,
+		} {
+			suite.T().Run(health.String(), func(t *testing.T) {
+				node := resourcetest.Resource(pbcatalog.NodeType, "remapped").
+					WithData(t, nodeData).
+					WithTenancy(tenancy).
+					WithStatus(nodehealth.StatusKey, &pbresource.Status{
+						Conditions: []*pbresource.Condition{
+							{
+								Type:   nodehealth.StatusConditionHealthy,
+								State:  pbresource.Condition_STATE_TRUE,
+								Reason: health.String(),
+							},
+						},
+					}).
+					Write(t, suite.client)
+
+				actualHealth, err := getNodeHealth(context.Background(), suite.runtime, node.Id)
+				require.NoError(t, err)
+				require.Equal(t, health, actualHealth)
+			})
+		}
+	})
+}
+
 func TestGetNodeHealth(t *testing.T) {
 	suite.Run(t, new(getNodeHealthTestSuite))
 }
@@ -17,6 +17,7 @@ const (
 	WorkloadHealthyMessage          = "All workload health checks are passing"
 	NodeAndWorkloadUnhealthyMessage = "One or more workload and node health checks are not passing"
 	WorkloadUnhealthyMessage        = "One or more workload health checks are not passing"
+	NodeMaintenanceInheritedMessage = "The workload's node is in maintenance"
 )
 
 var (
@@ -97,6 +98,16 @@ var (
 		Message: nodehealth.NodeUnhealthyMessage,
 	}
 
+	// ConditionNodeMaintenanceInherited is used instead of the workload's own
+	// health when its node is in maintenance and the controller was configured
+	// with WithNodeMaintenanceInheritance.
+	ConditionNodeMaintenanceInherited = &pbresource.Condition{
+		Type:    StatusConditionHealthy,
+		State:   pbresource.Condition_STATE_FALSE,
+		Reason:  pbcatalog.Health_HEALTH_MAINTENANCE.String(),
+		Message: NodeMaintenanceInheritedMessage,
+	}
+
 	// WorkloadConditions is a map of the workloadhealth to the status condition
 	// used to represent that health.
 	WorkloadConditions = map[pbcatalog.Health]*pbresource.Condition{
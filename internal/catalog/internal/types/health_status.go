@@ -20,6 +20,9 @@ func RegisterHealthStatus(r resource.Registry) {
 		Proto:    &pbcatalog.HealthStatus{},
 		Scope:    resource.ScopeNamespace,
 		Validate: ValidateHealthStatus,
+		// HealthStatuses describe their owner, so there's no point keeping one
+		// whose owner doesn't exist.
+		ValidateOwner: true,
 		ACLs: &resource.ACLHooks{
 			Read:  resource.AuthorizeReadWithResource(aclReadHookHealthStatus),
 			Write: aclWriteHookHealthStatus,
@@ -5,6 +5,7 @@ package types
 
 import (
 	"github.com/hashicorp/go-multierror"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/internal/resource"
@@ -30,9 +31,31 @@ func RegisterNode(r resource.Registry) {
 			Write: aclWriteHookNode,
 			List:  resource.NoOpACLListHook,
 		},
+		DerivedFields: map[string]resource.DerivedFieldFunc{
+			"primary_address": nodePrimaryAddress,
+		},
 	})
 }
 
+// nodePrimaryAddress derives the address other nodes should use to reach the
+// node: its first internal address, or its first address if all are external.
+func nodePrimaryAddress(res *pbresource.Resource) (*structpb.Value, error) {
+	node, err := resource.Decode[*pbcatalog.Node](res)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(node.Data.Addresses) == 0 {
+		return structpb.NewNullValue(), nil
+	}
+	for _, addr := range node.Data.Addresses {
+		if !addr.External {
+			return structpb.NewStringValue(addr.Host), nil
+		}
+	}
+	return structpb.NewStringValue(node.Data.Addresses[0].Host), nil
+}
+
 var ValidateNode = resource.DecodeAndValidate(validateNode)
 
 func validateNode(res *DecodedNode) error {
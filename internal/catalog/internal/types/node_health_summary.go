@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package types
+
+import (
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/internal/resource"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+type DecodedNodeHealthSummary = resource.DecodedResource[*pbcatalog.NodeHealthSummary]
+
+func RegisterNodeHealthSummary(r resource.Registry) {
+	r.Register(resource.Registration{
+		Type:     pbcatalog.NodeHealthSummaryType,
+		Proto:    &pbcatalog.NodeHealthSummary{},
+		Scope:    resource.ScopeCluster,
+		Validate: ValidateNodeHealthSummary,
+		ACLs: &resource.ACLHooks{
+			Read:  aclReadHookNodeHealthSummary,
+			Write: aclWriteHookNodeHealthSummary,
+			List:  resource.NoOpACLListHook,
+		},
+	})
+}
+
+var ValidateNodeHealthSummary = resource.DecodeAndValidate(validateNodeHealthSummary)
+
+func validateNodeHealthSummary(res *DecodedNodeHealthSummary) error {
+	// Iterate in a stable order so that the errors are deterministic.
+	partitions := make([]string, 0, len(res.Data.Partitions))
+	for partition := range res.Data.Partitions {
+		partitions = append(partitions, partition)
+	}
+	sort.Strings(partitions)
+
+	var err error
+	for _, partition := range partitions {
+		if nameErr := resource.ValidateName(partition); nameErr != nil {
+			err = multierror.Append(err, resource.ErrInvalidMapKey{
+				Map:     "partitions",
+				Key:     partition,
+				Wrapped: nameErr,
+			})
+		}
+	}
+	return err
+}
+
+// The summary reveals the health of every node in the cluster, so reading it
+// requires access to all of them.
+func aclReadHookNodeHealthSummary(authorizer acl.Authorizer, authzContext *acl.AuthorizerContext, _ *pbresource.ID, _ *pbresource.Resource) error {
+	return authorizer.ToAllowAuthorizer().NodeReadAllAllowed(authzContext)
+}
+
+func aclWriteHookNodeHealthSummary(authorizer acl.Authorizer, authzContext *acl.AuthorizerContext, _ *pbresource.Resource) error {
+	return authorizer.ToAllowAuthorizer().OperatorWriteAllowed(authzContext)
+}
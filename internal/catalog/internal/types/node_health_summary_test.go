@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/hashicorp/consul/internal/resource"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+func createNodeHealthSummaryResource(t *testing.T, data *pbcatalog.NodeHealthSummary) *pbresource.Resource {
+	res := &pbresource.Resource{
+		Id: &pbresource.ID{
+			Type:    pbcatalog.NodeHealthSummaryType,
+			Tenancy: resource.DefaultClusteredTenancy(),
+			Name:    "global",
+		},
+	}
+
+	var err error
+	res.Data, err = anypb.New(data)
+	require.NoError(t, err)
+	return res
+}
+
+func TestValidateNodeHealthSummary_Ok(t *testing.T) {
+	res := createNodeHealthSummaryResource(t, &pbcatalog.NodeHealthSummary{
+		Partitions: map[string]*pbcatalog.NodeHealthCounts{
+			"default": {Passing: 3, Critical: 1},
+		},
+	})
+
+	require.NoError(t, ValidateNodeHealthSummary(res))
+}
+
+func TestValidateNodeHealthSummary_InvalidPartitions(t *testing.T) {
+	res := createNodeHealthSummaryResource(t, &pbcatalog.NodeHealthSummary{
+		Partitions: map[string]*pbcatalog.NodeHealthCounts{
+			"Not-A-Name": {Passing: 1},
+			"default":    {Passing: 1},
+		},
+	})
+
+	err := ValidateNodeHealthSummary(res)
+	require.Error(t, err)
+	require.ErrorContains(t, err, `map partitions contains an invalid key - "Not-A-Name"`)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package types
+
+import (
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/internal/resource"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+type DecodedNodeSetHealth = resource.DecodedResource[*pbcatalog.NodeSetHealth]
+
+func RegisterNodeSetHealth(r resource.Registry) {
+	r.Register(resource.Registration{
+		Type:     pbcatalog.NodeSetHealthType,
+		Proto:    &pbcatalog.NodeSetHealth{},
+		Scope:    resource.ScopePartition,
+		Validate: ValidateNodeSetHealth,
+		ACLs: &resource.ACLHooks{
+			Read:  aclReadHookNodeSetHealth,
+			Write: aclWriteHookNodeSetHealth,
+			List:  resource.NoOpACLListHook,
+		},
+	})
+}
+
+var ValidateNodeSetHealth = resource.DecodeAndValidate(validateNodeSetHealth)
+
+func validateNodeSetHealth(res *DecodedNodeSetHealth) error {
+	selector := res.Data.Selector
+	if selector == nil {
+		return resource.ErrInvalidField{
+			Name:    "selector",
+			Wrapped: resource.ErrMissing,
+		}
+	}
+
+	var err error
+	for idx, name := range selector.Names {
+		if name == "" {
+			err = multierror.Append(err, resource.ErrInvalidField{
+				Name: "selector",
+				Wrapped: resource.ErrInvalidListElement{
+					Name:    "names",
+					Index:   idx,
+					Wrapped: resource.ErrEmpty,
+				},
+			})
+		}
+	}
+	for idx, prefix := range selector.Prefixes {
+		if prefix == "" {
+			err = multierror.Append(err, resource.ErrInvalidField{
+				Name: "selector",
+				Wrapped: resource.ErrInvalidListElement{
+					Name:    "prefixes",
+					Index:   idx,
+					Wrapped: resource.ErrEmpty,
+				},
+			})
+		}
+	}
+	if _, ok := selector.Metadata[""]; ok {
+		err = multierror.Append(err, resource.ErrInvalidField{
+			Name: "selector",
+			Wrapped: resource.ErrInvalidMapKey{
+				Map:     "metadata",
+				Key:     "",
+				Wrapped: resource.ErrEmpty,
+			},
+		})
+	}
+	return err
+}
+
+// The summary reveals the health of all of the set's members, so reading it
+// requires access to every node.
+func aclReadHookNodeSetHealth(authorizer acl.Authorizer, authzContext *acl.AuthorizerContext, _ *pbresource.ID, _ *pbresource.Resource) error {
+	return authorizer.ToAllowAuthorizer().NodeReadAllAllowed(authzContext)
+}
+
+func aclWriteHookNodeSetHealth(authorizer acl.Authorizer, authzContext *acl.AuthorizerContext, _ *pbresource.Resource) error {
+	return authorizer.ToAllowAuthorizer().OperatorWriteAllowed(authzContext)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/hashicorp/consul/internal/resource"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+func createNodeSetHealthResource(t *testing.T, data protoreflect.ProtoMessage) *pbresource.Resource {
+	res := &pbresource.Resource{
+		Id: &pbresource.ID{
+			Type: pbcatalog.NodeSetHealthType,
+			Tenancy: &pbresource.Tenancy{
+				Partition: "default",
+				PeerName:  "local",
+			},
+			Name: "test-set",
+		},
+	}
+
+	var err error
+	res.Data, err = anypb.New(data)
+	require.NoError(t, err)
+	return res
+}
+
+func TestValidateNodeSetHealth_Ok(t *testing.T) {
+	data := &pbcatalog.NodeSetHealth{
+		Selector: &pbcatalog.NodeSelector{
+			Names:    []string{"node-1"},
+			Prefixes: []string{"rack-1-"},
+			Metadata: map[string]string{"rack": "R1"},
+		},
+	}
+
+	res := createNodeSetHealthResource(t, data)
+
+	err := ValidateNodeSetHealth(res)
+	require.NoError(t, err)
+}
+
+func TestValidateNodeSetHealth_MissingSelector(t *testing.T) {
+	res := createNodeSetHealthResource(t, &pbcatalog.NodeSetHealth{})
+
+	err := ValidateNodeSetHealth(res)
+	require.Error(t, err)
+	expected := resource.ErrInvalidField{
+		Name:    "selector",
+		Wrapped: resource.ErrMissing,
+	}
+	var actual resource.ErrInvalidField
+	require.ErrorAs(t, err, &actual)
+	require.Equal(t, expected, actual)
+}
+
+func TestValidateNodeSetHealth_EmptySelectorEntries(t *testing.T) {
+	data := &pbcatalog.NodeSetHealth{
+		Selector: &pbcatalog.NodeSelector{
+			Names:    []string{""},
+			Prefixes: []string{""},
+			Metadata: map[string]string{"": "R1"},
+		},
+	}
+
+	res := createNodeSetHealthResource(t, data)
+
+	err := ValidateNodeSetHealth(res)
+	require.Error(t, err)
+	require.ErrorContains(t, err, `invalid element at index 0 of list "names"`)
+	require.ErrorContains(t, err, `invalid element at index 0 of list "prefixes"`)
+	require.ErrorContains(t, err, `map metadata contains an invalid key`)
+}
@@ -129,6 +129,39 @@ func TestValidateNode_AddressMissingHost(t *testing.T) {
 	require.Equal(t, expected, actual)
 }
 
+func TestNodePrimaryAddress(t *testing.T) {
+	cases := map[string]struct {
+		addresses []*pbcatalog.NodeAddress
+		expected  string
+	}{
+		"first internal": {
+			addresses: []*pbcatalog.NodeAddress{
+				{Host: "foo.node.example.com", External: true},
+				{Host: "198.18.0.1"},
+				{Host: "198.18.0.2"},
+			},
+			expected: "198.18.0.1",
+		},
+		"all external": {
+			addresses: []*pbcatalog.NodeAddress{
+				{Host: "foo.node.example.com", External: true},
+				{Host: "bar.node.example.com", External: true},
+			},
+			expected: "foo.node.example.com",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			res := createNodeResource(t, &pbcatalog.Node{Addresses: tc.addresses})
+
+			value, err := nodePrimaryAddress(res)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, value.GetStringValue())
+		})
+	}
+}
+
 func TestNodeACLs(t *testing.T) {
 	registry := resource.NewRegistry()
 	Register(registry)
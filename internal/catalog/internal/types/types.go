@@ -14,6 +14,8 @@ func Register(r resource.Registry) {
 	RegisterNode(r)
 	RegisterHealthStatus(r)
 	RegisterFailoverPolicy(r)
+	RegisterNodeSetHealth(r)
+	RegisterNodeHealthSummary(r)
 
 	// todo (v2): re-register once these resources are implemented.
 	//RegisterHealthChecks(r)
@@ -4,10 +4,12 @@
 package types
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	svctest "github.com/hashicorp/consul/agent/grpc-external/services/resource/testing"
 	"github.com/hashicorp/consul/internal/resource"
 	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
 	"github.com/hashicorp/consul/proto-public/pbresource"
@@ -24,6 +26,8 @@ func TestTypeRegistration(t *testing.T) {
 		pbcatalog.ServiceEndpointsKind,
 		pbcatalog.NodeKind,
 		pbcatalog.HealthStatusKind,
+		pbcatalog.NodeSetHealthKind,
+		pbcatalog.NodeHealthSummaryKind,
 		// todo (ishustava): uncomment once we implement these
 		//pbcatalog.HealthChecksKind,
 		//pbcatalog.DNSPolicyKind,
@@ -46,3 +50,26 @@ func TestTypeRegistration(t *testing.T) {
 		})
 	}
 }
+
+func TestListTypes(t *testing.T) {
+	client := svctest.RunResourceService(t, Register, RegisterDNSPolicy)
+
+	rsp, err := client.ListTypes(context.Background(), &pbresource.ListTypesRequest{})
+	require.NoError(t, err)
+
+	listed := make(map[string]*pbresource.RegisteredType)
+	for _, typ := range rsp.Types {
+		listed[resource.ToGVK(typ.Type)] = typ
+	}
+
+	for _, typ := range []*pbresource.Type{
+		pbcatalog.NodeType,
+		pbcatalog.HealthStatusType,
+		pbcatalog.DNSPolicyType,
+	} {
+		got, ok := listed[resource.ToGVK(typ)]
+		require.True(t, ok, "%s not listed", resource.ToGVK(typ))
+		require.Equal(t, pbresource.Scope_SCOPE_NAMESPACE, got.Scope)
+		require.True(t, got.HasAclHooks)
+	}
+}
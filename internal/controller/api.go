@@ -10,9 +10,11 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-hclog"
+	"golang.org/x/time/rate"
 
 	"github.com/hashicorp/consul/agent/consul/controller/queue"
 	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/telemetry"
 	"github.com/hashicorp/consul/proto-public/pbresource"
 )
 
@@ -62,6 +64,17 @@ func (c Controller) WithCustomWatch(source *Source, mapper CustomDependencyMappe
 	return c
 }
 
+// WithName changes the name used to refer to the controller, for example when
+// pausing it via Manager.Pause.
+func (c Controller) WithName(name string) Controller {
+	if name == "" {
+		panic("name must not be empty")
+	}
+
+	c.name = name
+	return c
+}
+
 // WithLogger changes the controller's logger.
 func (c Controller) WithLogger(logger hclog.Logger) Controller {
 	if logger == nil {
@@ -80,6 +93,89 @@ func (c Controller) WithBackoff(base, max time.Duration) Controller {
 	return c
 }
 
+// WithStartupRamp spreads the reconciles of the resources that exist when the
+// controller starts running (such as after this server becomes the Raft
+// leader) evenly over the given window, rather than issuing them all at once.
+//
+// Until a resource's ramped reconcile has happened, further events for it are
+// coalesced into that reconcile, so changes made during the window may take up
+// to the window to be reconciled.
+func (c Controller) WithStartupRamp(window time.Duration) Controller {
+	c.startupRamp = window
+	return c
+}
+
+// WithReconcileAfter declares that the controller's reconciles should be
+// ordered after those of the controllers managing the given types, e.g. so that
+// a policy is reconciled before the resources it affects, avoiding redundant
+// reconciles of the dependent resources.
+//
+// While the controllers of the given types haven't loaded their initial
+// snapshot or have outstanding requests (including retries that are due),
+// the controller's requests are put back on its queue rather than reconciled,
+// for up to reconcileAfterMaxWait. Declared orderings must not be circular,
+// Manager.Register panics otherwise.
+func (c Controller) WithReconcileAfter(types ...*pbresource.Type) Controller {
+	c.reconcileAfter = append(c.reconcileAfter, types...)
+	return c
+}
+
+// WithStatusWriteBatching buffers the status writes made by the controller's
+// reconciler and flushes them to the resource service at most the given window
+// later, in BatchWriteStatus calls, coalescing repeated writes to the same
+// status so that only the latest is made. This reduces write pressure when many
+// resources are reconciled in a short burst, such as after a leader election.
+//
+// The reconciler's WriteStatus calls return once the write has been buffered,
+// with the resource as it will be once flushed. Resources it reads have its
+// buffered statuses applied, so that comparing against the existing status to
+// skip no-op writes continues to work. Writes that fail when flushed are logged
+// and the resource is requeued for reconciliation. Writes still buffered when
+// the controller stops are flushed, and those that fail are logged.
+func (c Controller) WithStatusWriteBatching(window time.Duration) Controller {
+	c.statusBatchWindow = window
+	return c
+}
+
+// WithReadCache enables Runtime.CacheRead to serve the reconciler's reads of
+// the controller's managed and watched types from the controller's watches
+// rather than the resource service.
+func (c Controller) WithReadCache() Controller {
+	c.readCache = true
+	return c
+}
+
+// WithWorkers sets the number of reconciles of the controller's managed type
+// that may run concurrently. Each controller has its own pool of workers, so
+// a slow controller can't hold up the reconciles of the others registered with
+// the same Manager. A resource is never reconciled by more than one worker at
+// a time. The default is a single worker.
+func (c Controller) WithWorkers(workers int) Controller {
+	if workers < 1 {
+		panic("workers must be at least 1")
+	}
+	c.workers = workers
+	return c
+}
+
+// WithRateLimit caps the rate at which the controller's managed type is
+// reconciled at limit reconciles per second, with bursts of up to burst, to
+// protect the backend from bursts of reconciles. The limit is shared by all of
+// the controller's workers, so raising the number of workers doesn't raise it.
+// Requests beyond the limit wait in the queue rather than being dropped. There
+// is no limit by default.
+func (c Controller) WithRateLimit(limit rate.Limit, burst int) Controller {
+	if limit <= 0 {
+		panic("limit must be positive")
+	}
+	if burst < 1 {
+		panic("burst must be at least 1")
+	}
+	c.rateLimit = limit
+	c.rateBurst = burst
+	return c
+}
+
 // WithPlacement changes where and how many replicas of the controller will run.
 // In the majority of cases, the default placement (one leader elected instance
 // per cluster) is the most appropriate and you shouldn't need to override it.
@@ -104,14 +200,23 @@ func (c Controller) String() string {
 	)
 }
 
+// Name returns the name of the controller. Unless one was given using WithName,
+// it defaults to the GVK of the managed type.
+func (c Controller) Name() string {
+	if c.name != "" {
+		return c.name
+	}
+	return resource.ToGVK(c.managedType)
+}
+
 func (c Controller) backoff() (time.Duration, time.Duration) {
 	base := c.baseBackoff
 	if base == 0 {
-		base = 5 * time.Millisecond
+		base = DefaultRetryPolicy.BaseBackoff
 	}
 	max := c.maxBackoff
 	if max == 0 {
-		max = 1000 * time.Second
+		max = DefaultRetryPolicy.MaxBackoff
 	}
 	return base, max
 }
@@ -123,14 +228,22 @@ func (c Controller) backoff() (time.Duration, time.Duration) {
 // Use the builder methods in this package (starting with ForType) to construct
 // a controller, and then pass it to a Manager to be executed.
 type Controller struct {
-	managedType   *pbresource.Type
-	reconciler    Reconciler
-	logger        hclog.Logger
-	watches       []watch
-	customWatches []customWatch
-	baseBackoff   time.Duration
-	maxBackoff    time.Duration
-	placement     Placement
+	name              string
+	managedType       *pbresource.Type
+	reconciler        Reconciler
+	logger            hclog.Logger
+	watches           []watch
+	customWatches     []customWatch
+	baseBackoff       time.Duration
+	maxBackoff        time.Duration
+	startupRamp       time.Duration
+	placement         Placement
+	reconcileAfter    []*pbresource.Type
+	statusBatchWindow time.Duration
+	workers           int
+	rateLimit         rate.Limit
+	rateBurst         int
+	readCache         bool
 }
 
 type watch struct {
@@ -200,6 +313,15 @@ func (r Request) Key() string {
 type Runtime struct {
 	Client pbresource.ResourceServiceClient
 	Logger hclog.Logger
+
+	// Metrics is where reconcilers emit their metrics. When nil, metrics should
+	// be emitted to telemetry.Default.
+	Metrics telemetry.Sink
+
+	// cache serves CacheRead, and is nil when reads aren't cached. overlay, when
+	// non-nil, is applied to the resources read from it.
+	cache   *ResourceCache
+	overlay func(*pbresource.Resource) *pbresource.Resource
 }
 
 // Reconciler implements the business logic of a controller.
@@ -240,6 +362,10 @@ func (p Placement) String() string {
 // exponential backoff behavior of the Controller, rather than applying
 // the backoff algorithm, returning a RequeueAfterError will cause the
 // Controller to reschedule the Request at a given time in the future.
+//
+// A requeue isn't treated as a failure: it resets the Request's backoff and is
+// reported as ReconcileResultRequeue. The error may be wrapped, e.g. with
+// fmt.Errorf and %w.
 type RequeueAfterError time.Duration
 
 // Error implements the error interface.
@@ -6,14 +6,25 @@ package controller_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 
 	svctest "github.com/hashicorp/consul/agent/grpc-external/services/resource/testing"
 	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/resource"
 	"github.com/hashicorp/consul/internal/resource/demo"
+	"github.com/hashicorp/consul/internal/telemetry"
 	"github.com/hashicorp/consul/proto-public/pbresource"
 	"github.com/hashicorp/consul/proto/private/prototest"
 	"github.com/hashicorp/consul/sdk/testutil"
@@ -219,6 +230,987 @@ func TestController_Placement(t *testing.T) {
 	})
 }
 
+func TestController_LeadershipTransitions(t *testing.T) {
+	t.Parallel()
+
+	rec := newTestReconciler()
+	client := &watchCounter{ResourceServiceClient: svctest.RunResourceService(t, demo.RegisterTypes)}
+
+	mgr := controller.NewManager(client, testutil.Logger(t))
+	mgr.Register(controller.ForType(demo.TypeV2Artist).WithReconciler(rec))
+	mgr.SetRaftLeader(true)
+	go mgr.Run(testContext(t))
+
+	writeArtist := func() *pbresource.ID {
+		artist, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+		require.NoError(t, err)
+		return rsp.Resource.Id
+	}
+	ids := []*pbresource.ID{writeArtist()}
+	prototest.AssertDeepEqual(t, ids[0], rec.wait(t).ID)
+
+	for i := 2; i <= 3; i++ {
+		// Reconciles stop when leadership is lost, even for new writes.
+		mgr.SetRaftLeader(false)
+		ids = append(ids, writeArtist())
+		rec.expectNoRequest(t, 500*time.Millisecond)
+
+		// They resume when it's regained, from a fresh watch that re-lists both
+		// the artists written before the transition and during it. Restarting
+		// the controller and re-listing can take a while on a loaded machine, so
+		// allow more time than for a single reconcile.
+		mgr.SetRaftLeader(true)
+		var reconciled []*pbresource.ID
+		for range ids {
+			reconciled = append(reconciled, rec.waitFor(t, 5*time.Second).ID)
+		}
+		prototest.AssertElementsMatch(t, ids, reconciled)
+		rec.expectNoRequest(t, 100*time.Millisecond)
+		require.Equal(t, int64(i), client.watches.Load())
+	}
+}
+
+func TestController_StartupRamp(t *testing.T) {
+	t.Parallel()
+
+	rec := newTestReconciler()
+	client := svctest.RunResourceService(t, demo.RegisterTypes)
+
+	const (
+		numArtists = 5
+		window     = time.Second
+	)
+	for i := 0; i < numArtists; i++ {
+		res, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		res.Id.Name = fmt.Sprintf("artist-%d", i)
+
+		_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: res})
+		require.NoError(t, err)
+	}
+
+	ctrl := controller.
+		ForType(demo.TypeV2Artist).
+		WithWatch(demo.TypeV2Album, controller.MapOwner).
+		WithStartupRamp(window).
+		WithReconciler(rec)
+
+	mgr := controller.NewManager(client, testutil.Logger(t))
+	mgr.Register(ctrl)
+	go mgr.Run(testContext(t))
+
+	// Becoming the leader triggers the initial reconcile of every artist.
+	mgr.SetRaftLeader(true)
+
+	seen := make(map[string]time.Time)
+	for len(seen) < numArtists {
+		select {
+		case req := <-rec.calls:
+			require.NotContains(t, seen, req.ID.Name, "artist reconciled more than once")
+			seen[req.ID.Name] = time.Now()
+		case <-time.After(2 * window):
+			t.Fatalf("only %d of %d artists were reconciled", len(seen), numArtists)
+		}
+	}
+
+	// The reconciles are paced across the window rather than bursted.
+	var first, last time.Time
+	for _, at := range seen {
+		if first.IsZero() || at.Before(first) {
+			first = at
+		}
+		if at.After(last) {
+			last = at
+		}
+	}
+	require.GreaterOrEqual(t, last.Sub(first), window/2)
+
+	// Once the ramp is over, new resources are reconciled straight away.
+	res, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: res})
+	require.NoError(t, err)
+	_ = rec.wait(t)
+}
+
+func TestController_ReconcileEvents(t *testing.T) {
+	t.Parallel()
+
+	rec := newTestReconciler()
+	client := svctest.RunResourceService(t, demo.RegisterTypes)
+
+	ctrl := controller.
+		ForType(demo.TypeV2Artist).
+		WithName("reconcile-events").
+		// Use a long backoff so that failed reconciles aren't retried during the test.
+		WithBackoff(time.Hour, time.Hour).
+		WithReconciler(rec)
+
+	mgr := controller.NewManager(client, testutil.Logger(t))
+	mgr.Register(ctrl)
+	mgr.SetRaftLeader(true)
+
+	require.ErrorContains(t, mgr.WatchReconcileEvents(testContext(t), "unknown", nil), "no controller registered")
+
+	events := make(chan controller.ReconcileEvent, 10)
+	go mgr.WatchReconcileEvents(testContext(t), ctrl.Name(), func(event controller.ReconcileEvent) error {
+		events <- event
+		return nil
+	})
+	go mgr.Run(testContext(t))
+
+	reconcile := func(t *testing.T) (*pbresource.ID, controller.ReconcileEvent) {
+		res, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: res})
+		require.NoError(t, err)
+		_ = rec.wait(t)
+
+		select {
+		case event := <-events:
+			return rsp.Resource.Id, event
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("no reconcile event was emitted after 500ms")
+			return nil, controller.ReconcileEvent{}
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		id, event := reconcile(t)
+		require.Equal(t, ctrl.Name(), event.Controller)
+		prototest.AssertDeepEqual(t, id, event.ID)
+		require.Equal(t, controller.ReconcileResultSuccess, event.Result)
+		require.NoError(t, event.Err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		rec.failNext(errors.New("KABOOM"))
+		id, event := reconcile(t)
+		prototest.AssertDeepEqual(t, id, event.ID)
+		require.Equal(t, controller.ReconcileResultError, event.Result)
+		require.EqualError(t, event.Err, "KABOOM")
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		rec.panicNext("KABOOM")
+		_, event := reconcile(t)
+		require.Equal(t, controller.ReconcileResultError, event.Result)
+		require.ErrorContains(t, event.Err, "panic [recovered]")
+	})
+
+	t.Run("requeue", func(t *testing.T) {
+		rec.failNext(controller.RequeueAfter(time.Hour))
+		_, event := reconcile(t)
+		require.Equal(t, controller.ReconcileResultRequeue, event.Result)
+	})
+}
+
+func TestController_OTelMetrics(t *testing.T) {
+	t.Parallel()
+
+	rec := newTestReconciler()
+	client := svctest.RunResourceService(t, demo.RegisterTypes)
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	ctrl := controller.
+		ForType(demo.TypeV2Artist).
+		WithName("otel-metrics").
+		WithReconciler(rec)
+
+	mgr := controller.NewManager(client, testutil.Logger(t))
+	mgr.SetMetrics(telemetry.NewOTelSink(provider))
+	mgr.Register(ctrl)
+	mgr.SetRaftLeader(true)
+	go mgr.Run(testContext(t))
+
+	res, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: res})
+	require.NoError(t, err)
+	_ = rec.wait(t)
+
+	// The latency is recorded once the reconcile has returned.
+	var histogram metricdata.Histogram[float64]
+	require.Eventually(t, func() bool {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(testContext(t), &rm))
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				if m.Name == "consul.controller.reconcile" {
+					histogram = m.Data.(metricdata.Histogram[float64])
+					return true
+				}
+			}
+		}
+		return false
+	}, 5*time.Second, 50*time.Millisecond)
+
+	require.Len(t, histogram.DataPoints, 1)
+	point := histogram.DataPoints[0]
+	require.Equal(t, uint64(1), point.Count)
+
+	name, _ := point.Attributes.Value("controller")
+	require.Equal(t, ctrl.Name(), name.AsString())
+	result, _ := point.Attributes.Value("result")
+	require.Equal(t, controller.ReconcileResultSuccess.String(), result.AsString())
+}
+
+func TestController_Metrics(t *testing.T) {
+	t.Parallel()
+
+	rec := newTestReconciler()
+	client := svctest.RunResourceService(t, demo.RegisterTypes)
+	sink := newTestSink()
+
+	ctrl := controller.
+		ForType(demo.TypeV2Artist).
+		WithName("metrics").
+		WithBackoff(time.Hour, time.Hour).
+		WithReconciler(rec)
+
+	mgr := controller.NewManager(client, testutil.Logger(t), controller.WithMetricLabels(metrics.Label{Name: "manager", Value: "test"}))
+	mgr.SetMetrics(sink)
+	mgr.Register(ctrl)
+	mgr.SetRaftLeader(true)
+	go mgr.Run(testContext(t))
+
+	labels := func(result controller.ReconcileResult) []metrics.Label {
+		return []metrics.Label{
+			{Name: "manager", Value: "test"},
+			{Name: "controller", Value: ctrl.Name()},
+			{Name: "result", Value: result.String()},
+		}
+	}
+
+	res, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: res})
+	require.NoError(t, err)
+	_ = rec.wait(t)
+
+	// The timer and outcome counter are recorded once the reconcile has returned.
+	success := labels(controller.ReconcileResultSuccess)
+	require.Eventually(t, func() bool {
+		return sink.counter("controller.reconcile.outcome", success) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, 1, sink.timings("controller.reconcile", success))
+
+	// Failed reconciles are counted separately.
+	rec.failNext(errors.New("KABOOM"))
+	_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: res})
+	require.NoError(t, err)
+	_ = rec.wait(t)
+
+	failure := labels(controller.ReconcileResultError)
+	require.Eventually(t, func() bool {
+		return sink.counter("controller.reconcile.outcome", failure) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, 1, sink.timings("controller.reconcile", failure))
+	require.Equal(t, float32(1), sink.counter("controller.reconcile.outcome", success))
+}
+
+// testSink is a telemetry.Sink that records the metrics emitted to it, keyed
+// by name and labels.
+type testSink struct {
+	mu       sync.Mutex
+	measures map[string]int
+	counters map[string]float32
+}
+
+func newTestSink() *testSink {
+	return &testSink{
+		measures: make(map[string]int),
+		counters: make(map[string]float32),
+	}
+}
+
+func (s *testSink) MeasureSince(key []string, _ time.Time, labels []metrics.Label) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.measures[testSinkKey(strings.Join(key, "."), labels)]++
+}
+
+func (s *testSink) IncrCounter(key []string, val float32, labels []metrics.Label) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters[testSinkKey(strings.Join(key, "."), labels)] += val
+}
+
+func (s *testSink) SetGauge(key []string, val float32, labels []metrics.Label) {}
+
+func (s *testSink) timings(name string, labels []metrics.Label) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.measures[testSinkKey(name, labels)]
+}
+
+func (s *testSink) counter(name string, labels []metrics.Label) float32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.counters[testSinkKey(name, labels)]
+}
+
+func testSinkKey(name string, labels []metrics.Label) string {
+	return fmt.Sprintf("%s%v", name, labels)
+}
+
+func TestController_ReconcileAfter(t *testing.T) {
+	t.Parallel()
+
+	client := svctest.RunResourceService(t, demo.RegisterTypes)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+	artist = rsp.Resource
+
+	const numAlbums = 3
+	for i := 0; i < numAlbums; i++ {
+		album, err := demo.GenerateV2Album(artist.Id)
+		require.NoError(t, err)
+		album.Id.Name = fmt.Sprintf("album-%d", i)
+		_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: album})
+		require.NoError(t, err)
+	}
+
+	order := make(chan string, numAlbums+1)
+
+	// The album controller is registered first and the artist reconciler is
+	// slow, so without the declared ordering the albums would be reconciled
+	// first.
+	mgr := controller.NewManager(client, testutil.Logger(t))
+	mgr.Register(controller.
+		ForType(demo.TypeV2Album).
+		WithReconcileAfter(demo.TypeV2Artist).
+		WithReconciler(&orderRecorder{order: order}))
+	mgr.Register(controller.
+		ForType(demo.TypeV2Artist).
+		WithReconciler(&orderRecorder{order: order, delay: 100 * time.Millisecond}))
+	go mgr.Run(testContext(t))
+
+	// Becoming the leader reconciles the existing artist and albums together.
+	mgr.SetRaftLeader(true)
+
+	var got []string
+	for len(got) < numAlbums+1 {
+		select {
+		case kind := <-order:
+			got = append(got, kind)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("only %d of %d resources were reconciled", len(got), numAlbums+1)
+		}
+	}
+	require.Equal(t, demo.TypeV2Artist.Kind, got[0], "reconcile order: %v", got)
+}
+
+func TestController_ReconcileAfter_Retry(t *testing.T) {
+	t.Parallel()
+
+	client := svctest.RunResourceService(t, demo.RegisterTypes)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+	artist = rsp.Resource
+
+	album, err := demo.GenerateV2Album(artist.Id)
+	require.NoError(t, err)
+	_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: album})
+	require.NoError(t, err)
+
+	order := make(chan string, 2)
+
+	// The artist's first reconcile fails, so the album must wait for it to be
+	// retried rather than only for it to be taken from the queue.
+	mgr := controller.NewManager(client, testutil.Logger(t))
+	mgr.Register(controller.
+		ForType(demo.TypeV2Album).
+		WithReconcileAfter(demo.TypeV2Artist).
+		WithReconciler(&orderRecorder{order: order}))
+	mgr.Register(controller.
+		ForType(demo.TypeV2Artist).
+		WithBackoff(50*time.Millisecond, 100*time.Millisecond).
+		WithReconciler(&orderRecorder{order: order, failures: 1}))
+	go mgr.Run(testContext(t))
+
+	mgr.SetRaftLeader(true)
+
+	var got []string
+	for len(got) < 2 {
+		select {
+		case kind := <-order:
+			got = append(got, kind)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("only %d of 2 resources were reconciled", len(got))
+		}
+	}
+	require.Equal(t, []string{demo.TypeV2Artist.Kind, demo.TypeV2Album.Kind}, got)
+}
+
+func TestManager_Register_CircularReconcileAfter(t *testing.T) {
+	mgr := controller.NewManager(nil, testutil.Logger(t))
+	mgr.Register(controller.
+		ForType(demo.TypeV2Album).
+		WithReconcileAfter(demo.TypeV2Artist).
+		WithReconciler(&orderRecorder{}))
+
+	require.Panics(t, func() {
+		mgr.Register(controller.
+			ForType(demo.TypeV2Artist).
+			WithReconcileAfter(demo.TypeV2Album).
+			WithReconciler(&orderRecorder{}))
+	})
+}
+
+// orderRecorder is a Reconciler that sends the kind of each resource it
+// reconciles to order, after the given delay. Its first failures reconciles
+// fail instead.
+type orderRecorder struct {
+	order    chan<- string
+	delay    time.Duration
+	failures int32
+}
+
+func (r *orderRecorder) Reconcile(_ context.Context, _ controller.Runtime, req controller.Request) error {
+	time.Sleep(r.delay)
+	if atomic.AddInt32(&r.failures, -1) >= 0 {
+		return errors.New("reconcile failed")
+	}
+	r.order <- req.ID.Type.Kind
+	return nil
+}
+
+func TestController_StatusWriteBatching(t *testing.T) {
+	t.Parallel()
+
+	client := &statusWriteCounter{ResourceServiceClient: svctest.RunResourceService(t, demo.RegisterTypes)}
+	rec := &statusWriter{}
+
+	mgr := controller.NewManager(client, testutil.Logger(t))
+	mgr.Register(controller.
+		ForType(demo.TypeV2Artist).
+		WithStatusWriteBatching(2 * time.Second).
+		WithReconciler(rec))
+	mgr.SetRaftLeader(true)
+	go mgr.Run(testContext(t))
+
+	// Create the artists, then update each of them twice. Every change is
+	// reconciled and written by the reconciler within the batching window.
+	const numArtists = 20
+	artists := make([]*pbresource.Resource, numArtists)
+	for round := 0; round < 3; round++ {
+		for i := range artists {
+			artist, err := demo.GenerateV2Artist()
+			require.NoError(t, err)
+			if round == 0 {
+				artist.Id.Name = fmt.Sprintf("artist-%d", i)
+			} else {
+				artist.Id = artists[i].Id
+			}
+			rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+			require.NoError(t, err)
+			artists[i] = rsp.Resource
+		}
+
+		expected := int64(numArtists * (round + 1))
+		require.Eventually(t, func() bool {
+			return rec.writes.Load() == expected
+		}, time.Second, 10*time.Millisecond)
+	}
+
+	// Each artist ends up with the status for its latest generation.
+	for _, artist := range artists {
+		require.Eventually(t, func() bool {
+			rsp, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id})
+			require.NoError(t, err)
+			status, ok := rsp.Resource.Status[statusWriterKey]
+			return ok && status.ObservedGeneration == artist.Generation
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+
+	// The writes were coalesced into fewer than the reconciler made, and
+	// made in fewer calls still.
+	require.Less(t, client.writes.Load(), rec.writes.Load())
+	require.GreaterOrEqual(t, client.writes.Load(), int64(numArtists))
+	require.Less(t, client.calls.Load(), int64(numArtists))
+}
+
+func TestController_StatusWriteBatching_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	client := &statusWriteCounter{ResourceServiceClient: svctest.RunResourceService(t, demo.RegisterTypes)}
+	rec := &statusWriter{}
+
+	// The window is long enough that the write is only flushed because the
+	// controller stops.
+	mgr := controller.NewManager(client, testutil.Logger(t))
+	mgr.Register(controller.
+		ForType(demo.TypeV2Artist).
+		WithStatusWriteBatching(time.Hour).
+		WithReconciler(rec))
+	mgr.SetRaftLeader(true)
+	go mgr.Run(testContext(t))
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+	artist = rsp.Resource
+
+	require.Eventually(t, func() bool {
+		return rec.writes.Load() == 1
+	}, time.Second, 10*time.Millisecond)
+	require.Zero(t, client.writes.Load())
+
+	// WriteStatus returned the resource with the buffered status applied.
+	written := rec.written.Load()
+	require.NotNil(t, written)
+	require.Equal(t, artist.Generation, written.Status[statusWriterKey].GetObservedGeneration())
+
+	mgr.SetRaftLeader(false)
+
+	require.Eventually(t, func() bool {
+		rsp, err := client.Read(testContext(t), &pbresource.ReadRequest{Id: artist.Id})
+		require.NoError(t, err)
+		return rsp.Resource.Status[statusWriterKey].GetObservedGeneration() == artist.Generation
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+const statusWriterKey = "consul.io/status-writer"
+
+// statusWriter is a Reconciler that writes a status observing the resource's
+// generation, unless it has already been written. It records the resource
+// returned by the last write.
+type statusWriter struct {
+	writes  atomic.Int64
+	written atomic.Pointer[pbresource.Resource]
+}
+
+func (r *statusWriter) Reconcile(ctx context.Context, rt controller.Runtime, req controller.Request) error {
+	rsp, err := rt.Client.Read(ctx, &pbresource.ReadRequest{Id: req.ID})
+	if err != nil {
+		return err
+	}
+
+	newStatus := &pbresource.Status{
+		ObservedGeneration: rsp.Resource.Generation,
+		Conditions: []*pbresource.Condition{
+			{Type: "Written", State: pbresource.Condition_STATE_TRUE},
+		},
+	}
+	if resource.EqualStatus(rsp.Resource.Status[statusWriterKey], newStatus, false) {
+		return nil
+	}
+
+	r.writes.Add(1)
+	written, err := rt.Client.WriteStatus(ctx, &pbresource.WriteStatusRequest{
+		Id:     rsp.Resource.Id,
+		Key:    statusWriterKey,
+		Status: newStatus,
+	})
+	if err != nil {
+		return err
+	}
+	r.written.Store(written.Resource)
+	return nil
+}
+
+// statusWriteCounter counts the status writes, and the calls making them, that
+// reach the resource service.
+type statusWriteCounter struct {
+	pbresource.ResourceServiceClient
+	writes atomic.Int64
+	calls  atomic.Int64
+}
+
+func (c *statusWriteCounter) WriteStatus(ctx context.Context, in *pbresource.WriteStatusRequest, opts ...grpc.CallOption) (*pbresource.WriteStatusResponse, error) {
+	c.writes.Add(1)
+	c.calls.Add(1)
+	return c.ResourceServiceClient.WriteStatus(ctx, in, opts...)
+}
+
+func (c *statusWriteCounter) BatchWriteStatus(ctx context.Context, in *pbresource.BatchWriteStatusRequest, opts ...grpc.CallOption) (*pbresource.BatchWriteStatusResponse, error) {
+	c.writes.Add(int64(len(in.Requests)))
+	c.calls.Add(1)
+	return c.ResourceServiceClient.BatchWriteStatus(ctx, in, opts...)
+}
+
+// watchCounter counts the watches opened against the resource service.
+type watchCounter struct {
+	pbresource.ResourceServiceClient
+	watches atomic.Int64
+}
+
+func (c *watchCounter) WatchList(ctx context.Context, in *pbresource.WatchListRequest, opts ...grpc.CallOption) (pbresource.ResourceService_WatchListClient, error) {
+	c.watches.Add(1)
+	return c.ResourceServiceClient.WatchList(ctx, in, opts...)
+}
+
+// readCounter counts the reads that reach the resource service.
+type readCounter struct {
+	pbresource.ResourceServiceClient
+	reads atomic.Int64
+}
+
+func (c *readCounter) Read(ctx context.Context, in *pbresource.ReadRequest, opts ...grpc.CallOption) (*pbresource.ReadResponse, error) {
+	c.reads.Add(1)
+	return c.ResourceServiceClient.Read(ctx, in, opts...)
+}
+
+// cacheReader is a Reconciler that reads the resource using CacheRead.
+type cacheReader struct {
+	consistency pbresource.Consistency
+	reads       chan *pbresource.Resource
+}
+
+func (r *cacheReader) Reconcile(ctx context.Context, rt controller.Runtime, req controller.Request) error {
+	rsp, err := rt.CacheRead(ctx, &pbresource.ReadRequest{Id: req.ID, Consistency: r.consistency})
+	if err != nil {
+		return err
+	}
+	r.reads <- rsp.Resource
+	return nil
+}
+
+func TestRuntime_CacheRead(t *testing.T) {
+	t.Parallel()
+
+	client := &readCounter{ResourceServiceClient: svctest.RunResourceService(t, demo.RegisterTypes)}
+	ctx := testContext(t)
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	rsp, err := client.Write(ctx, &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+	artist = rsp.Resource
+
+	cache := controller.NewResourceCache()
+	rt := controller.RuntimeWithCache(controller.Runtime{Client: client}, cache)
+
+	// Misses fall through to the resource service.
+	read, err := rt.CacheRead(ctx, &pbresource.ReadRequest{Id: artist.Id})
+	require.NoError(t, err)
+	prototest.AssertDeepEqual(t, artist, read.Resource)
+	require.Equal(t, int64(1), client.reads.Load())
+
+	cache.Upsert(artist)
+	read, err = rt.CacheRead(ctx, &pbresource.ReadRequest{Id: artist.Id})
+	require.NoError(t, err)
+	prototest.AssertDeepEqual(t, artist, read.Resource)
+	require.Equal(t, int64(1), client.reads.Load())
+
+	// Leader consistent reads aren't served from the cache.
+	_, err = rt.CacheRead(ctx, &pbresource.ReadRequest{Id: artist.Id, Consistency: pbresource.Consistency_CONSISTENCY_LEADER})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), client.reads.Load())
+
+	// Neither are reads of another incarnation of the resource.
+	other := proto.Clone(artist.Id).(*pbresource.ID)
+	other.Uid = "other"
+	_, err = rt.CacheRead(ctx, &pbresource.ReadRequest{Id: other})
+	require.Error(t, err)
+	require.Equal(t, int64(3), client.reads.Load())
+
+	// Without a cache every read falls through.
+	_, err = controller.Runtime{Client: client}.CacheRead(ctx, &pbresource.ReadRequest{Id: artist.Id})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), client.reads.Load())
+}
+
+func TestController_ReadCache(t *testing.T) {
+	t.Parallel()
+
+	client := &readCounter{ResourceServiceClient: svctest.RunResourceService(t, demo.RegisterTypes)}
+	rec := &cacheReader{reads: make(chan *pbresource.Resource)}
+
+	mgr := controller.NewManager(client, testutil.Logger(t))
+	mgr.Register(controller.ForType(demo.TypeV2Artist).WithReadCache().WithReconciler(rec))
+	mgr.SetRaftLeader(true)
+	go mgr.Run(testContext(t))
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+
+	// The resource is read from the controller's watch.
+	select {
+	case res := <-rec.reads:
+		prototest.AssertDeepEqual(t, rsp.Resource, res)
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("Reconcile was not called after 500ms")
+	}
+	require.Equal(t, int64(0), client.reads.Load())
+}
+
+func TestController_EnqueueType(t *testing.T) {
+	t.Parallel()
+
+	rec := newTestReconciler()
+	client := svctest.RunResourceService(t, demo.RegisterTypes)
+
+	mgr := controller.NewManager(client, testutil.Logger(t))
+	labelRec := newTestReconciler()
+	mgr.Register(controller.ForType(demo.TypeV2Artist).WithReconciler(rec))
+	mgr.Register(controller.ForType(demo.TypeV1RecordLabel).WithReconciler(labelRec))
+	require.Error(t, mgr.EnqueueType(testContext(t), demo.TypeV2Album))
+
+	mgr.SetRaftLeader(true)
+	go mgr.Run(testContext(t))
+
+	artist, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+	require.NoError(t, err)
+
+	req := rec.wait(t)
+	prototest.AssertDeepEqual(t, rsp.Resource.Id, req.ID)
+	rec.expectNoRequest(t, 100*time.Millisecond)
+
+	// Enqueueing the type reconciles every artist again.
+	require.NoError(t, mgr.EnqueueType(testContext(t), demo.TypeV2Artist))
+	req = rec.wait(t)
+	prototest.AssertDeepEqual(t, rsp.Resource.Id, req.ID)
+	rec.expectNoRequest(t, 100*time.Millisecond)
+
+	// Partition scoped types are listed too.
+	label, err := demo.GenerateV1RecordLabel("label")
+	require.NoError(t, err)
+	rsp, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: label})
+	require.NoError(t, err)
+	prototest.AssertDeepEqual(t, rsp.Resource.Id, labelRec.wait(t).ID)
+
+	require.NoError(t, mgr.EnqueueType(testContext(t), demo.TypeV1RecordLabel))
+	prototest.AssertDeepEqual(t, rsp.Resource.Id, labelRec.wait(t).ID)
+	labelRec.expectNoRequest(t, 100*time.Millisecond)
+}
+
+func TestController_Workers(t *testing.T) {
+	t.Parallel()
+
+	client := svctest.RunResourceService(t, demo.RegisterTypes)
+
+	blocking := &blockingReconciler{
+		started: make(chan controller.Request),
+		release: make(chan struct{}),
+	}
+	t.Cleanup(func() { close(blocking.release) })
+	labelRec := newTestReconciler()
+
+	mgr := controller.NewManager(client, testutil.Logger(t))
+	mgr.Register(controller.ForType(demo.TypeV2Artist).WithReconciler(blocking).WithWorkers(2))
+	mgr.Register(controller.ForType(demo.TypeV1RecordLabel).WithReconciler(labelRec))
+	mgr.SetRaftLeader(true)
+	go mgr.Run(testContext(t))
+
+	for i := 0; i < 2; i++ {
+		artist, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: artist})
+		require.NoError(t, err)
+	}
+
+	// Both of the artist controller's workers are busy at once...
+	for i := 0; i < 2; i++ {
+		select {
+		case <-blocking.started:
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("expected both artists to be reconciled concurrently")
+		}
+	}
+
+	// ...without delaying the reconciles of the record label controller.
+	label, err := demo.GenerateV1RecordLabel("label")
+	require.NoError(t, err)
+	rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: label})
+	require.NoError(t, err)
+	prototest.AssertDeepEqual(t, rsp.Resource.Id, labelRec.wait(t).ID)
+
+	require.Panics(t, func() {
+		controller.ForType(demo.TypeV2Artist).WithWorkers(0)
+	})
+}
+
+func TestController_RetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	rec := newTestReconciler()
+	client := svctest.RunResourceService(t, demo.RegisterTypes)
+
+	ctrl := controller.
+		ForType(demo.TypeV2Artist).
+		WithReconciler(rec)
+
+	const base = 20 * time.Millisecond
+	mgr := controller.NewManager(client, testutil.Logger(t), controller.WithRetryPolicy(controller.RetryPolicy{
+		BaseBackoff: base,
+		MaxBackoff:  time.Second,
+	}))
+	mgr.Register(ctrl)
+	mgr.SetRaftLeader(true)
+	go mgr.Run(testContext(t))
+
+	res, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+
+	rec.failNext(errors.New("KABOOM"))
+	_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: res})
+	require.NoError(t, err)
+	_ = rec.wait(t)
+	last := time.Now()
+
+	// Each consecutive failure doubles the delay before the next retry.
+	var prev time.Duration
+	for i := 0; i < 4; i++ {
+		rec.failNext(errors.New("KABOOM"))
+		_ = rec.wait(t)
+
+		delay := time.Since(last)
+		last = time.Now()
+		if i > 0 {
+			require.Greater(t, delay, prev)
+		}
+		require.GreaterOrEqual(t, delay, base<<i)
+		prev = delay
+	}
+}
+
+func TestController_RequeueAfter(t *testing.T) {
+	t.Parallel()
+
+	rec := newTestReconciler()
+	client := svctest.RunResourceService(t, demo.RegisterTypes)
+
+	ctrl := controller.
+		ForType(demo.TypeV2Artist).
+		WithReconciler(rec)
+
+	const base = 20 * time.Millisecond
+	mgr := controller.NewManager(client, testutil.Logger(t), controller.WithRetryPolicy(controller.RetryPolicy{
+		BaseBackoff: base,
+		MaxBackoff:  time.Minute,
+	}))
+	mgr.Register(ctrl)
+	mgr.SetRaftLeader(true)
+	go mgr.Run(testContext(t))
+
+	res, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+
+	// Build up the request's backoff with a few consecutive failures.
+	rec.failNext(errors.New("KABOOM"))
+	_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: res})
+	require.NoError(t, err)
+	_ = rec.wait(t)
+	for i := 0; i < 3; i++ {
+		rec.failNext(errors.New("KABOOM"))
+		_ = rec.wait(t)
+	}
+
+	// A (wrapped) requeue is scheduled after its own duration rather than the
+	// next backoff.
+	const after = 100 * time.Millisecond
+	rec.failNext(fmt.Errorf("check ttl: %w", controller.RequeueAfter(after)))
+	_ = rec.wait(t)
+	last := time.Now()
+
+	rec.failNext(errors.New("KABOOM"))
+	_ = rec.wait(t)
+	require.GreaterOrEqual(t, time.Since(last), after)
+	last = time.Now()
+
+	// The requeue reset the backoff, so the failure is retried after the base
+	// delay again.
+	_ = rec.wait(t)
+	require.Less(t, time.Since(last), 8*base)
+}
+
+func TestController_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	rec := newTestReconciler()
+	client := svctest.RunResourceService(t, demo.RegisterTypes)
+
+	const (
+		numArtists = 6
+		limit      = 10
+	)
+	for i := 0; i < numArtists; i++ {
+		res, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		res.Id.Name = fmt.Sprintf("artist-%d", i)
+
+		_, err = client.Write(testContext(t), &pbresource.WriteRequest{Resource: res})
+		require.NoError(t, err)
+	}
+
+	// Idle workers don't raise the limit.
+	ctrl := controller.
+		ForType(demo.TypeV2Artist).
+		WithWorkers(numArtists).
+		WithRateLimit(limit, 1).
+		WithReconciler(rec)
+
+	mgr := controller.NewManager(client, testutil.Logger(t))
+	mgr.Register(ctrl)
+	go mgr.Run(testContext(t))
+
+	// Becoming the leader enqueues the reconcile of every artist at once.
+	mgr.SetRaftLeader(true)
+
+	var first, last time.Time
+	seen := make(map[string]struct{})
+	for len(seen) < numArtists {
+		select {
+		case req := <-rec.calls:
+			seen[req.ID.Name] = struct{}{}
+			last = time.Now()
+			if first.IsZero() {
+				first = last
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("only %d of %d artists were reconciled", len(seen), numArtists)
+		}
+	}
+
+	// The reconciles are paced to the limit rather than bursted, with a little
+	// slack for timer resolution.
+	expected := time.Duration(numArtists-1) * time.Second / limit
+	require.GreaterOrEqual(t, last.Sub(first), expected*9/10)
+
+	require.Panics(t, func() {
+		controller.ForType(demo.TypeV2Artist).WithRateLimit(0, 1)
+	})
+	require.Panics(t, func() {
+		controller.ForType(demo.TypeV2Artist).WithRateLimit(limit, 0)
+	})
+}
+
+// blockingReconciler blocks each reconcile until release is closed.
+type blockingReconciler struct {
+	started chan controller.Request
+	release chan struct{}
+}
+
+func (r *blockingReconciler) Reconcile(ctx context.Context, _ controller.Runtime, req controller.Request) error {
+	select {
+	case r.started <- req:
+	case <-ctx.Done():
+		return nil
+	}
+	select {
+	case <-r.release:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
 func TestController_String(t *testing.T) {
 	ctrl := controller.
 		ForType(demo.TypeV2Artist).
@@ -238,7 +1230,7 @@ func TestController_NoReconciler(t *testing.T) {
 
 	ctrl := controller.ForType(demo.TypeV2Artist)
 	require.PanicsWithValue(t,
-		`cannot register controller without a reconciler <Controller managed_type="demo.v2.Artist", watched_types=[], backoff=<base="5ms", max="16m40s">, placement="singleton">`,
+		`cannot register controller without a reconciler <Controller managed_type="demo.v2.Artist", watched_types=[], backoff=<base="50ms", max="30s">, placement="singleton">`,
 		func() { mgr.Register(ctrl) })
 }
 
@@ -365,12 +1357,17 @@ func (r *testReconciler) expectNoRequest(t *testing.T, duration time.Duration) {
 
 func (r *testReconciler) wait(t *testing.T) controller.Request {
 	t.Helper()
+	return r.waitFor(t, 500*time.Millisecond)
+}
+
+func (r *testReconciler) waitFor(t *testing.T, timeout time.Duration) controller.Request {
+	t.Helper()
 
 	var req controller.Request
 	select {
 	case req = <-r.calls:
-	case <-time.After(500 * time.Millisecond):
-		t.Fatal("Reconcile was not called after 500ms")
+	case <-time.After(timeout):
+		t.Fatalf("Reconcile was not called after %s", timeout)
 	}
 	return req
 }
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// ResourceCache holds the most recently watched version of resources, so that
+// reconcilers can read them without a round trip to the resource service.
+type ResourceCache struct {
+	mu        sync.RWMutex
+	resources map[resource.ReferenceKey]*pbresource.Resource
+}
+
+// NewResourceCache creates an empty ResourceCache.
+func NewResourceCache() *ResourceCache {
+	return &ResourceCache{resources: make(map[resource.ReferenceKey]*pbresource.Resource)}
+}
+
+// Upsert stores res in the cache, replacing any previous version of it.
+func (c *ResourceCache) Upsert(res *pbresource.Resource) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.resources[resource.NewReferenceKey(res.Id)] = res
+}
+
+// Delete removes the resource with the given ID from the cache.
+func (c *ResourceCache) Delete(id *pbresource.ID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.resources, resource.NewReferenceKey(id))
+}
+
+// resetType removes the resources of the given type from the cache, e.g. when
+// their watch is restarted and deletions may have been missed.
+func (c *ResourceCache) resetType(typ *pbresource.Type) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	gvk := resource.ToGVK(typ)
+	for key := range c.resources {
+		if key.GVK == gvk {
+			delete(c.resources, key)
+		}
+	}
+}
+
+// get returns a copy of the cached resource with the given ID. The Uid is only
+// compared when id has one.
+func (c *ResourceCache) get(id *pbresource.ID) (*pbresource.Resource, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	res, ok := c.resources[resource.NewReferenceKey(id)]
+	if !ok || (id.Uid != "" && id.Uid != res.Id.Uid) {
+		return nil, false
+	}
+	return proto.Clone(res).(*pbresource.Resource), true
+}
+
+// RuntimeWithCache returns a copy of rt whose CacheRead serves reads from the
+// given cache.
+func RuntimeWithCache(rt Runtime, cache *ResourceCache) Runtime {
+	rt.cache = cache
+	return rt
+}
+
+// CacheRead reads a resource like Client.Read, but serves it from the
+// Runtime's cache when possible. The cache of a controller built using
+// WithReadCache holds the resources of its managed and watched types as of
+// their most recent watch events, so cached resources may be slightly stale.
+//
+// Reads fall through to Client.Read when the resource isn't cached, when
+// leader consistency is requested (either via ReadRequest.Consistency or the
+// x-consul-consistency-mode metadata), or when the request has any option other
+// than the resource's ID.
+func (rt Runtime) CacheRead(ctx context.Context, req *pbresource.ReadRequest) (*pbresource.ReadResponse, error) {
+	if rt.cache == nil || !cacheable(ctx, req) {
+		return rt.Client.Read(ctx, req)
+	}
+
+	res, ok := rt.cache.get(req.Id)
+	if !ok {
+		return rt.Client.Read(ctx, req)
+	}
+	if rt.overlay != nil {
+		res = rt.overlay(res)
+	}
+	return &pbresource.ReadResponse{Resource: res}, nil
+}
+
+// cacheable reports whether req can be served from the cache.
+func cacheable(ctx context.Context, req *pbresource.ReadRequest) bool {
+	if req.Id == nil || req.Consistency == pbresource.Consistency_CONSISTENCY_LEADER {
+		return false
+	}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		if vals := md.Get("x-consul-consistency-mode"); len(vals) > 0 && vals[0] == "consistent" {
+			return false
+		}
+	}
+
+	rest := proto.Clone(req).(*pbresource.ReadRequest)
+	rest.Id = nil
+	rest.Consistency = pbresource.Consistency_CONSISTENCY_UNSPECIFIED
+	return proto.Equal(rest, &pbresource.ReadRequest{})
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// MaxConflictRetries is the number of times RetryOnConflict retries a write
+// that failed because the resource was changed concurrently.
+const MaxConflictRetries = 5
+
+// RetryOnConflict reads the resource with the given ID, applies mutate to it,
+// and writes it back using the version it read. If the write fails because the
+// resource was changed in the meantime, the resource is read again and mutate
+// re-applied, up to MaxConflictRetries times. mutate must therefore be safe to
+// call more than once.
+//
+// The written resource is returned. Errors returned by mutate, and all other
+// errors, are returned straight away.
+func RetryOnConflict(ctx context.Context, rt Runtime, id *pbresource.ID, mutate func(*pbresource.Resource) error) (*pbresource.Resource, error) {
+	var err error
+	for attempt := 0; attempt <= MaxConflictRetries; attempt++ {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var rsp *pbresource.ReadResponse
+		rsp, err = rt.Client.Read(ctx, &pbresource.ReadRequest{Id: id})
+		if err != nil {
+			return nil, err
+		}
+
+		res := rsp.Resource
+		if err = mutate(res); err != nil {
+			return nil, err
+		}
+
+		var writeRsp *pbresource.WriteResponse
+		writeRsp, err = rt.Client.Write(ctx, &pbresource.WriteRequest{Resource: res})
+		if err == nil {
+			return writeRsp.Resource, nil
+		}
+		if status.Code(err) != codes.Aborted {
+			return nil, err
+		}
+		rt.Logger.Trace("retrying write after conflict", "resource_id", id, "attempt", attempt+1)
+	}
+	return nil, err
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	svctest "github.com/hashicorp/consul/agent/grpc-external/services/resource/testing"
+	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/resource/demo"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	pbdemov2 "github.com/hashicorp/consul/proto/private/pbdemo/v2"
+	"github.com/hashicorp/consul/sdk/testutil"
+)
+
+func TestRetryOnConflict(t *testing.T) {
+	t.Parallel()
+
+	client := svctest.RunResourceService(t, demo.RegisterTypes)
+	rt := controller.Runtime{Client: client, Logger: testutil.Logger(t)}
+	ctx := testContext(t)
+
+	writeArtist := func(t *testing.T) *pbresource.Resource {
+		artist, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+		rsp, err := client.Write(ctx, &pbresource.WriteRequest{Resource: artist})
+		require.NoError(t, err)
+		return rsp.Resource
+	}
+
+	// conflictingWrite updates the artist's metadata behind the helper's back.
+	conflictingWrite := func(t *testing.T, id *pbresource.ID) {
+		rsp, err := client.Read(ctx, &pbresource.ReadRequest{Id: id})
+		require.NoError(t, err)
+		res := rsp.Resource
+		res.Metadata = map[string]string{"writer": "other"}
+		_, err = client.Write(ctx, &pbresource.WriteRequest{Resource: res})
+		require.NoError(t, err)
+	}
+
+	setGenre := func(res *pbresource.Resource, genre pbdemov2.Genre) error {
+		var artist pbdemov2.Artist
+		if err := res.Data.UnmarshalTo(&artist); err != nil {
+			return err
+		}
+		artist.Genre = genre
+		data, err := anypb.New(&artist)
+		if err != nil {
+			return err
+		}
+		res.Data = data
+		return nil
+	}
+
+	t.Run("succeeds after conflicts", func(t *testing.T) {
+		artist := writeArtist(t)
+
+		var calls int
+		written, err := controller.RetryOnConflict(ctx, rt, artist.Id, func(res *pbresource.Resource) error {
+			calls++
+			if calls <= 2 {
+				conflictingWrite(t, artist.Id)
+			}
+			return setGenre(res, pbdemov2.Genre_GENRE_BLUES)
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, calls)
+
+		// Both the conflicting writer's change and the mutation were kept.
+		var data pbdemov2.Artist
+		require.NoError(t, written.Data.UnmarshalTo(&data))
+		require.Equal(t, pbdemov2.Genre_GENRE_BLUES, data.Genre)
+		require.Equal(t, "other", written.Metadata["writer"])
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		artist := writeArtist(t)
+
+		var calls int
+		_, err := controller.RetryOnConflict(ctx, rt, artist.Id, func(res *pbresource.Resource) error {
+			calls++
+			conflictingWrite(t, artist.Id)
+			return setGenre(res, pbdemov2.Genre_GENRE_BLUES)
+		})
+		require.Equal(t, codes.Aborted.String(), status.Code(err).String())
+		require.Equal(t, controller.MaxConflictRetries+1, calls)
+	})
+
+	t.Run("mutate error", func(t *testing.T) {
+		artist := writeArtist(t)
+		mutateErr := errors.New("boom")
+
+		var calls int
+		_, err := controller.RetryOnConflict(ctx, rt, artist.Id, func(*pbresource.Resource) error {
+			calls++
+			return mutateErr
+		})
+		require.ErrorIs(t, err, mutateErr)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("non-conflict error", func(t *testing.T) {
+		artist := writeArtist(t)
+
+		var calls int
+		_, err := controller.RetryOnConflict(ctx, rt, artist.Id, func(res *pbresource.Resource) error {
+			calls++
+			res.Id.Name = "Invalid Name"
+			return nil
+		})
+		require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		artist, err := demo.GenerateV2Artist()
+		require.NoError(t, err)
+
+		_, err = controller.RetryOnConflict(ctx, rt, artist.Id, func(*pbresource.Resource) error {
+			t.Fatal("mutate should not be called")
+			return nil
+		})
+		require.Equal(t, codes.NotFound.String(), status.Code(err).String())
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		artist := writeArtist(t)
+		ctx, cancel := context.WithCancel(ctx)
+
+		var calls int
+		_, err := controller.RetryOnConflict(ctx, rt, artist.Id, func(res *pbresource.Resource) error {
+			calls++
+			conflictingWrite(t, artist.Id)
+			cancel()
+			return setGenre(res, pbdemov2.Genre_GENRE_BLUES)
+		})
+		require.Error(t, err)
+		require.Equal(t, 1, calls)
+	})
+}
@@ -7,22 +7,59 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
 
 	"github.com/hashicorp/consul/agent/consul/controller/queue"
 	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/telemetry"
 	"github.com/hashicorp/consul/proto-public/pbresource"
 )
 
 // controllerRunner contains the actual implementation of running a controller
 // including creating watches, calling the reconciler, handling retries, etc.
 type controllerRunner struct {
-	ctrl   Controller
-	client pbresource.ResourceServiceClient
-	logger hclog.Logger
+	ctrl    Controller
+	client  pbresource.ResourceServiceClient
+	logger  hclog.Logger
+	events  *reconcileEvents
+	metrics telemetry.Sink
+	labels  []metrics.Label
+	retry   RetryPolicy
+
+	// tracker records the controller's outstanding work when other controllers
+	// are ordered after it, and is nil otherwise.
+	tracker *reconcileTracker
+
+	// reconcileAfter are the trackers of the controllers this controller is
+	// ordered after.
+	reconcileAfter []*reconcileTracker
+
+	// deferrals records the requests deferred waiting for the controllers this
+	// controller is ordered after.
+	deferrals reconcileDeferrals
+
+	// batcher buffers the reconciler's status writes when status write
+	// batching is enabled, and is nil otherwise.
+	batcher *statusBatcher
+
+	// cache holds the watched resources when the read cache is enabled, and is
+	// nil otherwise.
+	cache *ResourceCache
+
+	// limiter paces the reconciles of all workers when a rate limit is
+	// configured, and is nil otherwise.
+	limiter *rate.Limiter
+
+	// queue is where requests enqueued via the Manager are added while the
+	// controller is running, and is nil otherwise.
+	queueMu sync.Mutex
+	queue   requestQueue
 }
 
 func (c *controllerRunner) run(ctx context.Context) error {
@@ -30,35 +67,91 @@ func (c *controllerRunner) run(ctx context.Context) error {
 	defer c.logger.Debug("controller stopping")
 
 	group, groupCtx := errgroup.WithContext(ctx)
-	recQueue := runQueue[Request](groupCtx, c.ctrl)
+	recQueue := runQueue[Request](groupCtx, c.retry)
+
+	// When other controllers are ordered after this one, requests are tracked
+	// from when they are added, by any path, until they have been reconciled.
+	if c.tracker != nil {
+		c.tracker.reset()
+		recQueue = trackingWorkQueue{WorkQueue: recQueue, tracker: c.tracker}
+	}
+	c.deferrals = reconcileDeferrals{}
+
+	// When a startup ramp is configured, requests pass through it on their way
+	// to the reconciliation queue (apart from retries).
+	var (
+		reqQueue      requestQueue = recQueue
+		endOfSnapshot func()
+	)
+	if c.ctrl.startupRamp > 0 {
+		ramp := newStartupRamp(c.ctrl.startupRamp, recQueue)
+		reqQueue, endOfSnapshot = ramp, ramp.endOfSnapshot
+	}
+
+	// Requests held by the startup ramp are outstanding too.
+	if c.tracker != nil {
+		if c.ctrl.startupRamp > 0 {
+			reqQueue = trackingQueue{requestQueue: reqQueue, tracker: c.tracker}
+		}
+
+		rampEndOfSnapshot := endOfSnapshot
+		endOfSnapshot = func() {
+			if rampEndOfSnapshot != nil {
+				rampEndOfSnapshot()
+			}
+			c.tracker.endOfSnapshot()
+		}
+	}
+
+	// Status writes made by the reconciler are buffered and flushed in batches.
+	c.batcher = nil
+	if c.ctrl.statusBatchWindow > 0 {
+		c.batcher = newStatusBatcher(c.client, c.ctrl.statusBatchWindow, c.logger, func(req Request) {
+			if resource.EqualType(req.ID.Type, c.ctrl.managedType) {
+				recQueue.AddRateLimited(req)
+			}
+		})
+		group.Go(func() error {
+			return c.batcher.run(groupCtx)
+		})
+	}
+
+	c.cache = nil
+	if c.ctrl.readCache {
+		c.cache = NewResourceCache()
+	}
+
+	// External Requests → Reconciliation Queue
+	c.setQueue(reqQueue)
+	defer c.setQueue(nil)
 
 	// Managed Type Events → Reconciliation Queue
 	group.Go(func() error {
 		return c.watch(groupCtx, c.ctrl.managedType, func(res *pbresource.Resource) {
-			recQueue.Add(Request{ID: res.Id})
-		})
+			reqQueue.Add(Request{ID: res.Id})
+		}, endOfSnapshot)
 	})
 
 	for _, w := range c.ctrl.watches {
-		mapQueue := runQueue[mapperRequest](groupCtx, c.ctrl)
+		mapQueue := runQueue[mapperRequest](groupCtx, c.retry)
 		watcher := w
 		// Watched Type Events → Mapper Queue
 		group.Go(func() error {
 			return c.watch(groupCtx, watcher.watchedType, func(res *pbresource.Resource) {
 				mapQueue.Add(mapperRequest{res: res})
-			})
+			}, nil)
 		})
 
 		// Mapper Queue → Mapper → Reconciliation Queue
 		group.Go(func() error {
-			return c.runMapper(groupCtx, watcher, mapQueue, recQueue, func(ctx context.Context, runtime Runtime, itemType queue.ItemType) ([]Request, error) {
+			return c.runMapper(groupCtx, watcher, mapQueue, reqQueue, func(ctx context.Context, runtime Runtime, itemType queue.ItemType) ([]Request, error) {
 				return watcher.mapper(ctx, runtime, itemType.(mapperRequest).res)
 			})
 		})
 	}
 
 	for _, cw := range c.ctrl.customWatches {
-		customMapQueue := runQueue[Event](groupCtx, c.ctrl)
+		customMapQueue := runQueue[Event](groupCtx, c.retry)
 		watcher := cw
 		// Custom Events → Mapper Queue
 		group.Go(func() error {
@@ -69,40 +162,79 @@ func (c *controllerRunner) run(ctx context.Context) error {
 
 		// Mapper Queue → Mapper → Reconciliation Queue
 		group.Go(func() error {
-			return c.runCustomMapper(groupCtx, watcher, customMapQueue, recQueue, func(ctx context.Context, runtime Runtime, itemType queue.ItemType) ([]Request, error) {
+			return c.runCustomMapper(groupCtx, watcher, customMapQueue, reqQueue, func(ctx context.Context, runtime Runtime, itemType queue.ItemType) ([]Request, error) {
 				return watcher.mapper(ctx, runtime, itemType.(Event))
 			})
 		})
 	}
 
-	// Reconciliation Queue → Reconciler
-	group.Go(func() error {
-		return c.runReconciler(groupCtx, recQueue)
-	})
+	// Reconciliation Queue → Rate Limiter → Reconciler Workers
+	c.limiter = nil
+	if c.ctrl.rateLimit > 0 {
+		c.limiter = rate.NewLimiter(c.ctrl.rateLimit, c.ctrl.rateBurst)
+	}
+	workers := c.ctrl.workers
+	if workers == 0 {
+		workers = 1
+	}
+	for i := 0; i < workers; i++ {
+		group.Go(func() error {
+			return c.runReconciler(groupCtx, recQueue)
+		})
+	}
 
 	return group.Wait()
 }
 
-func runQueue[T queue.ItemType](ctx context.Context, ctrl Controller) queue.WorkQueue[T] {
-	base, max := ctrl.backoff()
-	return queue.RunWorkQueue[T](ctx, base, max)
+// requestQueue is the part of the reconciliation queue used to add requests.
+type requestQueue interface {
+	Add(item Request)
 }
 
-func (c *controllerRunner) watch(ctx context.Context, typ *pbresource.Type, add func(*pbresource.Resource)) error {
+func runQueue[T queue.ItemType](ctx context.Context, policy RetryPolicy) queue.WorkQueue[T] {
+	if policy.FullJitter {
+		return queue.RunWorkQueueWithLimiter[T](ctx, queue.NewJitterRateLimiter[T](policy.BaseBackoff, policy.MaxBackoff))
+	}
+	return queue.RunWorkQueue[T](ctx, policy.BaseBackoff, policy.MaxBackoff)
+}
+
+// watch calls add for each resource of the given type, starting with a snapshot
+// of those that already exist. If endOfSnapshot is non-nil, it is called once
+// the snapshot is complete.
+func (c *controllerRunner) watch(ctx context.Context, typ *pbresource.Type, add func(*pbresource.Resource), endOfSnapshot func()) error {
 	wl, err := c.client.WatchList(ctx, &pbresource.WatchListRequest{
-		Type: typ,
+		Type:                 typ,
+		IncludeEndOfSnapshot: endOfSnapshot != nil,
 	})
 	if err != nil {
 		c.logger.Error("failed to create watch", "error", err)
 		return err
 	}
 
+	// Deletions may have been missed while the watch wasn't running.
+	if c.cache != nil {
+		c.cache.resetType(typ)
+	}
+
 	for {
 		event, err := wl.Recv()
 		if err != nil {
 			c.logger.Warn("error received from watch", "error", err)
 			return err
 		}
+		if event.Operation == pbresource.WatchEvent_OPERATION_END_OF_SNAPSHOT {
+			if endOfSnapshot != nil {
+				endOfSnapshot()
+			}
+			continue
+		}
+		if c.cache != nil {
+			if event.Operation == pbresource.WatchEvent_OPERATION_DELETE {
+				c.cache.Delete(event.Resource.Id)
+			} else {
+				c.cache.Upsert(event.Resource)
+			}
+		}
 		add(event.Resource)
 	}
 }
@@ -111,7 +243,7 @@ func (c *controllerRunner) runMapper(
 	ctx context.Context,
 	w watch,
 	from queue.WorkQueue[mapperRequest],
-	to queue.WorkQueue[Request],
+	to requestQueue,
 	mapper func(ctx context.Context, runtime Runtime, itemType queue.ItemType) ([]Request, error),
 ) error {
 	logger := c.logger.With("watched_resource_type", resource.ToGVK(w.watchedType))
@@ -137,7 +269,7 @@ func (c *controllerRunner) runCustomMapper(
 	ctx context.Context,
 	cw customWatch,
 	from queue.WorkQueue[Event],
-	to queue.WorkQueue[Request],
+	to requestQueue,
 	mapper func(ctx context.Context, runtime Runtime, itemType queue.ItemType) ([]Request, error),
 ) error {
 	logger := c.logger.With("watched_event", cw.source)
@@ -159,7 +291,7 @@ func (c *controllerRunner) runCustomMapper(
 	}
 }
 
-func (c *controllerRunner) doMap(ctx context.Context, mapper func(ctx context.Context, runtime Runtime, itemType queue.ItemType) ([]Request, error), to queue.WorkQueue[Request], item queue.ItemType, logger hclog.Logger) error {
+func (c *controllerRunner) doMap(ctx context.Context, mapper func(ctx context.Context, runtime Runtime, itemType queue.ItemType) ([]Request, error), to requestQueue, item queue.ItemType, logger hclog.Logger) error {
 	var reqs []Request
 	if err := c.handlePanic(func() error {
 		var err error
@@ -189,22 +321,71 @@ func (c *controllerRunner) runReconciler(ctx context.Context, queue queue.WorkQu
 			return nil
 		}
 
+		if c.tracker != nil {
+			c.tracker.begin(req)
+		}
+
+		// Rather than holding the worker, put the request back on the queue
+		// while the controllers this controller is ordered after are busy.
+		// It stays outstanding so that controllers ordered after this one
+		// keep waiting too.
+		if c.deferForReconcileAfter(req) {
+			queue.AddAfter(req, reconcileAfterRecheck)
+			if c.tracker != nil {
+				c.tracker.add(req)
+			}
+			queue.Done(req)
+			if c.tracker != nil {
+				c.tracker.done(req)
+			}
+			continue
+		}
+
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx); err != nil {
+				// The controller is stopping.
+				queue.Done(req)
+				if c.tracker != nil {
+					c.tracker.done(req)
+				}
+				return nil
+			}
+		}
+
 		c.logger.Trace("handling request", "request", req)
+		start := time.Now()
 		err := c.handlePanic(func() error {
 			return c.ctrl.reconciler.Reconcile(ctx, c.runtime(), req)
 		})
-		if err == nil {
+		duration := time.Since(start)
+
+		result := classifyReconcileResult(err)
+		labels := c.reconcileLabels(result)
+		c.metrics.MeasureSince(metricReconcile, start, labels)
+		c.metrics.IncrCounter(metricReconcileOutcome, 1, labels)
+		switch result {
+		case ReconcileResultSuccess:
 			queue.Forget(req)
-		} else {
+		case ReconcileResultRequeue:
 			var requeueAfter RequeueAfterError
-			if errors.As(err, &requeueAfter) {
-				queue.Forget(req)
-				queue.AddAfter(req, time.Duration(requeueAfter))
-			} else {
-				queue.AddRateLimited(req)
-			}
+			errors.As(err, &requeueAfter)
+			queue.Forget(req)
+			queue.AddAfter(req, time.Duration(requeueAfter))
+		default:
+			queue.AddRateLimited(req)
 		}
 		queue.Done(req)
+
+		c.events.publish(ReconcileEvent{
+			Controller: c.ctrl.Name(),
+			ID:         req.ID,
+			Result:     result,
+			Duration:   duration,
+			Err:        err,
+		})
+		if c.tracker != nil {
+			c.tracker.done(req)
+		}
 	}
 }
 
@@ -225,10 +406,22 @@ func (c *controllerRunner) handlePanic(fn func() error) (err error) {
 }
 
 func (c *controllerRunner) runtime() Runtime {
-	return Runtime{
-		Client: c.client,
-		Logger: c.logger,
+	var client pbresource.ResourceServiceClient = c.client
+	if c.batcher != nil {
+		client = statusBatchingClient{ResourceServiceClient: c.client, batcher: c.batcher}
+	}
+	rt := Runtime{
+		Client:  client,
+		Logger:  c.logger,
+		Metrics: c.metrics,
+	}
+	if c.cache != nil {
+		rt = RuntimeWithCache(rt, c.cache)
+		if c.batcher != nil {
+			rt.overlay = c.batcher.overlay
+		}
 	}
+	return rt
 }
 
 type mapperRequest struct{ res *pbresource.Resource }
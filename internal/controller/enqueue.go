@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/storage"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// Enqueue requests that the resources with the given IDs are reconciled by the
+// controllers managing their types, e.g. in response to an external signal
+// such as a config file change that isn't tied to a resource event.
+//
+// Requests for controllers that aren't currently running are dropped, as a
+// controller reconciles every resource of its managed type when it starts. An
+// error is returned if no controller has been registered for one of the IDs'
+// types, in which case none of the requests are enqueued.
+func (m *Manager) Enqueue(ids ...*pbresource.ID) error {
+	runners := make([][]*controllerRunner, len(ids))
+	for idx, id := range ids {
+		var err error
+		if runners[idx], err = m.runnersFor(id.Type); err != nil {
+			return err
+		}
+	}
+
+	for idx, id := range ids {
+		for _, runner := range runners[idx] {
+			runner.enqueue(Request{ID: id})
+		}
+	}
+	return nil
+}
+
+// EnqueueType requests that all resources of the given type are reconciled by
+// the controllers managing it. Like Enqueue, it is a no-op for controllers that
+// aren't currently running, and an error is returned if no controller has been
+// registered for the type.
+func (m *Manager) EnqueueType(ctx context.Context, typ *pbresource.Type) error {
+	runners, err := m.runnersFor(typ)
+	if err != nil {
+		return err
+	}
+
+	for _, runner := range runners {
+		if err := runner.enqueueAll(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Manager) runnersFor(typ *pbresource.Type) ([]*controllerRunner, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var found bool
+	var runners []*controllerRunner
+	for idx, ctrl := range m.controllers {
+		if !resource.EqualType(ctrl.managedType, typ) {
+			continue
+		}
+		found = true
+		if idx < len(m.runners) {
+			runners = append(runners, m.runners[idx])
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("no controller registered for type %q", resource.ToGVK(typ))
+	}
+	return runners, nil
+}
+
+// setQueue records the queue to which external requests are added while the
+// controller is running. It is cleared by passing nil when the controller
+// stops.
+func (c *controllerRunner) setQueue(q requestQueue) {
+	c.queueMu.Lock()
+	defer c.queueMu.Unlock()
+
+	c.queue = q
+}
+
+// enqueue adds req to the controller's queue, if it is running.
+func (c *controllerRunner) enqueue(req Request) {
+	c.queueMu.Lock()
+	defer c.queueMu.Unlock()
+
+	if c.queue != nil {
+		c.queue.Add(req)
+	}
+}
+
+// enqueueAll adds a request for every resource of the managed type to the
+// controller's queue, if it is running.
+func (c *controllerRunner) enqueueAll(ctx context.Context) error {
+	c.queueMu.Lock()
+	running := c.queue != nil
+	c.queueMu.Unlock()
+
+	if !running {
+		return nil
+	}
+
+	// The scope of the managed type isn't known here, so list with the
+	// tenancy of namespace scoped types, which also matches cluster scoped
+	// ones, and fall back to that of partition scoped types, which can't have
+	// a namespace.
+	tenancy := &pbresource.Tenancy{
+		Partition: storage.Wildcard,
+		PeerName:  storage.Wildcard,
+		Namespace: storage.Wildcard,
+	}
+	rsp, err := c.client.List(ctx, &pbresource.ListRequest{Type: c.ctrl.managedType, Tenancy: tenancy})
+	if status.Code(err) == codes.InvalidArgument {
+		tenancy.Namespace = ""
+		rsp, err = c.client.List(ctx, &pbresource.ListRequest{Type: c.ctrl.managedType, Tenancy: tenancy})
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, res := range rsp.Resources {
+		c.enqueue(Request{ID: res.Id})
+	}
+	return nil
+}
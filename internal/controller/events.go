@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// ReconcileResult classifies the outcome of a call to Reconciler.Reconcile.
+type ReconcileResult int
+
+const (
+	// ReconcileResultSuccess means the reconcile returned no error.
+	ReconcileResultSuccess ReconcileResult = iota
+
+	// ReconcileResultRequeue means the reconcile returned a RequeueAfterError,
+	// so the request was rescheduled without backoff.
+	ReconcileResultRequeue
+
+	// ReconcileResultError means the reconcile returned any other error or
+	// panicked, so the request was retried with backoff.
+	ReconcileResultError
+)
+
+func (r ReconcileResult) String() string {
+	switch r {
+	case ReconcileResultSuccess:
+		return "success"
+	case ReconcileResultRequeue:
+		return "requeue"
+	case ReconcileResultError:
+		return "error"
+	default:
+		return fmt.Sprintf("ReconcileResult(%d)", int(r))
+	}
+}
+
+// classifyReconcileResult returns the result of a reconcile that returned err.
+func classifyReconcileResult(err error) ReconcileResult {
+	if err == nil {
+		return ReconcileResultSuccess
+	}
+	var requeueAfter RequeueAfterError
+	if errors.As(err, &requeueAfter) {
+		return ReconcileResultRequeue
+	}
+	return ReconcileResultError
+}
+
+// ReconcileEvent describes a single call to a controller's Reconciler.
+type ReconcileEvent struct {
+	// Controller is the name of the controller that ran the reconcile.
+	Controller string
+
+	// ID of the resource that was reconciled.
+	ID *pbresource.ID
+
+	// Result of the reconcile.
+	Result ReconcileResult
+
+	// Duration is how long the reconcile took.
+	Duration time.Duration
+
+	// Err is the error returned by the reconcile, if any.
+	Err error
+}
+
+// reconcileEventBufferSize is the number of events buffered for each watcher.
+// Events for a watcher that falls further behind are dropped rather than
+// slowing down the controller.
+const reconcileEventBufferSize = 64
+
+// reconcileEvents fans reconcile events out to the watchers of each controller.
+type reconcileEvents struct {
+	mu       sync.Mutex
+	watchers map[*reconcileEventWatcher]struct{}
+}
+
+type reconcileEventWatcher struct {
+	controller string
+	ch         chan ReconcileEvent
+}
+
+func newReconcileEvents() *reconcileEvents {
+	return &reconcileEvents{watchers: make(map[*reconcileEventWatcher]struct{})}
+}
+
+func (e *reconcileEvents) publish(event ReconcileEvent) {
+	if e == nil {
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for w := range e.watchers {
+		if w.controller != event.Controller {
+			continue
+		}
+		select {
+		case w.ch <- event:
+		default:
+		}
+	}
+}
+
+func (e *reconcileEvents) watch(controller string) (*reconcileEventWatcher, func()) {
+	w := &reconcileEventWatcher{
+		controller: controller,
+		ch:         make(chan ReconcileEvent, reconcileEventBufferSize),
+	}
+
+	e.mu.Lock()
+	e.watchers[w] = struct{}{}
+	e.mu.Unlock()
+
+	return w, func() {
+		e.mu.Lock()
+		delete(e.watchers, w)
+		e.mu.Unlock()
+	}
+}
+
+// WatchReconcileEvents calls fn with an event for each reconcile run by the
+// controllers registered with the given name, until the context is canceled or
+// fn returns an error. It is intended for debugging controllers: events are
+// dropped if fn can't keep up, rather than slowing down the controller.
+//
+// An error is returned if no controller has been registered with the given
+// name.
+func (m *Manager) WatchReconcileEvents(ctx context.Context, name string, fn func(ReconcileEvent) error) error {
+	m.mu.Lock()
+	var found bool
+	for _, ctrl := range m.controllers {
+		if ctrl.Name() == name {
+			found = true
+			break
+		}
+	}
+	m.mu.Unlock()
+
+	if !found {
+		return fmt.Errorf("no controller registered with name %q", name)
+	}
+
+	w, stop := m.events.watch(name)
+	defer stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event := <-w.ch:
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+	}
+}
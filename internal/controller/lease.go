@@ -3,6 +3,12 @@
 
 package controller
 
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
 // Lease is used to ensure controllers are run as singletons (i.e. one leader-
 // elected instance per cluster).
 //
@@ -30,3 +36,80 @@ type eternalLease struct{}
 
 func (eternalLease) Held() bool               { return true }
 func (eternalLease) Changed() <-chan struct{} { return nil }
+
+// pausableLease wraps another Lease so that it is never considered held while
+// an operator has paused the controller (see Manager.Pause). Changed fires when
+// either the wrapped lease or the paused state changes.
+type pausableLease struct {
+	lease  Lease
+	ch     chan struct{}
+	paused atomic.Bool
+
+	// mu guards changes to paused and running, which is set while the
+	// controller's task runs, so that Pause can wait for the task to return.
+	mu      sync.Mutex
+	stopped *sync.Cond
+	running bool
+}
+
+func newPausableLease(lease Lease, ch chan struct{}) *pausableLease {
+	l := &pausableLease{lease: lease, ch: ch}
+	l.stopped = sync.NewCond(&l.mu)
+	return l
+}
+
+func (l *pausableLease) Held() bool               { return !l.paused.Load() && l.lease.Held() }
+func (l *pausableLease) Changed() <-chan struct{} { return l.ch }
+
+func (l *pausableLease) setPaused(paused bool) {
+	l.mu.Lock()
+	l.paused.Store(paused)
+	l.mu.Unlock()
+
+	notifyLeaseChanged(l.ch)
+}
+
+// waitStopped blocks until the controller's task is not running.
+func (l *pausableLease) waitStopped() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for l.running {
+		l.stopped.Wait()
+	}
+}
+
+// guard wraps the controller's task so that waitStopped can tell when it has
+// returned. A task started after the controller was paused, having raced with
+// setPaused, doesn't run but waits for the supervisor to stop it.
+func (l *pausableLease) guard(t task) task {
+	return func(ctx context.Context) error {
+		l.mu.Lock()
+		if l.paused.Load() {
+			l.mu.Unlock()
+			<-ctx.Done()
+			return nil
+		}
+		l.running = true
+		l.mu.Unlock()
+
+		defer func() {
+			l.mu.Lock()
+			l.running = false
+			l.mu.Unlock()
+			l.stopped.Broadcast()
+		}()
+		return t(ctx)
+	}
+}
+
+func notifyLeaseChanged(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+		// Do not block if there's nothing receiving on ch (because the supervisor is
+		// busy doing something else). Note that ch has a buffer of 1, so we'll never
+		// miss the notification that something has changed so we need to re-evaluate
+		// the lease.
+	}
+}
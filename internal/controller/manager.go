@@ -8,9 +8,13 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
 
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/telemetry"
 	"github.com/hashicorp/consul/proto-public/pbresource"
 )
 
@@ -20,20 +24,91 @@ type Manager struct {
 	logger hclog.Logger
 
 	raftLeader atomic.Bool
+	events     *reconcileEvents
+	metrics    telemetry.Sink
+	labels     []metrics.Label
+	retry      RetryPolicy
 
 	mu          sync.Mutex
 	running     bool
 	controllers []Controller
+	leases      []*pausableLease
 	leaseChans  []chan struct{}
+	runners     []*controllerRunner
 }
 
 // NewManager creates a Manager. logger will be used by the Manager, and as the
 // base logger for controllers when one is not specified using WithLogger.
-func NewManager(client pbresource.ResourceServiceClient, logger hclog.Logger) *Manager {
-	return &Manager{
+func NewManager(client pbresource.ResourceServiceClient, logger hclog.Logger, opts ...ManagerOption) *Manager {
+	m := &Manager{
 		client: client,
 		logger: logger,
+		events: newReconcileEvents(),
+		retry:  DefaultRetryPolicy,
 	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ManagerOption configures a Manager.
+type ManagerOption func(*Manager)
+
+// WithMetricLabels adds the given labels to the metrics emitted for every
+// controller, e.g. to distinguish the controllers of one Manager from those of
+// another.
+func WithMetricLabels(labels ...metrics.Label) ManagerOption {
+	return func(m *Manager) {
+		m.labels = append(m.labels, labels...)
+	}
+}
+
+// RetryPolicy decides how long failed reconciles are delayed before they're
+// retried.
+type RetryPolicy struct {
+	// BaseBackoff is the delay after a request's first failure. It doubles with
+	// each consecutive failure.
+	BaseBackoff time.Duration
+
+	// MaxBackoff is the longest delay.
+	MaxBackoff time.Duration
+
+	// FullJitter randomizes each delay between zero and the exponential
+	// backoff, so that requests failing together don't all retry together.
+	FullJitter bool
+}
+
+// DefaultRetryPolicy is the RetryPolicy of Managers created without
+// WithRetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	BaseBackoff: 50 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+	FullJitter:  true,
+}
+
+// WithRetryPolicy changes the retry policy of the Manager's controllers.
+// Controllers configured using WithBackoff keep their own base and maximum
+// backoff.
+func WithRetryPolicy(policy RetryPolicy) ManagerOption {
+	if policy.BaseBackoff <= 0 || policy.MaxBackoff < policy.BaseBackoff {
+		panic("retry policy must have a positive base backoff no greater than its max backoff")
+	}
+	return func(m *Manager) {
+		m.retry = policy
+	}
+}
+
+// retryPolicy returns the retry policy of the given controller.
+func (m *Manager) retryPolicy(ctrl Controller) RetryPolicy {
+	policy := m.retry
+	if ctrl.baseBackoff != 0 {
+		policy.BaseBackoff = ctrl.baseBackoff
+	}
+	if ctrl.maxBackoff != 0 {
+		policy.MaxBackoff = ctrl.maxBackoff
+	}
+	return policy
 }
 
 // Register the given controller to be executed by the Manager. Cannot be called
@@ -50,7 +125,12 @@ func (m *Manager) Register(ctrl Controller) {
 		panic(fmt.Sprintf("cannot register controller without a reconciler %s", ctrl))
 	}
 
+	// Check the orderings including this controller without registering it,
+	// so a circular ordering panics here rather than in Run.
+	validateReconcileAfter(append(m.controllers[:len(m.controllers):len(m.controllers)], ctrl))
+
 	m.controllers = append(m.controllers, ctrl)
+	m.leases = append(m.leases, m.newLeaseLocked(ctrl))
 }
 
 // Run the Manager and start executing controllers until the given context is
@@ -64,24 +144,41 @@ func (m *Manager) Run(ctx context.Context) {
 	}
 	m.running = true
 
-	for _, desc := range m.controllers {
+	trackers, trackersByType := m.reconcileTrackersLocked()
+
+	for idx, desc := range m.controllers {
 		logger := desc.logger
 		if logger == nil {
 			logger = m.logger.With("managed_type", desc.managedType.Kind)
 		}
 
 		runner := &controllerRunner{
-			ctrl:   desc,
-			client: m.client,
-			logger: logger,
+			ctrl:    desc,
+			client:  m.client,
+			logger:  logger,
+			events:  m.events,
+			metrics: telemetry.OrDefault(m.metrics),
+			labels:  m.labels,
+			retry:   m.retryPolicy(desc),
+			tracker: trackers[idx],
 		}
-		go newSupervisor(runner.run, m.newLeaseLocked(desc)).run(ctx)
+		for _, typ := range desc.reconcileAfter {
+			runner.reconcileAfter = append(runner.reconcileAfter, trackersByType[resource.ToGVK(typ)]...)
+		}
+		m.runners = append(m.runners, runner)
+		go newSupervisor(m.leases[idx].guard(runner.run), m.leases[idx]).run(ctx)
 	}
 }
 
 // SetRaftLeader notifies the Manager of Raft leadership changes. Controllers
 // are currently only executed on the Raft leader, so calling this method will
 // cause the Manager to spin them up/down accordingly.
+//
+// On losing leadership, singleton controllers are stopped: their watches and
+// queued requests are discarded, and reconciles in progress have their context
+// canceled. On regaining it, they are restarted only once their previous run
+// has returned, with fresh watches that re-list every resource, so they never
+// act on state from before the transition.
 func (m *Manager) SetRaftLeader(leader bool) {
 	m.raftLeader.Store(leader)
 
@@ -89,23 +186,91 @@ func (m *Manager) SetRaftLeader(leader bool) {
 	defer m.mu.Unlock()
 
 	for _, ch := range m.leaseChans {
-		select {
-		case ch <- struct{}{}:
-		default:
-			// Do not block if there's nothing receiving on ch (because the supervisor is
-			// busy doing something else). Note that ch has a buffer of 1, so we'll never
-			// miss the notification that something has changed so we need to re-evaluate
-			// the lease.
+		notifyLeaseChanged(ch)
+	}
+}
+
+// Pause stops the controllers registered with the given name from reconciling
+// resources until Resume is called. As when leadership is lost, their watches
+// and queued requests are discarded and reconciles in progress have their
+// context canceled. Pause returns once they have returned, so no request is
+// processed after it does; it must not be called from the paused controllers'
+// own reconcilers.
+//
+// Pausing is independent of leadership: a paused controller will not run even
+// when this server is the Raft leader. An error is returned if no controller
+// has been registered with the given name.
+func (m *Manager) Pause(name string) error {
+	leases, err := m.setPaused(name, true)
+	if err != nil {
+		return err
+	}
+	for _, lease := range leases {
+		lease.waitStopped()
+	}
+	return nil
+}
+
+// Resume restarts the controllers registered with the given name after a call
+// to Pause. On resumption the controllers start from a fresh watch, which will
+// re-list every resource so that changes made while paused are reconciled.
+func (m *Manager) Resume(name string) error {
+	_, err := m.setPaused(name, false)
+	return err
+}
+
+// setPaused pauses or resumes the controllers registered with the given name,
+// returning their leases.
+func (m *Manager) setPaused(name string, paused bool) ([]*pausableLease, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var leases []*pausableLease
+	for idx, ctrl := range m.controllers {
+		if ctrl.Name() != name {
+			continue
 		}
+		m.leases[idx].setPaused(paused)
+		leases = append(leases, m.leases[idx])
 	}
+
+	if len(leases) == 0 {
+		return nil, fmt.Errorf("no controller registered with name %q", name)
+	}
+	return leases, nil
 }
 
-func (m *Manager) newLeaseLocked(ctrl Controller) Lease {
-	if ctrl.placement == PlacementEachServer {
-		return eternalLease{}
+// reconcileTrackersLocked creates a reconcileTracker for each controller that
+// other controllers are ordered after. It returns the trackers by controller
+// index, and by managed type.
+func (m *Manager) reconcileTrackersLocked() ([]*reconcileTracker, map[string][]*reconcileTracker) {
+	tracked := make(map[string]struct{})
+	for _, ctrl := range m.controllers {
+		for _, typ := range ctrl.reconcileAfter {
+			tracked[resource.ToGVK(typ)] = struct{}{}
+		}
 	}
 
+	trackers := make([]*reconcileTracker, len(m.controllers))
+	byType := make(map[string][]*reconcileTracker)
+	for idx, ctrl := range m.controllers {
+		typ := resource.ToGVK(ctrl.managedType)
+		if _, ok := tracked[typ]; !ok {
+			continue
+		}
+		trackers[idx] = newReconcileTracker(m.leases[idx])
+		byType[typ] = append(byType[typ], trackers[idx])
+	}
+	return trackers, byType
+}
+
+func (m *Manager) newLeaseLocked(ctrl Controller) *pausableLease {
 	ch := make(chan struct{}, 1)
+
+	if ctrl.placement == PlacementEachServer {
+		return newPausableLease(eternalLease{}, ch)
+	}
+
 	m.leaseChans = append(m.leaseChans, ch)
-	return &raftLease{m: m, ch: ch}
+	return newPausableLease(&raftLease{m: m, ch: ch}, ch)
 }
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"github.com/armon/go-metrics"
+	"github.com/armon/go-metrics/prometheus"
+
+	"github.com/hashicorp/consul/internal/telemetry"
+)
+
+var (
+	metricReconcile        = []string{"controller", "reconcile"}
+	metricReconcileOutcome = []string{"controller", "reconcile", "outcome"}
+)
+
+var Summaries = []prometheus.SummaryDefinition{
+	{
+		Name: metricReconcile,
+		Help: "Measures the time it takes a controller to reconcile a resource, labeled with the controller and the result.",
+	},
+}
+
+var Counters = []prometheus.CounterDefinition{
+	{
+		Name: metricReconcileOutcome,
+		Help: "Counts the reconciles of a controller, labeled with the controller and the result (success, requeue or error).",
+	},
+}
+
+// reconcileLabels returns the labels of a reconcile metric, which are the
+// Manager's labels followed by the controller's name and the reconcile result.
+func (c *controllerRunner) reconcileLabels(result ReconcileResult) []metrics.Label {
+	labels := make([]metrics.Label, 0, len(c.labels)+2)
+	labels = append(labels, c.labels...)
+	return append(labels,
+		metrics.Label{Name: "controller", Value: c.ctrl.Name()},
+		metrics.Label{Name: "result", Value: result.String()},
+	)
+}
+
+// SetMetrics sets the Sink controllers' metrics are emitted to, which is also
+// passed to reconcilers in their Runtime. By default metrics are emitted via
+// go-metrics. Cannot be called once the Manager is running.
+//
+// The agent does not call it, so the agent's controllers always emit metrics
+// via go-metrics.
+func (m *Manager) SetMetrics(sink telemetry.Sink) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.running {
+		panic("cannot set metrics after calling Run")
+	}
+	m.metrics = sink
+}
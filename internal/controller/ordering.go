@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/agent/consul/controller/queue"
+	"github.com/hashicorp/consul/internal/resource"
+)
+
+// reconcileAfterMaxWait bounds how long a request is deferred waiting for the
+// controllers its controller is ordered after (see Controller.WithReconcileAfter),
+// so that a stuck or continuously busy controller can't block its dependents
+// indefinitely.
+const reconcileAfterMaxWait = 10 * time.Second
+
+// reconcileAfterRecheck is how long a deferred request is put back on the
+// queue for before checking again whether it can be reconciled.
+const reconcileAfterRecheck = 100 * time.Millisecond
+
+// reconcileTracker tracks whether a controller that others are ordered after
+// has work outstanding: either it hasn't yet loaded the initial snapshot of its
+// managed type, or requests have been added to its queue, by any path, that it
+// hasn't reconciled yet.
+type reconcileTracker struct {
+	lease Lease
+
+	mu     sync.Mutex
+	synced bool
+
+	// queued holds the requests added to the queue and not yet taken from it.
+	queued map[string]struct{}
+
+	// scheduled holds when the requests added to the queue with a delay, and
+	// not yet taken from it, become due. They are outstanding from then.
+	scheduled map[string]time.Time
+
+	// processing holds the requests taken from the queue and being reconciled.
+	processing map[string]struct{}
+}
+
+func newReconcileTracker(lease Lease) *reconcileTracker {
+	t := &reconcileTracker{lease: lease}
+	t.reset()
+	return t
+}
+
+// reset is called when the controller starts running, as it will reload the
+// snapshot of its managed type.
+func (t *reconcileTracker) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.synced = false
+	t.queued = make(map[string]struct{})
+	t.scheduled = make(map[string]time.Time)
+	t.processing = make(map[string]struct{})
+}
+
+func (t *reconcileTracker) endOfSnapshot() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.synced = true
+}
+
+func (t *reconcileTracker) add(req Request) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.queued[req.Key()] = struct{}{}
+}
+
+func (t *reconcileTracker) addAfter(req Request, delay time.Duration) {
+	if delay <= 0 {
+		t.add(req)
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// Like the queue, keep the earliest time the request is due.
+	key := req.Key()
+	due := time.Now().Add(delay)
+	if at, ok := t.scheduled[key]; !ok || due.Before(at) {
+		t.scheduled[key] = due
+	}
+}
+
+// begin is called when the request is taken from the queue. Requests added
+// again from then on are outstanding once it is done.
+func (t *reconcileTracker) begin(req Request) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := req.Key()
+	delete(t.queued, key)
+	if at, ok := t.scheduled[key]; ok && !at.After(time.Now()) {
+		delete(t.scheduled, key)
+	}
+	t.processing[key] = struct{}{}
+}
+
+func (t *reconcileTracker) done(req Request) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.processing, req.Key())
+}
+
+// busy reports whether the controller has outstanding work. A controller
+// that isn't running, because its lease isn't held, is never busy.
+func (t *reconcileTracker) busy() bool {
+	if !t.lease.Held() {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.synced || len(t.queued) != 0 || len(t.processing) != 0 {
+		return true
+	}
+	now := time.Now()
+	for _, at := range t.scheduled {
+		if !at.After(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// trackingQueue records the requests added to a controller's startup ramp on
+// its reconcileTracker, as they are outstanding while the ramp holds them.
+type trackingQueue struct {
+	requestQueue
+	tracker *reconcileTracker
+}
+
+func (q trackingQueue) Add(req Request) {
+	q.tracker.add(req)
+	q.requestQueue.Add(req)
+}
+
+// trackingWorkQueue records every request added to a controller's
+// reconciliation queue on its reconcileTracker, including retries and
+// requeues.
+type trackingWorkQueue struct {
+	queue.WorkQueue[Request]
+	tracker *reconcileTracker
+}
+
+func (q trackingWorkQueue) Add(req Request) {
+	q.tracker.add(req)
+	q.WorkQueue.Add(req)
+}
+
+func (q trackingWorkQueue) AddAfter(req Request, delay time.Duration) {
+	q.tracker.addAfter(req, delay)
+	q.WorkQueue.AddAfter(req, delay)
+}
+
+func (q trackingWorkQueue) AddRateLimited(req Request) {
+	// A failed request is outstanding until it has been retried successfully,
+	// however long its backoff.
+	q.tracker.add(req)
+	q.WorkQueue.AddRateLimited(req)
+}
+
+// reconcileDeferrals records when each of a controller's requests was first
+// deferred because the controllers it is ordered after had work outstanding.
+type reconcileDeferrals struct {
+	mu    sync.Mutex
+	since map[string]time.Time
+}
+
+// deferForReconcileAfter reports whether the request should be put back on the
+// queue, rather than reconciled now, because a controller this controller is
+// ordered after has work outstanding. Each request is deferred for at most
+// reconcileAfterMaxWait.
+func (c *controllerRunner) deferForReconcileAfter(req Request) bool {
+	if len(c.reconcileAfter) == 0 {
+		return false
+	}
+
+	var busy bool
+	for _, t := range c.reconcileAfter {
+		if t.busy() {
+			busy = true
+			break
+		}
+	}
+
+	d := &c.deferrals
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	key := req.Key()
+	if !busy {
+		delete(d.since, key)
+		return false
+	}
+
+	since, ok := d.since[key]
+	switch {
+	case !ok:
+		if d.since == nil {
+			d.since = make(map[string]time.Time)
+		}
+		d.since[key] = time.Now()
+		return true
+	case time.Since(since) < reconcileAfterMaxWait:
+		return true
+	default:
+		delete(d.since, key)
+		c.logger.Warn("timed out waiting for the controllers this controller reconciles after", "request", req)
+		return false
+	}
+}
+
+// validateReconcileAfter panics if the controllers' declared reconcile
+// orderings are circular. It is called as each controller is registered.
+func validateReconcileAfter(ctrls []Controller) {
+	after := make(map[string][]string)
+	for _, ctrl := range ctrls {
+		typ := resource.ToGVK(ctrl.managedType)
+		for _, t := range ctrl.reconcileAfter {
+			after[typ] = append(after[typ], resource.ToGVK(t))
+		}
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[string]int)
+	var visit func(typ string, path []string)
+	visit = func(typ string, path []string) {
+		switch state[typ] {
+		case visiting:
+			panic(fmt.Sprintf("circular reconcile ordering: %v", append(path, typ)))
+		case visited:
+			return
+		}
+		state[typ] = visiting
+		for _, dep := range after[typ] {
+			visit(dep, append(path, typ))
+		}
+		state[typ] = visited
+	}
+	for typ := range after {
+		visit(typ, nil)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+func TestReconcileTracker(t *testing.T) {
+	req := Request{ID: &pbresource.ID{Type: &pbresource.Type{Group: "demo", GroupVersion: "v2", Kind: "Artist"}, Tenancy: &pbresource.Tenancy{}, Name: "artist"}}
+
+	newTracker := func(t *testing.T) *reconcileTracker {
+		tracker := newReconcileTracker(eternalLease{})
+		tracker.endOfSnapshot()
+		require.False(t, tracker.busy())
+		return tracker
+	}
+
+	t.Run("not synced", func(t *testing.T) {
+		tracker := newReconcileTracker(eternalLease{})
+		require.True(t, tracker.busy())
+
+		tracker.endOfSnapshot()
+		require.False(t, tracker.busy())
+	})
+
+	t.Run("added while processing", func(t *testing.T) {
+		tracker := newTracker(t)
+
+		tracker.add(req)
+		tracker.begin(req)
+		tracker.add(req)
+		tracker.done(req)
+		require.True(t, tracker.busy())
+
+		tracker.begin(req)
+		tracker.done(req)
+		require.False(t, tracker.busy())
+	})
+
+	t.Run("added with a delay", func(t *testing.T) {
+		tracker := newTracker(t)
+
+		tracker.addAfter(req, 50*time.Millisecond)
+		require.False(t, tracker.busy())
+		require.Eventually(t, tracker.busy, time.Second, 10*time.Millisecond)
+
+		tracker.begin(req)
+		tracker.done(req)
+		require.False(t, tracker.busy())
+	})
+
+	t.Run("lease not held", func(t *testing.T) {
+		lease := &testLease{}
+		tracker := newReconcileTracker(lease)
+		tracker.add(req)
+		require.False(t, tracker.busy())
+
+		lease.held.Store(true)
+		require.True(t, tracker.busy())
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/agent/consul/controller/queue"
+)
+
+// startupRamp spreads the reconciles for the initial snapshot of the managed
+// type over a window, rather than adding them to the reconciliation queue all
+// at once.
+//
+// Requests added before the snapshot is complete are held back and scheduled
+// evenly across the window once it is. Until a held back request's scheduled
+// time, further requests for the same resource are dropped, as the scheduled
+// reconcile will observe whatever changed.
+type startupRamp struct {
+	window time.Duration
+	queue  queue.WorkQueue[Request]
+
+	mu       sync.Mutex
+	released bool
+	pending  []Request
+	due      map[string]time.Time
+}
+
+func newStartupRamp(window time.Duration, q queue.WorkQueue[Request]) *startupRamp {
+	return &startupRamp{
+		window: window,
+		queue:  q,
+		due:    make(map[string]time.Time),
+	}
+}
+
+// Add holds back, drops, or adds the request to the reconciliation queue
+// depending on the state of the ramp.
+func (r *startupRamp) Add(req Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := req.Key()
+	if !r.released {
+		if _, ok := r.due[key]; !ok {
+			r.due[key] = time.Time{}
+			r.pending = append(r.pending, req)
+		}
+		return
+	}
+
+	if due, ok := r.due[key]; ok {
+		if time.Now().Before(due) {
+			return
+		}
+		delete(r.due, key)
+	}
+	r.queue.Add(req)
+}
+
+// endOfSnapshot schedules the held back requests evenly across the window.
+func (r *startupRamp) endOfSnapshot() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.released {
+		return
+	}
+	r.released = true
+
+	if len(r.pending) == 0 {
+		return
+	}
+
+	now := time.Now()
+	interval := r.window / time.Duration(len(r.pending))
+	for i, req := range r.pending {
+		delay := interval * time.Duration(i)
+		r.due[req.Key()] = now.Add(delay)
+		r.queue.AddAfter(req, delay)
+	}
+	r.pending = nil
+}
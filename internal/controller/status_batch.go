@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// statusBatchMaxSize is the number of distinct buffered status writes that
+// triggers a flush before the batching window has elapsed, so that a large
+// reconcile wave doesn't hold an unbounded number of writes in memory.
+const statusBatchMaxSize = 256
+
+// statusWriteKey identifies a single status of a resource.
+type statusWriteKey struct {
+	ref resource.ReferenceKey
+	key string
+}
+
+// statusBatcher buffers the status writes made by a controller's reconciles
+// and flushes them once per window. Repeated writes to the same status within
+// a window are coalesced so that only the latest reaches the backend.
+type statusBatcher struct {
+	client  pbresource.ResourceServiceClient
+	window  time.Duration
+	logger  hclog.Logger
+	requeue func(Request)
+
+	mu      sync.Mutex
+	pending map[statusWriteKey]*pbresource.WriteStatusRequest
+
+	ready chan struct{}
+	full  chan struct{}
+}
+
+func newStatusBatcher(client pbresource.ResourceServiceClient, window time.Duration, logger hclog.Logger, requeue func(Request)) *statusBatcher {
+	return &statusBatcher{
+		client:  client,
+		window:  window,
+		logger:  logger,
+		requeue: requeue,
+		pending: make(map[statusWriteKey]*pbresource.WriteStatusRequest),
+		ready:   make(chan struct{}, 1),
+		full:    make(chan struct{}, 1),
+	}
+}
+
+// add buffers req, replacing any buffered write to the same status.
+func (b *statusBatcher) add(req *pbresource.WriteStatusRequest) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	wasEmpty := len(b.pending) == 0
+	b.pending[statusWriteKey{resource.NewReferenceKey(req.Id), req.Key}] = req
+
+	if wasEmpty {
+		signal(b.ready)
+	}
+	if len(b.pending) >= statusBatchMaxSize {
+		signal(b.full)
+	}
+}
+
+// overlay returns res with any buffered statuses applied, so that reconciles
+// compare against the status they've written rather than the stored one and
+// don't mistake an unflushed change for a no-op.
+func (b *statusBatcher) overlay(res *pbresource.Resource) *pbresource.Resource {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ref := resource.NewReferenceKey(res.Id)
+	var overlaid *pbresource.Resource
+	for k, req := range b.pending {
+		if k.ref != ref || req.Id.Uid != res.Id.Uid {
+			continue
+		}
+		if overlaid == nil {
+			overlaid = proto.Clone(res).(*pbresource.Resource)
+			if overlaid.Status == nil {
+				overlaid.Status = make(map[string]*pbresource.Status)
+			}
+		}
+		overlaid.Status[k.key] = req.Status
+	}
+	if overlaid == nil {
+		return res
+	}
+	return overlaid
+}
+
+// statusBatchShutdownTimeout bounds how long the writes still buffered when
+// the controller stops are given to flush.
+const statusBatchShutdownTimeout = 5 * time.Second
+
+// run flushes the buffered writes until the context is canceled, and then
+// flushes the writes that are still buffered.
+func (b *statusBatcher) run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			b.shutdown()
+			return nil
+		case <-b.ready:
+		}
+
+		select {
+		case <-ctx.Done():
+			b.shutdown()
+			return nil
+		case <-time.After(b.window):
+		case <-b.full:
+		}
+
+		for _, req := range b.flush(ctx) {
+			b.requeue(Request{ID: req.Id})
+		}
+	}
+}
+
+// shutdown flushes the buffered writes when the controller stops. The writes
+// that fail can't be retried by this controller, so are reported instead: they
+// will be recomputed by the reconciles that run when the controller next
+// starts.
+func (b *statusBatcher) shutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), statusBatchShutdownTimeout)
+	defer cancel()
+
+	if failed := b.flush(ctx); len(failed) != 0 {
+		b.logger.Warn("dropped batched status writes on shutdown", "count", len(failed))
+	}
+}
+
+// flush writes the buffered statuses, statusBatchMaxSize at a time, using
+// BatchWriteStatus. It returns the writes that failed.
+func (b *statusBatcher) flush(ctx context.Context) []*pbresource.WriteStatusRequest {
+	b.mu.Lock()
+	batch := make([]*pbresource.WriteStatusRequest, 0, len(b.pending))
+	for _, req := range b.pending {
+		batch = append(batch, req)
+	}
+	b.pending = make(map[statusWriteKey]*pbresource.WriteStatusRequest)
+	b.mu.Unlock()
+
+	var failed []*pbresource.WriteStatusRequest
+	for len(batch) != 0 {
+		chunk := batch
+		if len(chunk) > statusBatchMaxSize {
+			chunk = chunk[:statusBatchMaxSize]
+		}
+		batch = batch[len(chunk):]
+
+		rsp, err := b.client.BatchWriteStatus(ctx, &pbresource.BatchWriteStatusRequest{Requests: chunk})
+		if err != nil {
+			b.logger.Error("failed to write batched statuses", "count", len(chunk), "error", err)
+			failed = append(failed, chunk...)
+			continue
+		}
+		for idx, result := range rsp.Results {
+			if code := codes.Code(result.Status.GetCode()); code != codes.OK {
+				req := chunk[idx]
+				b.logger.Error("failed to write batched status",
+					"resource-id", req.Id,
+					"key", req.Key,
+					"error", status.ErrorProto(result.Status),
+				)
+				failed = append(failed, req)
+			}
+		}
+	}
+	b.logger.Trace("flushed batched status writes", "failed", len(failed))
+	return failed
+}
+
+// signal sends to ch without blocking if a signal is already pending.
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+// statusBatchingClient is the client given to reconcilers of controllers with
+// status write batching enabled. WriteStatus returns as soon as the write has
+// been buffered, with the resource as it will be once the buffered writes have
+// been flushed.
+type statusBatchingClient struct {
+	pbresource.ResourceServiceClient
+	batcher *statusBatcher
+}
+
+func (c statusBatchingClient) WriteStatus(ctx context.Context, in *pbresource.WriteStatusRequest, opts ...grpc.CallOption) (*pbresource.WriteStatusResponse, error) {
+	// Reading the resource first means writes to resources that don't exist
+	// fail here, as they would unbuffered, rather than when flushed.
+	rsp, err := c.ResourceServiceClient.Read(ctx, &pbresource.ReadRequest{Id: in.Id}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.batcher.add(in)
+	return &pbresource.WriteStatusResponse{Resource: c.batcher.overlay(rsp.Resource)}, nil
+}
+
+func (c statusBatchingClient) Read(ctx context.Context, in *pbresource.ReadRequest, opts ...grpc.CallOption) (*pbresource.ReadResponse, error) {
+	rsp, err := c.ResourceServiceClient.Read(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Resource = c.batcher.overlay(rsp.Resource)
+	return rsp, nil
+}
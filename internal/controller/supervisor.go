@@ -16,8 +16,10 @@ const flapThreshold = 2 * time.Second
 
 // supervisor keeps a task running, restarting it on-error, for as long as the
 // given lease is held. When the lease is lost, the context given to the task
-// will be canceled. If the task persistently fails (i.e. the controller is in
-// a crash-loop) supervisor will use exponential backoff to delay restarts.
+// will be canceled, and the task will not be restarted until it has returned,
+// so that no two instances of it ever run at once. If the task persistently
+// fails (i.e. the controller is in a crash-loop) supervisor will use
+// exponential backoff to delay restarts.
 type supervisor struct {
 	task  task
 	lease Lease
@@ -53,7 +55,7 @@ func (s *supervisor) run(ctx context.Context) {
 		if s.shouldStart() {
 			s.startTask(ctx)
 		} else if s.shouldStop() {
-			s.stopTask()
+			s.stopTask(ctx)
 		}
 
 		select {
@@ -118,10 +120,18 @@ func (s *supervisor) shouldStop() bool {
 	return s.running && !s.lease.Held()
 }
 
-func (s *supervisor) stopTask() {
+func (s *supervisor) stopTask(ctx context.Context) {
 	s.cancelTask()
 	s.backoff.Reset()
 	s.running = false
+
+	// Wait for the task to return, so that its state (e.g. watches and queued
+	// requests) can't leak into the next run, and its result isn't mistaken for
+	// the next run's.
+	select {
+	case <-s.errCh:
+	case <-ctx.Done():
+	}
 }
 
 func (s *supervisor) handleError() func() bool {
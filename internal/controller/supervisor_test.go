@@ -119,3 +119,58 @@ func (l *testLease) setHeld(held bool) {
 	default:
 	}
 }
+
+func TestSupervise_WaitsForTaskToStop(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	var running atomic.Int32
+	runCh := make(chan struct{})
+	releaseCh := make(chan struct{})
+
+	task := func(taskCtx context.Context) error {
+		if running.Add(1) > 1 {
+			t.Error("task started while a previous run was still running")
+		}
+		defer running.Add(-1)
+
+		runCh <- struct{}{}
+		<-taskCtx.Done()
+
+		// Simulate a reconcile that takes a while to notice cancelation.
+		<-releaseCh
+		return taskCtx.Err()
+	}
+
+	lease := newTestLease()
+	lease.acquired()
+
+	go newSupervisor(task, lease).run(ctx)
+
+	select {
+	case <-runCh:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("task not running after lease is acquired")
+	}
+
+	// Lose and regain the lease while the task is still returning.
+	lease.lost()
+	time.Sleep(100 * time.Millisecond)
+	lease.acquired()
+
+	select {
+	case <-runCh:
+		t.Fatal("task restarted before its previous run returned")
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	close(releaseCh)
+
+	select {
+	case <-runCh:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("task not restarted after its previous run returned")
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// TestController drives a controller synchronously, for use in tests that need
+// to assert precise sequencing of reconciles.
+//
+// Unlike the Manager, the TestController does not watch the resource service or
+// run any background goroutines. Instead, tests feed it resource events with
+// Notify (or requests with Enqueue) and then explicitly reconcile the pending
+// requests one at a time with Step, in the order in which they were queued.
+// Failed reconciles (including RequeueAfterError) are returned to the caller
+// rather than being retried, so that the test decides what happens next.
+type TestController struct {
+	ctrl    Controller
+	runtime Runtime
+
+	pending []Request
+	queued  map[string]struct{}
+}
+
+// NewTestController creates a TestController for the given controller. The
+// client is passed to the reconciler and dependency mappers via the Runtime.
+func NewTestController(ctrl Controller, client pbresource.ResourceServiceClient) *TestController {
+	if ctrl.reconciler == nil {
+		panic(fmt.Sprintf("cannot test controller without a reconciler %s", ctrl))
+	}
+
+	logger := ctrl.logger
+	if logger == nil {
+		logger = hclog.NewNullLogger()
+	}
+
+	return &TestController{
+		ctrl: ctrl,
+		runtime: Runtime{
+			Client: client,
+			Logger: logger,
+		},
+		queued: make(map[string]struct{}),
+	}
+}
+
+// Runtime returns the Runtime passed to the reconciler and dependency mappers.
+func (c *TestController) Runtime() Runtime {
+	return c.runtime
+}
+
+// Enqueue adds the given requests to the end of the queue. As with the Manager,
+// requests for a resource that is already queued are de-duplicated.
+func (c *TestController) Enqueue(reqs ...Request) {
+	for _, req := range reqs {
+		key := req.Key()
+		if _, ok := c.queued[key]; ok {
+			continue
+		}
+		c.queued[key] = struct{}{}
+		c.pending = append(c.pending, req)
+	}
+}
+
+// Notify simulates a watch event for the given resource. If the resource is of
+// the managed type a request to reconcile it is queued, and the mappers of any
+// watches on the resource's type are called synchronously to queue the
+// requests they return.
+func (c *TestController) Notify(ctx context.Context, res *pbresource.Resource) error {
+	if resource.EqualType(res.Id.Type, c.ctrl.managedType) {
+		c.Enqueue(Request{ID: res.Id})
+	}
+
+	for _, w := range c.ctrl.watches {
+		if !resource.EqualType(res.Id.Type, w.watchedType) {
+			continue
+		}
+
+		reqs, err := w.mapper(ctx, c.runtime, res)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range reqs {
+			if !resource.EqualType(r.ID.Type, c.ctrl.managedType) {
+				return fmt.Errorf("dependency mapper returned request for a resource of the wrong type: expected %s, got %s",
+					resource.ToGVK(c.ctrl.managedType),
+					resource.ToGVK(r.ID.Type),
+				)
+			}
+			c.Enqueue(r)
+		}
+	}
+	return nil
+}
+
+// Pending returns the requests that are queued, in the order they will be
+// reconciled.
+func (c *TestController) Pending() []Request {
+	return append([]Request(nil), c.pending...)
+}
+
+// Step reconciles the request at the front of the queue and returns it along
+// with the reconciler's error. ok is false if there were no pending requests.
+func (c *TestController) Step(ctx context.Context) (req Request, ok bool, err error) {
+	if len(c.pending) == 0 {
+		return Request{}, false, nil
+	}
+
+	req = c.pending[0]
+	c.pending = c.pending[1:]
+	delete(c.queued, req.Key())
+
+	return req, true, c.ctrl.reconciler.Reconcile(ctx, c.runtime, req)
+}
+
+// Drain calls Step until the queue is empty, stopping at the first error.
+func (c *TestController) Drain(ctx context.Context) error {
+	for {
+		req, ok, err := c.Step(ctx)
+		if !ok {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to reconcile %s: %w", resource.IDToString(req.ID), err)
+		}
+	}
+}
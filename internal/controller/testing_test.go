@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	svctest "github.com/hashicorp/consul/agent/grpc-external/services/resource/testing"
+	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/resource/demo"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/prototest"
+)
+
+func TestTestController(t *testing.T) {
+	client := svctest.RunResourceService(t, demo.RegisterTypes)
+	ctx := testContext(t)
+
+	rec := &recordingReconciler{}
+	tc := controller.NewTestController(
+		controller.ForType(demo.TypeV2Artist).
+			WithWatch(demo.TypeV2Album, controller.MapOwner).
+			WithReconciler(rec),
+		client,
+	)
+
+	artist1 := writeArtist(t, client)
+	artist2 := writeArtist(t, client)
+
+	album, err := demo.GenerateV2Album(artist1.Id)
+	require.NoError(t, err)
+	rsp, err := client.Write(ctx, &pbresource.WriteRequest{Resource: album})
+	require.NoError(t, err)
+	album = rsp.Resource
+
+	// Nothing happens until the test drives the controller.
+	_, ok, err := tc.Step(ctx)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, tc.Notify(ctx, artist2))
+	require.NoError(t, tc.Notify(ctx, album))
+	// Requests for a resource that is already queued are de-duplicated.
+	require.NoError(t, tc.Notify(ctx, artist1))
+	require.NoError(t, tc.Notify(ctx, artist2))
+	require.Len(t, tc.Pending(), 2)
+
+	// Requests are reconciled one at a time, in the order they were queued.
+	req, ok, err := tc.Step(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	prototest.AssertDeepEqual(t, artist2.Id, req.ID)
+	require.Len(t, rec.calls, 1)
+
+	rec.err = errors.New("KABOOM")
+	req, ok, err = tc.Step(ctx)
+	require.ErrorIs(t, err, rec.err)
+	require.True(t, ok)
+	prototest.AssertDeepEqual(t, artist1.Id, req.ID)
+
+	// Failed requests are not retried automatically.
+	require.Empty(t, tc.Pending())
+	rec.err = nil
+
+	tc.Enqueue(controller.Request{ID: artist1.Id}, controller.Request{ID: artist2.Id})
+	require.NoError(t, tc.Drain(ctx))
+	require.Empty(t, tc.Pending())
+	require.Len(t, rec.calls, 4)
+}
+
+type recordingReconciler struct {
+	calls []controller.Request
+	err   error
+}
+
+func (r *recordingReconciler) Reconcile(_ context.Context, _ controller.Runtime, req controller.Request) error {
+	r.calls = append(r.calls, req)
+	return r.err
+}
+
+func writeArtist(t *testing.T, client pbresource.ResourceServiceClient) *pbresource.Resource {
+	t.Helper()
+
+	res, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+
+	rsp, err := client.Write(testContext(t), &pbresource.WriteRequest{Resource: res})
+	require.NoError(t, err)
+	return rsp.Resource
+}
@@ -42,7 +42,8 @@ func (d *DecodedResource[T]) GetData() T {
 // Decode will generically decode the provided resource into a 2-field
 // structure that holds onto the original Resource and the decoded contents.
 //
-// Returns an ErrDataParse on unmarshalling errors.
+// Returns an ErrDataParse, which has the InvalidArgument gRPC code, on
+// unmarshalling errors, including when the data is not of type T.
 func Decode[T proto.Message](res *pbresource.Resource) (*DecodedResource[T], error) {
 	var zero T
 	data := zero.ProtoReflect().New().Interface().(T)
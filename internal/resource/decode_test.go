@@ -8,12 +8,15 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	svctest "github.com/hashicorp/consul/agent/grpc-external/services/resource/testing"
 	"github.com/hashicorp/consul/internal/resource"
 	"github.com/hashicorp/consul/internal/resource/demo"
 	rtest "github.com/hashicorp/consul/internal/resource/resourcetest"
+	pbcatalog "github.com/hashicorp/consul/proto-public/pbcatalog/v2beta1"
 	"github.com/hashicorp/consul/proto-public/pbresource"
 	pbdemo "github.com/hashicorp/consul/proto/private/pbdemo/v2"
 	"github.com/hashicorp/consul/proto/private/prototest"
@@ -111,4 +114,46 @@ func TestDecode(t *testing.T) {
 		_, err := resource.Decode[*pbdemo.Artist](foo)
 		require.Error(t, err)
 	})
+
+	t.Run("node round trip", func(t *testing.T) {
+		data := &pbcatalog.Node{
+			Addresses: []*pbcatalog.NodeAddress{{Host: "198.18.0.1"}},
+		}
+		res := rtest.Resource(pbcatalog.NodeType, "node-1").
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, data).
+			Build()
+
+		dec, err := resource.Decode[*pbcatalog.Node](res)
+		require.NoError(t, err)
+		prototest.AssertDeepEqual(t, res, dec.Resource)
+		prototest.AssertDeepEqual(t, data, dec.Data)
+	})
+
+	t.Run("health status round trip", func(t *testing.T) {
+		data := &pbcatalog.HealthStatus{
+			Type:   "serf",
+			Status: pbcatalog.Health_HEALTH_CRITICAL,
+		}
+		res := rtest.Resource(pbcatalog.HealthStatusType, "serf").
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, data).
+			Build()
+
+		dec, err := resource.Decode[*pbcatalog.HealthStatus](res)
+		require.NoError(t, err)
+		prototest.AssertDeepEqual(t, res, dec.Resource)
+		prototest.AssertDeepEqual(t, data, dec.Data)
+	})
+
+	t.Run("mismatched type", func(t *testing.T) {
+		res := rtest.Resource(pbcatalog.NodeType, "node-1").
+			WithTenancy(resource.DefaultNamespacedTenancy()).
+			WithData(t, &pbcatalog.HealthStatus{Type: "serf"}).
+			Build()
+
+		_, err := resource.Decode[*pbcatalog.Node](res)
+		require.ErrorAs(t, err, &resource.ErrDataParse{})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
 }
@@ -51,11 +51,24 @@ func EqualID(a, b *pbresource.ID) bool {
 		a.Uid == b.Uid
 }
 
-// EqualStatus compares two statuses for equality without reflection.
+// EqualStatus compares two statuses for equality without reflection. Their
+// conditions are compared regardless of order, as by EqualConditions.
 //
 // Pass true for compareUpdatedAt to compare the UpdatedAt timestamps, which you
 // generally *don't* want when dirty checking the status in a controller.
 func EqualStatus(a, b *pbresource.Status, compareUpdatedAt bool) bool {
+	return equalStatus(a, b, compareUpdatedAt, true)
+}
+
+// EqualStatusIgnoringGeneration is like EqualStatus, but ignores the statuses'
+// ObservedGeneration and UpdatedAt timestamps. It is useful to check whether a
+// status computed for a newer generation of a resource says anything new, e.g.
+// when the resource's spec changed in a way the controller doesn't care about.
+func EqualStatusIgnoringGeneration(a, b *pbresource.Status) bool {
+	return equalStatus(a, b, false, false)
+}
+
+func equalStatus(a, b *pbresource.Status, compareUpdatedAt, compareGeneration bool) bool {
 	if a == b {
 		return true
 	}
@@ -64,7 +77,7 @@ func EqualStatus(a, b *pbresource.Status, compareUpdatedAt bool) bool {
 		return false
 	}
 
-	if a.ObservedGeneration != b.ObservedGeneration {
+	if compareGeneration && a.ObservedGeneration != b.ObservedGeneration {
 		return false
 	}
 
@@ -72,18 +85,52 @@ func EqualStatus(a, b *pbresource.Status, compareUpdatedAt bool) bool {
 		return false
 	}
 
-	if len(a.Conditions) != len(b.Conditions) {
+	if a.Ttl.AsDuration() != b.Ttl.AsDuration() {
 		return false
 	}
 
-	for i, ac := range a.Conditions {
-		bc := b.Conditions[i]
+	if !EqualReconcileError(a.LastReconcileError, b.LastReconcileError) {
+		return false
+	}
 
-		if !EqualCondition(ac, bc) {
-			return false
+	return EqualConditions(a.Conditions, b.Conditions)
+}
+
+// EqualConditions compares two lists of conditions for equality without
+// reflection, regardless of their order.
+func EqualConditions(a, b []*pbresource.Condition) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	// Fast path for the common case of conditions written in the same order.
+	inOrder := true
+	for i := range a {
+		if !EqualCondition(a[i], b[i]) {
+			inOrder = false
+			break
 		}
 	}
+	if inOrder {
+		return true
+	}
 
+	// Statuses have a handful of conditions at most, so a quadratic search is
+	// cheaper than building an index.
+	matched := make([]bool, len(b))
+	for _, ac := range a {
+		found := false
+		for i, bc := range b {
+			if !matched[i] && EqualCondition(ac, bc) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
 	return true
 }
 
@@ -104,6 +151,21 @@ func EqualCondition(a, b *pbresource.Condition) bool {
 		EqualReference(a.Resource, b.Resource)
 }
 
+// EqualReconcileError compares two reconcile errors for equality without
+// reflection.
+func EqualReconcileError(a, b *pbresource.ReconcileError) bool {
+	if a == b {
+		return true
+	}
+
+	if a == nil || b == nil {
+		return false
+	}
+
+	return a.Message == b.Message &&
+		a.OccurredAt.AsTime().Equal(b.OccurredAt.AsTime())
+}
+
 // EqualReference compares two references for equality without reflection.
 func EqualReference(a, b *pbresource.Reference) bool {
 	if a == b {
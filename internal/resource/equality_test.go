@@ -11,6 +11,7 @@ import (
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/hashicorp/consul/internal/resource"
@@ -612,6 +613,11 @@ func TestEqualStatus(t *testing.T) {
 				},
 			},
 		},
+		LastReconcileError: &pbresource.ReconcileError{
+			Message:    "foo failed",
+			OccurredAt: timestamppb.Now(),
+		},
+		Ttl: durationpb.New(1 * time.Minute),
 	}
 
 	// Equal cases.
@@ -666,6 +672,21 @@ func TestEqualStatus(t *testing.T) {
 		"different Condition.Resource.Section": func(s *pbresource.Status) {
 			s.Conditions[0].Resource.Section = "bar-section"
 		},
+		"nil LastReconcileError": func(s *pbresource.Status) {
+			s.LastReconcileError = nil
+		},
+		"different LastReconcileError.Message": func(s *pbresource.Status) {
+			s.LastReconcileError.Message = "bar failed"
+		},
+		"different LastReconcileError.OccurredAt": func(s *pbresource.Status) {
+			s.LastReconcileError.OccurredAt = timestamppb.New(s.LastReconcileError.OccurredAt.AsTime().Add(1 * time.Minute))
+		},
+		"nil Ttl": func(s *pbresource.Status) {
+			s.Ttl = nil
+		},
+		"different Ttl": func(s *pbresource.Status) {
+			s.Ttl = durationpb.New(2 * time.Minute)
+		},
 	}
 	for desc, modFn := range testCases {
 		t.Run(desc, func(t *testing.T) {
@@ -690,6 +711,115 @@ func TestEqualStatus(t *testing.T) {
 		require.True(t, resource.EqualStatus(a, b, false))
 		require.True(t, resource.EqualStatus(b, a, false))
 	})
+
+	t.Run("reordered Conditions", func(t *testing.T) {
+		a, b := clone(orig), clone(orig)
+		bar := clone(orig).Conditions[0]
+		bar.Type = "BarType"
+		a.Conditions = append(a.Conditions, bar)
+		b.Conditions = append([]*pbresource.Condition{bar}, b.Conditions...)
+		require.True(t, resource.EqualStatus(a, b, true))
+		require.True(t, resource.EqualStatus(b, a, true))
+	})
+}
+
+func TestEqualStatusIgnoringGeneration(t *testing.T) {
+	orig := &pbresource.Status{
+		ObservedGeneration: ulid.Make().String(),
+		UpdatedAt:          timestamppb.Now(),
+		Conditions: []*pbresource.Condition{
+			{
+				Type:    "FooType",
+				State:   pbresource.Condition_STATE_TRUE,
+				Reason:  "FooReason",
+				Message: "Foo is true",
+			},
+		},
+	}
+
+	t.Run("different ObservedGeneration and UpdatedAt", func(t *testing.T) {
+		a, b := clone(orig), clone(orig)
+		b.ObservedGeneration = ulid.Make().String()
+		b.UpdatedAt = timestamppb.New(b.UpdatedAt.AsTime().Add(1 * time.Minute))
+		require.True(t, resource.EqualStatusIgnoringGeneration(a, b))
+		require.True(t, resource.EqualStatusIgnoringGeneration(b, a))
+		require.False(t, resource.EqualStatus(a, b, false))
+	})
+
+	t.Run("different Condition.Message", func(t *testing.T) {
+		a, b := clone(orig), clone(orig)
+		b.ObservedGeneration = ulid.Make().String()
+		b.Conditions[0].Message = "Foo is still true"
+		require.False(t, resource.EqualStatusIgnoringGeneration(a, b))
+		require.False(t, resource.EqualStatusIgnoringGeneration(b, a))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		require.True(t, resource.EqualStatusIgnoringGeneration(nil, nil))
+		require.False(t, resource.EqualStatusIgnoringGeneration(orig, nil))
+		require.False(t, resource.EqualStatusIgnoringGeneration(nil, orig))
+	})
+}
+
+func TestEqualConditions(t *testing.T) {
+	foo := &pbresource.Condition{
+		Type:    "FooType",
+		State:   pbresource.Condition_STATE_TRUE,
+		Reason:  "FooReason",
+		Message: "Foo is true",
+	}
+	bar := &pbresource.Condition{
+		Type:    "BarType",
+		State:   pbresource.Condition_STATE_FALSE,
+		Reason:  "BarReason",
+		Message: "Bar is false",
+	}
+	withMessage := func(c *pbresource.Condition, msg string) *pbresource.Condition {
+		c = clone(c)
+		c.Message = msg
+		return c
+	}
+
+	testCases := map[string]struct {
+		a, b  []*pbresource.Condition
+		equal bool
+	}{
+		"both empty": {
+			equal: true,
+		},
+		"equal": {
+			a:     []*pbresource.Condition{foo, bar},
+			b:     []*pbresource.Condition{clone(foo), clone(bar)},
+			equal: true,
+		},
+		"reordered": {
+			a:     []*pbresource.Condition{foo, bar},
+			b:     []*pbresource.Condition{bar, foo},
+			equal: true,
+		},
+		"different Message": {
+			a: []*pbresource.Condition{foo, bar},
+			b: []*pbresource.Condition{bar, withMessage(foo, "Foo is still true")},
+		},
+		"different length": {
+			a: []*pbresource.Condition{foo, bar},
+			b: []*pbresource.Condition{foo},
+		},
+		"duplicates": {
+			a: []*pbresource.Condition{foo, foo},
+			b: []*pbresource.Condition{foo, bar},
+		},
+		"nil Condition": {
+			a: []*pbresource.Condition{foo},
+			b: []*pbresource.Condition{nil},
+		},
+	}
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			require.Equal(t, tc.equal, resource.EqualConditions(tc.a, tc.b))
+			require.Equal(t, tc.equal, resource.EqualConditions(tc.b, tc.a))
+		})
+	}
 }
 
 func TestEqualStatusMap(t *testing.T) {
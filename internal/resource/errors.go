@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"strings"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/hashicorp/consul/proto-public/pbresource"
@@ -62,6 +65,13 @@ func (err ErrDataParse) Unwrap() error {
 	return err.Wrapped
 }
 
+// GRPCStatus gives the error the InvalidArgument code when it is returned from a
+// gRPC handler or inspected with status.Code, e.g. when the resource's data is
+// of a different type than the one it was decoded into.
+func (err ErrDataParse) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, err.Error())
+}
+
 type ErrInvalidField struct {
 	Name    string
 	Wrapped error
@@ -179,3 +189,112 @@ func (err ErrInvalidFields) Error() string {
 func (err ErrInvalidFields) Unwrap() error {
 	return err.Wrapped
 }
+
+// FieldViolation describes why a single field of a resource is invalid.
+type FieldViolation struct {
+	// FieldPath is the path to the field within the resource's data, such as
+	// "addresses[0].host". It is empty when the violation doesn't relate to a
+	// specific field.
+	FieldPath string
+
+	// Message describes the violation.
+	Message string
+}
+
+// ValidationError is a failed validation broken down into the violations of
+// individual fields, so that clients can report them next to the right fields.
+//
+// When returned from a gRPC handler, it has the InvalidArgument code and the
+// violations are carried by an errdetails.BadRequest status detail.
+type ValidationError struct {
+	Violations []FieldViolation
+	Wrapped    error
+}
+
+// NewValidationError breaks down an error returned by a validation hook into
+// field violations, using the names of the ErrInvalidField,
+// ErrInvalidListElement, ErrInvalidMapKey, ErrInvalidMapValue and
+// ErrInvalidFields errors it's made of as field paths.
+func NewValidationError(err error) ValidationError {
+	return ValidationError{
+		Violations: fieldViolations("", err),
+		Wrapped:    err,
+	}
+}
+
+func (err ValidationError) Error() string {
+	return err.Wrapped.Error()
+}
+
+func (err ValidationError) Unwrap() error {
+	return err.Wrapped
+}
+
+// GRPCStatus gives the error the InvalidArgument code and attaches its field
+// violations to the status.
+func (err ValidationError) GRPCStatus() *status.Status {
+	st := status.New(codes.InvalidArgument, err.Error())
+
+	detail := &errdetails.BadRequest{}
+	for _, v := range err.Violations {
+		detail.FieldViolations = append(detail.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.FieldPath,
+			Description: v.Message,
+		})
+	}
+	if withDetails, derr := st.WithDetails(detail); derr == nil {
+		st = withDetails
+	}
+	return st
+}
+
+func fieldViolations(path string, err error) []FieldViolation {
+	if err == nil {
+		// e.g. an ErrInvalidField without a wrapped error.
+		return []FieldViolation{{FieldPath: path, Message: "invalid value"}}
+	}
+
+	switch e := err.(type) {
+	case ErrInvalidField:
+		return fieldViolations(joinFieldPath(path, e.Name), e.Wrapped)
+	case ErrInvalidListElement:
+		return fieldViolations(fmt.Sprintf("%s[%d]", joinFieldPath(path, e.Name), e.Index), e.Wrapped)
+	case ErrInvalidMapValue:
+		return fieldViolations(fmt.Sprintf("%s[%q]", joinFieldPath(path, e.Map), e.Key), e.Wrapped)
+	case ErrInvalidMapKey:
+		return []FieldViolation{{
+			FieldPath: joinFieldPath(path, e.Map),
+			Message:   fmt.Sprintf("invalid key %q: %v", e.Key, e.Wrapped),
+		}}
+	case ErrInvalidFields:
+		var violations []FieldViolation
+		for _, name := range e.Names {
+			violations = append(violations, fieldViolations(joinFieldPath(path, name), e.Wrapped)...)
+		}
+		return violations
+	}
+
+	// Errors collected with go-multierror or errors.Join.
+	var errs []error
+	switch e := err.(type) {
+	case interface{ WrappedErrors() []error }:
+		errs = e.WrappedErrors()
+	case interface{ Unwrap() []error }:
+		errs = e.Unwrap()
+	default:
+		return []FieldViolation{{FieldPath: path, Message: err.Error()}}
+	}
+
+	var violations []FieldViolation
+	for _, err := range errs {
+		violations = append(violations, fieldViolations(path, err)...)
+	}
+	return violations
+}
+
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
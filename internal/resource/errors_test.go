@@ -11,9 +11,14 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/prototest"
 )
 
 // update allows golden files to be updated based on the current output.
@@ -123,3 +128,48 @@ func TestErrorUnwrap(t *testing.T) {
 		})
 	}
 }
+
+func TestValidationError(t *testing.T) {
+	var err error
+	err = multierror.Append(err, ErrInvalidField{Name: "name", Wrapped: ErrMissing})
+	err = multierror.Append(err, ErrInvalidListElement{
+		Name:  "addresses",
+		Index: 1,
+		Wrapped: ErrInvalidField{
+			Name:    "host",
+			Wrapped: ErrEmpty,
+		},
+	})
+	err = multierror.Append(err, ErrInvalidMapValue{
+		Map: "ports",
+		Key: "http",
+		Wrapped: errors.Join(
+			ErrInvalidField{Name: "port", Wrapped: ErrMissing},
+			ErrInvalidFields{Names: []string{"protocol", "target"}, Wrapped: ErrUnsupported},
+		),
+	})
+	err = multierror.Append(err, ErrInvalidMapKey{Map: "ports", Key: "", Wrapped: ErrEmpty})
+	err = multierror.Append(err, ErrMissingOneOf)
+
+	verr := NewValidationError(err)
+	require.Equal(t, []FieldViolation{
+		{FieldPath: "name", Message: "missing required field"},
+		{FieldPath: "addresses[1].host", Message: "cannot be empty"},
+		{FieldPath: `ports["http"].port`, Message: "missing required field"},
+		{FieldPath: `ports["http"].protocol`, Message: "field is currently not supported"},
+		{FieldPath: `ports["http"].target`, Message: "field is currently not supported"},
+		{FieldPath: "ports", Message: `invalid key "": cannot be empty`},
+		{FieldPath: "", Message: "missing one of the required fields"},
+	}, verr.Violations)
+	require.Equal(t, err.Error(), verr.Error())
+	require.ErrorIs(t, verr, ErrMissingOneOf)
+
+	st, ok := status.FromError(verr)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	prototest.AssertDeepEqual(t, &errdetails.BadRequest_FieldViolation{
+		Field:       "addresses[1].host",
+		Description: "cannot be empty",
+	}, st.Details()[0].(*errdetails.BadRequest).FieldViolations[1])
+}
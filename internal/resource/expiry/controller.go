@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package expiry
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// ControllerName returns the name under which the sweeper for the given type
+// is registered with the controller Manager.
+func ControllerName(typ *pbresource.Type) string {
+	return "consul.io/expiry-sweeper/" + resource.ToGVK(typ)
+}
+
+// SweeperController returns a controller that deletes resources of the given
+// type once the expiry stored in their metadata (see resource.ExpireAtKey)
+// has passed. Resources without an expiry are left alone, and rewriting a
+// resource with a later expiry before it passes keeps it alive.
+func SweeperController(typ *pbresource.Type) controller.Controller {
+	return controller.ForType(typ).
+		WithName(ControllerName(typ)).
+		WithReconciler(newReconciler())
+}
+
+func newReconciler() *sweeperReconciler {
+	return &sweeperReconciler{
+		timeNow: time.Now,
+	}
+}
+
+type sweeperReconciler struct {
+	// Testing shim
+	timeNow func() time.Time
+}
+
+func (r *sweeperReconciler) Reconcile(ctx context.Context, rt controller.Runtime, req controller.Request) error {
+	rsp, err := rt.Client.Read(ctx, &pbresource.ReadRequest{Id: req.ID})
+	switch {
+	case status.Code(err) == codes.NotFound:
+		// already deleted. nothing to do
+		return nil
+	case err != nil:
+		return err
+	}
+	res := rsp.Resource
+
+	expireAt, ok, err := resource.ExpireAt(res)
+	if err != nil {
+		// Writes with an invalid expiry are rejected, so this can only be a
+		// resource written before expiry was supported. Retrying won't help.
+		rt.Logger.Warn("ignoring invalid resource expiry", "resource_id", res.Id, "error", err)
+		return nil
+	}
+	if !ok {
+		return nil
+	}
+
+	if remaining := expireAt.Sub(r.timeNow()); remaining > 0 {
+		return controller.RequeueAfter(remaining)
+	}
+
+	// Delete the version we read, so that a resource refreshed with a later
+	// expiry in the meantime survives. The resulting Aborted error retries the
+	// reconcile, which will read the new expiry.
+	_, err = rt.Client.Delete(ctx, &pbresource.DeleteRequest{Id: res.Id, Version: res.Version})
+	if err != nil {
+		return err
+	}
+	rt.Logger.Trace("deleted expired resource", "resource_id", res.Id, "expire_at", expireAt)
+	return nil
+}
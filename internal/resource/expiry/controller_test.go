@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package expiry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	svctest "github.com/hashicorp/consul/agent/grpc-external/services/resource/testing"
+	"github.com/hashicorp/consul/internal/controller"
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/resource/demo"
+	"github.com/hashicorp/consul/internal/resource/resourcetest"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/sdk/testutil"
+)
+
+func TestReconcile(t *testing.T) {
+	client := svctest.RunResourceServiceWithTenancies(t, demo.RegisterTypes)
+
+	for _, tenancy := range resourcetest.TestTenancies() {
+		ctx := testutil.TestContext(t)
+		now := time.Now()
+		rec := newReconciler()
+		rec.timeNow = func() time.Time { return now }
+		runtime := controller.Runtime{
+			Client: client,
+			Logger: testutil.Logger(t),
+		}
+
+		writeArtist := func(metadata map[string]string) *pbresource.Resource {
+			res, err := demo.GenerateV2Artist()
+			require.NoError(t, err)
+			res.Id.Tenancy = tenancy
+			res.Metadata = metadata
+			rsp, err := client.Write(ctx, &pbresource.WriteRequest{Resource: res})
+			require.NoError(t, err)
+			return rsp.Resource
+		}
+		requireExists := func(id *pbresource.ID) {
+			_, err := client.Read(ctx, &pbresource.ReadRequest{Id: id})
+			require.NoError(t, err)
+		}
+		expireAt := now.Add(time.Minute).Format(time.RFC3339)
+
+		// Resources without an expiry are never deleted.
+		permanent := writeArtist(nil)
+		require.NoError(t, rec.Reconcile(ctx, runtime, controller.Request{ID: permanent.Id}))
+		requireExists(permanent.Id)
+
+		// Resources are requeued until their expiry passes.
+		ephemeral := writeArtist(map[string]string{resource.ExpireAtKey: expireAt})
+		err := rec.Reconcile(ctx, runtime, controller.Request{ID: ephemeral.Id})
+		var requeue controller.RequeueAfterError
+		require.ErrorAs(t, err, &requeue)
+		require.LessOrEqual(t, time.Duration(requeue), time.Minute)
+		requireExists(ephemeral.Id)
+
+		// Once it passes, they're deleted.
+		now = now.Add(2 * time.Minute)
+		require.NoError(t, rec.Reconcile(ctx, runtime, controller.Request{ID: ephemeral.Id}))
+		_, err = client.Read(ctx, &pbresource.ReadRequest{Id: ephemeral.Id})
+		require.Equal(t, codes.NotFound.String(), status.Code(err).String())
+
+		// Reconcile again to verify no-op on an already deleted resource.
+		require.NoError(t, rec.Reconcile(ctx, runtime, controller.Request{ID: ephemeral.Id}))
+		requireExists(permanent.Id)
+	}
+}
+
+func TestController(t *testing.T) {
+	client := svctest.RunResourceService(t, demo.RegisterTypes)
+
+	mgr := controller.NewManager(client, testutil.Logger(t))
+	mgr.Register(SweeperController(demo.TypeV2Artist))
+	mgr.SetRaftLeader(true)
+	go mgr.Run(testutil.TestContext(t))
+
+	res, err := demo.GenerateV2Artist()
+	require.NoError(t, err)
+	res.Metadata = map[string]string{resource.ExpireAtKey: time.Now().Add(time.Second).Format(time.RFC3339)}
+	rsp, err := client.Write(testutil.TestContext(t), &pbresource.WriteRequest{Resource: res})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		_, err := client.Read(testutil.TestContext(t), &pbresource.ReadRequest{Id: rsp.Resource.Id})
+		return status.Code(err) == codes.NotFound
+	}, 5*time.Second, 100*time.Millisecond)
+}
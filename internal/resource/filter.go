@@ -5,8 +5,10 @@ package resource
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/hashicorp/go-bexpr"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/hashicorp/consul/proto-public/pbresource"
 )
@@ -103,3 +105,64 @@ func createMetadataFilterEvaluator(filter string) (*bexpr.Evaluator, error) {
 type metadataFilterFieldDetails struct {
 	Meta map[string]string `bexpr:"metadata"`
 }
+
+// DataFilter is a compiled go-bexpr filter matching resources by their
+// metadata and decoded data.
+type DataFilter struct {
+	eval       *bexpr.Evaluator
+	dataType   reflect.Type
+	fieldsType reflect.Type
+}
+
+// NewDataFilter compiles filter for resources whose data is of the same type
+// as data. Metadata keys are prefixed by "metadata." and fields of the data by
+// "data.", using the Go field names of the data's type.
+func NewDataFilter(filter string, data proto.Message) (*DataFilter, error) {
+	dataType := reflect.TypeOf(data)
+	fieldsType := reflect.StructOf([]reflect.StructField{
+		{Name: "Meta", Type: reflect.TypeOf(map[string]string{}), Tag: `bexpr:"metadata"`},
+		{Name: "Data", Type: dataType, Tag: `bexpr:"data"`},
+	})
+
+	f := &DataFilter{dataType: dataType, fieldsType: fieldsType}
+	sample, err := f.fields(&pbresource.Resource{})
+	if err != nil {
+		return nil, err
+	}
+	eval, err := bexpr.CreateEvaluatorForType(filter, nil, sample)
+	if err != nil {
+		return nil, fmt.Errorf("filter %q is invalid: %w", filter, err)
+	}
+	f.eval = eval
+	return f, nil
+}
+
+// Matches reports whether res matches the filter. res's data must be of the
+// type the filter was compiled for.
+func (f *DataFilter) Matches(res *pbresource.Resource) (bool, error) {
+	fields, err := f.fields(res)
+	if err != nil {
+		return false, err
+	}
+	return f.eval.Evaluate(fields)
+}
+
+// fields returns the variables the filter is evaluated against for res.
+func (f *DataFilter) fields(res *pbresource.Resource) (interface{}, error) {
+	data := reflect.New(f.dataType.Elem())
+	if res.Data != nil {
+		if err := res.Data.UnmarshalTo(data.Interface().(proto.Message)); err != nil {
+			return nil, NewErrDataParse(data.Interface().(proto.Message), err)
+		}
+	}
+
+	meta := res.Metadata
+	if meta == nil {
+		meta = make(map[string]string)
+	}
+
+	fields := reflect.New(f.fieldsType)
+	fields.Elem().Field(0).Set(reflect.ValueOf(meta))
+	fields.Elem().Field(1).Set(data)
+	return fields.Interface(), nil
+}
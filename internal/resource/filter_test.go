@@ -7,8 +7,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/hashicorp/consul/proto-public/pbresource"
+	pbdemo "github.com/hashicorp/consul/proto/private/pbdemo/v2"
 	"github.com/hashicorp/consul/proto/private/prototest"
 	"github.com/hashicorp/consul/sdk/testutil"
 )
@@ -193,3 +195,67 @@ func TestFilterMatchesResourceMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestDataFilter(t *testing.T) {
+	create := func(name string, region string) *pbresource.Resource {
+		data, err := anypb.New(&pbdemo.Artist{Name: name, Genre: pbdemo.Genre_GENRE_JAZZ})
+		require.NoError(t, err)
+		return &pbresource.Resource{
+			Id:       &pbresource.ID{Name: name},
+			Metadata: map[string]string{"region": region},
+			Data:     data,
+		}
+	}
+	// Metadata is optional.
+	noMeta := create("no-meta", "")
+	noMeta.Metadata = nil
+
+	cases := map[string]struct {
+		res       *pbresource.Resource
+		filter    string
+		expect    bool
+		expectErr string
+	}{
+		"bad filter": {
+			filter:    "data.Label == zzz",
+			expectErr: `Selector "data.Label" is not valid`,
+		},
+		"metadata": {
+			res:    create("Miles Davis", "us-east"),
+			filter: `metadata.region == "us-east"`,
+			expect: true,
+		},
+		"metadata no match": {
+			res:    create("Miles Davis", "us-west"),
+			filter: `metadata.region == "us-east"`,
+		},
+		"data": {
+			res:    create("Miles Davis", "us-east"),
+			filter: `data.Name == "Miles Davis"`,
+			expect: true,
+		},
+		"data and metadata": {
+			res:    create("Miles Davis", "us-west"),
+			filter: `data.Name == "Miles Davis" and metadata.region == "us-east"`,
+		},
+		"no metadata": {
+			res:    noMeta,
+			filter: `data.Name == "no-meta"`,
+			expect: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			filter, err := NewDataFilter(tc.filter, &pbdemo.Artist{})
+			if tc.expectErr != "" {
+				testutil.RequireErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			match, err := filter.Matches(tc.res)
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, match)
+		})
+	}
+}
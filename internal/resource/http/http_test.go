@@ -460,10 +460,11 @@ func TestResourceDeleteHandler(t *testing.T) {
 	})
 
 	t.Run("should delete a resource with version", func(t *testing.T) {
-		createResource(t, handler, nil)
+		created := createResource(t, handler, nil)
+		version := created["version"].(string)
 
 		rsp := httptest.NewRecorder()
-		req := httptest.NewRequest("DELETE", "/demo/v2/artist/keith-urban?partition=default&peer_name=local&namespace=default&version=1", strings.NewReader(""))
+		req := httptest.NewRequest("DELETE", "/demo/v2/artist/keith-urban?partition=default&peer_name=local&namespace=default&version="+version, strings.NewReader(""))
 
 		req.Header.Add("x-consul-token", testACLTokenArtistWritePolicy)
 		req.Header.Add("x-consul-token", testACLTokenArtistListPolicy)
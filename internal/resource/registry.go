@@ -4,13 +4,16 @@
 package resource
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/internal/storage"
@@ -48,11 +51,41 @@ type Registry interface {
 // the compiler enforce this immutability.
 type ValidationHook func(*pbresource.Resource) error
 
+// ReferenceValidationHook is the function signature for a validation hook that
+// checks a resource against the other resources it refers to, e.g. that its
+// owner exists. Like ValidationHook it must not mutate the resource. Reads must
+// be made with the given context, which carries the writer's ACL token and is
+// cancelled once the registration's ValidateReferencesTimeout has elapsed.
+type ReferenceValidationHook func(context.Context, ResourceReader, *pbresource.Resource) error
+
+// ResourceReader is the read-only view of the resource service given to
+// reference validation hooks. Reads are authorized with the writer's ACL token,
+// so a hook can't observe resources the writer isn't allowed to read.
+type ResourceReader interface {
+	Read(ctx context.Context, req *pbresource.ReadRequest) (*pbresource.ReadResponse, error)
+	List(ctx context.Context, req *pbresource.ListRequest) (*pbresource.ListResponse, error)
+}
+
+// DefaultReferenceValidationTimeout is the time reference validation hooks are
+// given when their registration doesn't set ValidateReferencesTimeout.
+const DefaultReferenceValidationTimeout = 5 * time.Second
+
 // MutationHook is the function signature for a validation hook. These hooks can inspect
 // and mutate the resource. If modifying the resources Data, the hook needs to ensure that
 // the data gets reencoded and stored back to the Data field.
 type MutationHook func(*pbresource.Resource) error
 
+// MigrationHook is the function signature for a migration hook, which converts
+// a resource stored with another GroupVersion of its type to the registered
+// one. It is given a copy of the stored resource whose ID already has the
+// registered type, and must replace its Data with the registered Proto.
+type MigrationHook func(*pbresource.Resource) error
+
+// DerivedFieldFunc is the function signature for computing a derived field: a
+// value that isn't stored but is computed from the resource when it is read.
+// Like validation hooks, it must not mutate the resource.
+type DerivedFieldFunc func(*pbresource.Resource) (*structpb.Value, error)
+
 type Registration struct {
 	// Type is the GVK of the resource type.
 	Type *pbresource.Type
@@ -77,6 +110,56 @@ type Registration struct {
 
 	// Scope describes the tenancy scope of a resource.
 	Scope Scope
+
+	// DefaultReadConsistency is the consistency with which resources of this
+	// type are read when the request doesn't specify one via the
+	// x-consul-consistency-mode metadata. The zero value is EventualConsistency.
+	DefaultReadConsistency storage.ReadConsistency
+
+	// DerivedFields are functions computing the values of fields that aren't
+	// stored, keyed by field name. They are evaluated on Read when the request
+	// sets IncludeDerivedFields, so that clients don't need to reimplement the
+	// logic.
+	DerivedFields map[string]DerivedFieldFunc
+
+	// AllowOwnerChanges, when set, lets Write change the owner of an existing
+	// resource, resolving the claims of its old and new owners with the resource
+	// service's OwnerConflictPolicy. Otherwise a resource's owner can only be
+	// set on creation. Controllers that watch a type with MapOwner only see the
+	// new owner of a resource that is moved, so it shouldn't be set for types
+	// whose owners derive anything from them.
+	AllowOwnerChanges bool
+
+	// ValidateOwner, when set, makes Write verify that a resource's owner exists
+	// (including its Uid, if given) so that orphaned resources can't be written.
+	// The owner is checked when the resource is created or its owner changes.
+	ValidateOwner bool
+
+	// ValidateReferences is called on Write after Validate and the write ACL
+	// check, to validate the resource against other resources. Unlike Validate,
+	// it can read them.
+	ValidateReferences ReferenceValidationHook
+
+	// ValidateReferencesTimeout bounds the time ValidateReferences may take,
+	// after which the write fails with DeadlineExceeded. It defaults to
+	// DefaultReferenceValidationTimeout.
+	ValidateReferencesTimeout time.Duration
+
+	// Migrate, when set, is called on Read when the requested resource is stored
+	// with a different GroupVersion of this type, to convert it to this one.
+	// Without it, such reads fail with InvalidArgument.
+	Migrate MigrationHook
+
+	// hasACLHooks records whether any ACL hooks were given when the type was
+	// registered, before the defaults were filled in.
+	hasACLHooks bool
+}
+
+// HasACLHooks returns whether the type was registered with any ACL hooks of its
+// own, rather than relying entirely on the default operator:read and
+// operator:write permissions.
+func (r Registration) HasACLHooks() bool {
+	return r.hasACLHooks
 }
 
 var ErrNeedResource = errors.New("authorization check requires the entire resource")
@@ -159,6 +242,9 @@ func (r *TypeRegistry) Register(registration Registration) {
 		panic(fmt.Sprintf("resource type %s already registered", key))
 	}
 
+	registration.hasACLHooks = registration.ACLs != nil &&
+		(registration.ACLs.Read != nil || registration.ACLs.Write != nil || registration.ACLs.List != nil)
+
 	// set default acl hooks for those not provided
 	if registration.ACLs == nil {
 		registration.ACLs = &ACLHooks{}
@@ -189,6 +275,10 @@ func (r *TypeRegistry) Register(registration Registration) {
 		registration.Mutate = func(resource *pbresource.Resource) error { return nil }
 	}
 
+	if registration.ValidateReferences != nil && registration.ValidateReferencesTimeout <= 0 {
+		registration.ValidateReferencesTimeout = DefaultReferenceValidationTimeout
+	}
+
 	r.registrations[key] = registration
 }
 
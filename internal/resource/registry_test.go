@@ -4,6 +4,7 @@
 package resource_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -74,6 +75,22 @@ func TestRegister_Defaults(t *testing.T) {
 	require.NoError(t, reg.Mutate(nil))
 }
 
+func TestRegister_ValidateReferencesTimeoutDefault(t *testing.T) {
+	r := resource.NewRegistry()
+	r.Register(resource.Registration{
+		Type:  demo.TypeV2Artist,
+		Proto: &demov2.Artist{},
+		Scope: resource.ScopeNamespace,
+		ValidateReferences: func(context.Context, resource.ResourceReader, *pbresource.Resource) error {
+			return nil
+		},
+	})
+
+	reg, ok := r.Resolve(demo.TypeV2Artist)
+	require.True(t, ok)
+	require.Equal(t, resource.DefaultReferenceValidationTimeout, reg.ValidateReferencesTimeout)
+}
+
 func TestNewRegistry(t *testing.T) {
 	r := resource.NewRegistry()
 
@@ -6,8 +6,11 @@ package resource
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	mapset "github.com/deckarep/golang-set/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/hashicorp/consul/agent/dns"
 	"github.com/hashicorp/consul/proto-public/pbresource"
@@ -24,6 +27,12 @@ const DeletionTimestampKey = "deletionTimestamp"
 // list of finalizers.
 const FinalizerKey = "finalizers"
 
+// ExpireAtKey is the key in a resource's metadata that stores the RFC 3339
+// timestamp after which the resource expires. Expired resources are deleted by
+// the expiry sweeper of their type, if one is registered, unless they are
+// rewritten with a later expiry first.
+const ExpireAtKey = "expireAt"
+
 // ValidateName returns an error a name is not a valid resource name.
 // The error will contain reference to what constitutes a valid resource name.
 func ValidateName(name string) error {
@@ -43,6 +52,62 @@ func IsMarkedForDeletion(res *pbresource.Resource) bool {
 	return ok
 }
 
+// ExpireAt returns the time at which the resource expires, and whether it has
+// an expiry at all. An error is returned if the expiry isn't a valid RFC 3339
+// timestamp.
+func ExpireAt(res *pbresource.Resource) (time.Time, bool, error) {
+	value, ok := res.GetMetadata()[ExpireAtKey]
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	expireAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("%s must be an RFC 3339 timestamp: %w", ExpireAtKey, err)
+	}
+	return expireAt, true, nil
+}
+
+// IsStatusStale returns true if the given status has a TTL and hasn't been
+// updated within it as of now, false otherwise.
+func IsStatusStale(status *pbresource.Status, now time.Time) bool {
+	ttl := status.GetTtl().AsDuration()
+	if ttl <= 0 {
+		return false
+	}
+	return now.Sub(status.GetUpdatedAt().AsTime()) > ttl
+}
+
+// PreserveUnknownConditionFields copies the unknown fields of each condition in
+// existing onto the condition of the same type in updated, so that a controller
+// built against an older version of the Condition schema doesn't drop fields
+// set by newer components when it rewrites the status. Conditions in updated
+// are cloned before being modified, so they may be shared values.
+func PreserveUnknownConditionFields(existing, updated *pbresource.Status) {
+	if existing == nil || updated == nil {
+		return
+	}
+
+	unknown := make(map[string]protoreflect.RawFields)
+	for _, cond := range existing.Conditions {
+		if raw := cond.ProtoReflect().GetUnknown(); len(raw) != 0 {
+			unknown[cond.Type] = raw
+		}
+	}
+	if len(unknown) == 0 {
+		return
+	}
+
+	for idx, cond := range updated.Conditions {
+		raw, ok := unknown[cond.Type]
+		if !ok || len(cond.ProtoReflect().GetUnknown()) != 0 {
+			continue
+		}
+		cond = proto.Clone(cond).(*pbresource.Condition)
+		cond.ProtoReflect().SetUnknown(raw)
+		updated.Conditions[idx] = cond
+	}
+}
+
 // HasFinalizers returns true if a resource has one or more finalizers, false otherwise.
 func HasFinalizers(res *pbresource.Resource) bool {
 	return GetFinalizers(res).Cardinality() >= 1
@@ -5,15 +5,57 @@ package resource_test
 
 import (
 	"testing"
+	"time"
 
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/hashicorp/consul/internal/resource"
 	rtest "github.com/hashicorp/consul/internal/resource/resourcetest"
+	"github.com/hashicorp/consul/proto-public/pbresource"
 	pbtenancy "github.com/hashicorp/consul/proto-public/pbtenancy/v2beta1"
 )
 
+func TestPreserveUnknownConditionFields(t *testing.T) {
+	// A field added to Condition by a newer version of the schema.
+	extra := protowire.AppendTag(nil, 1000, protowire.BytesType)
+	extra = protowire.AppendString(extra, "extra")
+
+	existingCond := &pbresource.Condition{Type: "healthy", State: pbresource.Condition_STATE_TRUE}
+	existingCond.ProtoReflect().SetUnknown(extra)
+	existing := &pbresource.Status{
+		Conditions: []*pbresource.Condition{
+			existingCond,
+			{Type: "other"},
+		},
+	}
+
+	shared := &pbresource.Condition{Type: "healthy", State: pbresource.Condition_STATE_FALSE}
+	updated := &pbresource.Status{
+		Conditions: []*pbresource.Condition{
+			shared,
+			{Type: "other"},
+			{Type: "new"},
+		},
+	}
+	resource.PreserveUnknownConditionFields(existing, updated)
+
+	require.Equal(t, extra, []byte(updated.Conditions[0].ProtoReflect().GetUnknown()))
+	require.Equal(t, pbresource.Condition_STATE_FALSE, updated.Conditions[0].State)
+	require.Empty(t, updated.Conditions[1].ProtoReflect().GetUnknown())
+	require.Empty(t, updated.Conditions[2].ProtoReflect().GetUnknown())
+
+	// The original condition is left as it was.
+	require.Empty(t, shared.ProtoReflect().GetUnknown())
+
+	// Nil statuses are ignored.
+	resource.PreserveUnknownConditionFields(nil, updated)
+	resource.PreserveUnknownConditionFields(existing, nil)
+}
+
 func TestFinalizer(t *testing.T) {
 	t.Run("no finalizers", func(t *testing.T) {
 		res := rtest.Resource(pbtenancy.NamespaceType, "ns1").Build()
@@ -51,3 +93,32 @@ func TestFinalizer(t *testing.T) {
 	})
 
 }
+
+func TestIsStatusStale(t *testing.T) {
+	now := time.Now()
+	updatedAt := timestamppb.New(now.Add(-1 * time.Minute))
+
+	require.False(t, resource.IsStatusStale(nil, now))
+	require.False(t, resource.IsStatusStale(&pbresource.Status{UpdatedAt: updatedAt}, now))
+	require.False(t, resource.IsStatusStale(&pbresource.Status{UpdatedAt: updatedAt, Ttl: durationpb.New(2 * time.Minute)}, now))
+	require.True(t, resource.IsStatusStale(&pbresource.Status{UpdatedAt: updatedAt, Ttl: durationpb.New(30 * time.Second)}, now))
+}
+
+func TestExpireAt(t *testing.T) {
+	_, ok, err := resource.ExpireAt(&pbresource.Resource{})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	expected := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	expireAt, ok, err := resource.ExpireAt(&pbresource.Resource{
+		Metadata: map[string]string{resource.ExpireAtKey: expected.Format(time.RFC3339)},
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, expected.Equal(expireAt))
+
+	_, _, err = resource.ExpireAt(&pbresource.Resource{
+		Metadata: map[string]string{resource.ExpireAtKey: "tomorrow"},
+	})
+	require.ErrorContains(t, err, "expireAt must be an RFC 3339 timestamp")
+}
@@ -454,15 +454,37 @@ func testListWatch(t *testing.T, opts TestOptions) {
 				require.NoError(t, err)
 				t.Cleanup(watch.Close)
 
-				for i := 0; i < len(tc.results); i++ {
+				// On a follower, some of the seed data may not have been replicated
+				// when the snapshot was taken, so it can arrive after the end of
+				// snapshot marker.
+				var endOfSnapshot bool
+				for i := 0; i < len(tc.results); {
 					ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 					t.Cleanup(cancel)
 
 					event, err := watch.Next(ctx)
 					require.NoError(t, err)
 
+					if event.Operation == pbresource.WatchEvent_OPERATION_END_OF_SNAPSHOT {
+						require.False(t, endOfSnapshot, "received more than one end of snapshot marker")
+						require.Nil(t, event.Resource)
+						endOfSnapshot = true
+						continue
+					}
+
 					require.Equal(t, pbresource.WatchEvent_OPERATION_UPSERT, event.Operation)
 					prototest.AssertContainsElement(t, tc.results, event.Resource, ignoreVersion)
+					i++
+				}
+
+				if !endOfSnapshot {
+					ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+					t.Cleanup(cancel)
+
+					event, err := watch.Next(ctx)
+					require.NoError(t, err)
+					require.Equal(t, pbresource.WatchEvent_OPERATION_END_OF_SNAPSHOT, event.Operation)
+					require.Nil(t, event.Resource)
 				}
 			})
 
@@ -474,6 +496,11 @@ func testListWatch(t *testing.T, opts TestOptions) {
 				require.NoError(t, err)
 				t.Cleanup(watch.Close)
 
+				// The initial snapshot is empty.
+				event, err := watch.Next(ctx)
+				require.NoError(t, err)
+				require.Equal(t, pbresource.WatchEvent_OPERATION_END_OF_SNAPSHOT, event.Operation)
+
 				// Write the seed data after the watch has been established.
 				for _, r := range seedData {
 					_, err := backend.WriteCAS(ctx, r)
@@ -504,7 +531,7 @@ func testListWatch(t *testing.T, opts TestOptions) {
 				ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 				t.Cleanup(cancel)
 
-				event, err := watch.Next(ctx)
+				event, err = watch.Next(ctx)
 				require.NoError(t, err)
 
 				require.Equal(t, pbresource.WatchEvent_OPERATION_DELETE, event.Operation)
@@ -560,6 +587,46 @@ func testListByOwner(t *testing.T, opts TestOptions) {
 		prototest.AssertElementsMatch(t, res, []*pbresource.Resource{r1, r2})
 	})
 
+	t.Run("paging", func(t *testing.T) {
+		// Resources are ordered by type (ignoring GroupVersion), so r2 (of type
+		// a) is before r1 (of type b).
+		eventually(t, func(t testingT) {
+			res, err := backend.ListByOwnerPage(ctx, owner.Id, nil, 1)
+			require.NoError(t, err)
+			prototest.AssertDeepEqual(t, []*pbresource.Resource{r2}, res)
+
+			res, err = backend.ListByOwnerPage(ctx, owner.Id, r2.Id, 1)
+			require.NoError(t, err)
+			prototest.AssertDeepEqual(t, []*pbresource.Resource{r1}, res)
+
+			res, err = backend.ListByOwnerPage(ctx, owner.Id, r1.Id, 1)
+			require.NoError(t, err)
+			require.Empty(t, res)
+
+			res, err = backend.ListByOwnerPage(ctx, owner.Id, nil, 0)
+			require.NoError(t, err)
+			prototest.AssertDeepEqual(t, []*pbresource.Resource{r2, r1}, res)
+		})
+
+		// Paging continues from where the given resource would be, so it
+		// needn't exist.
+		between := &pbresource.ID{Type: typeAv1, Tenancy: tenancyDefault, Name: "r3"}
+		eventually(t, func(t testingT) {
+			res, err := backend.ListByOwnerPage(ctx, owner.Id, between, 0)
+			require.NoError(t, err)
+			prototest.AssertDeepEqual(t, []*pbresource.Resource{r1}, res)
+		})
+
+		// Paging doesn't continue into another owner's resources.
+		other := clone(owner.Id)
+		other.Uid = "b"
+		eventually(t, func(t testingT) {
+			res, err := backend.ListByOwnerPage(ctx, other, between, 0)
+			require.NoError(t, err)
+			require.Empty(t, res)
+		})
+	})
+
 	t.Run("references are anchored to a specific uid", func(t *testing.T) {
 		id := clone(owner.Id)
 		id.Uid = "different"
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package inmem_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/internal/resource"
+	"github.com/hashicorp/consul/internal/storage"
+	"github.com/hashicorp/consul/internal/storage/inmem"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/prototest"
+)
+
+var (
+	ownerIndexTenancy = &pbresource.Tenancy{Partition: "default", PeerName: "local", Namespace: "default"}
+	ownerIndexNode    = &pbresource.Type{Group: "catalog", GroupVersion: "v2beta1", Kind: "Node"}
+	ownerIndexCheck   = &pbresource.Type{Group: "catalog", GroupVersion: "v2beta1", Kind: "HealthStatus"}
+)
+
+func newOwnerIndexStore(t testing.TB) *inmem.Store {
+	store, err := inmem.NewStore()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go store.Run(ctx)
+
+	return store
+}
+
+func writeOwned(t testing.TB, store *inmem.Store, typ *pbresource.Type, name string, owner *pbresource.ID) *pbresource.Resource {
+	res := &pbresource.Resource{
+		Id:      &pbresource.ID{Type: typ, Tenancy: ownerIndexTenancy, Name: name, Uid: "a"},
+		Owner:   owner,
+		Version: "1",
+	}
+	require.NoError(t, store.WriteCAS(res, ""))
+	return res
+}
+
+func TestStore_ListByOwner_Index(t *testing.T) {
+	store := newOwnerIndexStore(t)
+
+	node1 := writeOwned(t, store, ownerIndexNode, "node-1", nil)
+	node2 := writeOwned(t, store, ownerIndexNode, "node-2", nil)
+	check1 := writeOwned(t, store, ownerIndexCheck, "check-1", node1.Id)
+	check2 := writeOwned(t, store, ownerIndexCheck, "check-2", node1.Id)
+	check3 := writeOwned(t, store, ownerIndexCheck, "check-3", node1.Id)
+
+	requireOwned := func(t *testing.T, store *inmem.Store, owner *pbresource.ID, expected ...*pbresource.Resource) {
+		t.Helper()
+		owned, err := store.ListByOwner(owner)
+		require.NoError(t, err)
+		prototest.AssertElementsMatch(t, expected, owned)
+	}
+	requireOwned(t, store, node1.Id, check1, check2, check3)
+	requireOwned(t, store, node2.Id)
+
+	// Deleting an owned resource removes it from the index.
+	require.NoError(t, store.DeleteCAS(check2.Id, check2.Version))
+	requireOwned(t, store, node1.Id, check1, check3)
+
+	// Changing a resource's owner moves it in the index.
+	moved := &pbresource.Resource{Id: check3.Id, Owner: node2.Id, Version: "2"}
+	require.NoError(t, store.WriteCAS(moved, check3.Version))
+	requireOwned(t, store, node1.Id, check1)
+	requireOwned(t, store, node2.Id, moved)
+
+	// The index is rebuilt when restoring a snapshot, e.g. on restart.
+	snap, err := store.Snapshot()
+	require.NoError(t, err)
+
+	restored := newOwnerIndexStore(t)
+	restore, err := restored.Restore()
+	require.NoError(t, err)
+	for res := snap.Next(); res != nil; res = snap.Next() {
+		require.NoError(t, restore.Apply(res))
+	}
+	restore.Commit()
+
+	requireOwned(t, restored, node1.Id, check1)
+	requireOwned(t, restored, node2.Id, moved)
+
+	require.NoError(t, restored.DeleteCAS(check1.Id, check1.Version))
+	requireOwned(t, restored, node1.Id)
+}
+
+// BenchmarkStore_ListByOwner compares looking up the resources owned by a node
+// using the owner index with scanning all resources of the owned type, among
+// 10k resources.
+func BenchmarkStore_ListByOwner(b *testing.B) {
+	const (
+		nodes         = 1000
+		checksPerNode = 10
+	)
+
+	store := newOwnerIndexStore(b)
+	var owners []*pbresource.ID
+	for n := 0; n < nodes; n++ {
+		node := writeOwned(b, store, ownerIndexNode, fmt.Sprintf("node-%d", n), nil)
+		owners = append(owners, node.Id)
+		for c := 0; c < checksPerNode; c++ {
+			writeOwned(b, store, ownerIndexCheck, fmt.Sprintf("node-%d-check-%d", n, c), node.Id)
+		}
+	}
+
+	b.Run("index", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			owned, err := store.ListByOwner(owners[i%nodes])
+			require.NoError(b, err)
+			require.Len(b, owned, checksPerNode)
+		}
+	})
+
+	b.Run("scan", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			all, err := store.List(storage.UnversionedTypeFrom(ownerIndexCheck), ownerIndexTenancy, "")
+			require.NoError(b, err)
+
+			var owned []*pbresource.Resource
+			for _, res := range all {
+				if resource.EqualID(res.Owner, owners[i%nodes]) {
+					owned = append(owned, res)
+				}
+			}
+			require.Len(b, owned, checksPerNode)
+		}
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package inmem
+
+import (
+	"encoding/base64"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/hashicorp/consul/internal/storage"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+// maxHistoryEvents is the number of recently published events retained to
+// resume watches. Watches that fall further behind must start over.
+const maxHistoryEvents = 1024
+
+// eventHistory holds the most recently published events.
+type eventHistory struct {
+	// epoch identifies the store's sequence of events. It changes whenever the
+	// store's state is replaced wholesale (i.e. a snapshot is restored), so that
+	// tokens issued before can't be used to resume watches.
+	epoch string
+
+	events []historyEvent
+
+	// floor is the index of the most recently evicted event. Watches can only be
+	// resumed from this index onwards.
+	floor uint64
+}
+
+type historyEvent struct {
+	index uint64
+	event *pbresource.WatchEvent
+}
+
+func (h *eventHistory) reset() {
+	h.epoch = ulid.Make().String()
+	h.events = nil
+	h.floor = 0
+}
+
+func (h *eventHistory) record(idx uint64, event *pbresource.WatchEvent) {
+	if len(h.events) == maxHistoryEvents {
+		h.floor = h.events[0].index
+		h.events = h.events[1:]
+	}
+	h.events = append(h.events, historyEvent{index: idx, event: event})
+}
+
+// since returns the events published after the given index, or false if some
+// of them are no longer retained.
+func (h *eventHistory) since(idx uint64) ([]historyEvent, bool) {
+	if idx < h.floor {
+		return nil, false
+	}
+	i := sort.Search(len(h.events), func(i int) bool { return h.events[i].index > idx })
+	return h.events[i:], true
+}
+
+// WatchListFrom is like WatchList, but when token is non-empty it resumes the
+// watch after the event at which the token was issued.
+//
+// For more information, see the storage.ResumableBackend documentation.
+func (s *Store) WatchListFrom(typ storage.UnversionedType, ten *pbresource.Tenancy, namePrefix string, token string) (*Watch, error) {
+	if token == "" {
+		return s.WatchList(typ, ten, namePrefix)
+	}
+
+	epoch, idx, ok := parseResumeToken(token)
+	if !ok {
+		return nil, storage.ErrResumeTokenExpired
+	}
+
+	// Hold eventLock so that no events are published between collecting the ones
+	// to replay and subscribing to the later ones.
+	s.eventLock.Lock()
+	defer s.eventLock.Unlock()
+
+	tx := s.txn(false)
+	current, err := currentEventIndex(tx)
+	tx.Abort()
+	if err != nil {
+		return nil, err
+	}
+
+	missed, ok := s.history.since(idx)
+	if !ok || epoch != s.history.epoch || idx > current {
+		return nil, storage.ErrResumeTokenExpired
+	}
+
+	w, err := s.watchList(typ, ten, namePrefix, s.history.epoch)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range missed {
+		if storage.UnversionedTypeFrom(e.event.Resource.Id.Type) == typ && w.query.matches(e.event.Resource) {
+			w.replay = append(w.replay, e)
+		}
+	}
+	// The subscription's snapshot and any events up to the current index have
+	// already been seen by the watcher, or are replayed above.
+	w.resumedAt = current
+	w.index = idx
+	return w, nil
+}
+
+func encodeResumeToken(epoch string, idx uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(epoch + ":" + strconv.FormatUint(idx, 10)))
+}
+
+func parseResumeToken(token string) (string, uint64, bool) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", 0, false
+	}
+	epoch, idxStr, ok := strings.Cut(string(b), ":")
+	if !ok {
+		return "", 0, false
+	}
+	idx, err := strconv.ParseUint(idxStr, 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return epoch, idx, true
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package inmem_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/internal/storage"
+	"github.com/hashicorp/consul/internal/storage/inmem"
+	"github.com/hashicorp/consul/proto-public/pbresource"
+)
+
+func TestStore_WatchListFrom(t *testing.T) {
+	typ := &pbresource.Type{Group: "mesh", GroupVersion: "v1", Kind: "service"}
+	tenancy := &pbresource.Tenancy{Partition: "default", PeerName: "local", Namespace: "default"}
+
+	newStore := func(t *testing.T) *inmem.Store {
+		store, err := inmem.NewStore()
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		go store.Run(ctx)
+
+		return store
+	}
+	write := func(t *testing.T, store *inmem.Store, name string) {
+		require.NoError(t, store.WriteCAS(&pbresource.Resource{
+			Id:      &pbresource.ID{Type: typ, Tenancy: tenancy, Name: name, Uid: name},
+			Version: "1",
+		}, ""))
+	}
+	// snapshotToken returns the resume token of a new watch once it has
+	// received its snapshot.
+	snapshotToken := func(t *testing.T, store *inmem.Store) string {
+		watch, err := store.WatchList(storage.UnversionedTypeFrom(typ), tenancy, "")
+		require.NoError(t, err)
+		defer watch.Close()
+
+		for {
+			event, err := watch.Next(context.Background())
+			require.NoError(t, err)
+			if event.Operation == pbresource.WatchEvent_OPERATION_END_OF_SNAPSHOT {
+				return watch.ResumeToken()
+			}
+			require.Empty(t, watch.ResumeToken())
+		}
+	}
+
+	t.Run("resumed", func(t *testing.T) {
+		store := newStore(t)
+		write(t, store, "existing")
+		token := snapshotToken(t, store)
+		write(t, store, "missed")
+
+		watch, err := store.WatchListFrom(storage.UnversionedTypeFrom(typ), tenancy, "", token)
+		require.NoError(t, err)
+		defer watch.Close()
+
+		event, err := watch.Next(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "missed", event.Resource.Id.Name)
+		require.NotEqual(t, token, watch.ResumeToken())
+	})
+
+	t.Run("evicted", func(t *testing.T) {
+		store := newStore(t)
+		token := snapshotToken(t, store)
+
+		// More events than are retained.
+		for i := 0; i < 1100; i++ {
+			write(t, store, fmt.Sprintf("svc-%d", i))
+		}
+
+		_, err := store.WatchListFrom(storage.UnversionedTypeFrom(typ), tenancy, "", token)
+		require.ErrorIs(t, err, storage.ErrResumeTokenExpired)
+	})
+
+	t.Run("restored", func(t *testing.T) {
+		store := newStore(t)
+		token := snapshotToken(t, store)
+
+		restore, err := store.Restore()
+		require.NoError(t, err)
+		restore.Commit()
+
+		_, err = store.WatchListFrom(storage.UnversionedTypeFrom(typ), tenancy, "", token)
+		require.ErrorIs(t, err, storage.ErrResumeTokenExpired)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		store := newStore(t)
+
+		_, err := store.WatchListFrom(storage.UnversionedTypeFrom(typ), tenancy, "", "not-a-token")
+		require.ErrorIs(t, err, storage.ErrResumeTokenExpired)
+	})
+}
@@ -108,16 +108,30 @@ func (i idIndexer) PrefixFromArgs(args ...any) ([]byte, error) {
 // It is used for indexing resources by their owners.
 type ownerIndexer struct{}
 
-// FromArgs constructs a radix tree key from an ID for lookup.
+// FromArgs constructs a radix tree key from an owner ID for lookup. Given an
+// owned resource's ID too, it constructs the key at which that resource is
+// indexed under the owner, from which to seek. This works because memdb appends
+// the ID index key to the keys of non-unique indexes.
 func (i ownerIndexer) FromArgs(args ...any) ([]byte, error) {
-	if l := len(args); l != 1 {
-		return nil, fmt.Errorf("expected 1 arg, got: %d", l)
+	if l := len(args); l != 1 && l != 2 {
+		return nil, fmt.Errorf("expected 1 or 2 args, got: %d", l)
 	}
 	id, ok := args[0].(*pbresource.ID)
 	if !ok {
 		return nil, fmt.Errorf("expected *pbresource.ID, got: %T", args[0])
 	}
-	return indexFromID(id, true), nil
+	if len(args) == 1 {
+		return indexFromID(id, true), nil
+	}
+
+	owned, ok := args[1].(*pbresource.ID)
+	if !ok {
+		return nil, fmt.Errorf("expected *pbresource.ID, got: %T", args[1])
+	}
+	var b indexBuilder
+	b.Raw(indexFromID(id, true))
+	b.Raw(indexFromID(owned, false))
+	return b.Bytes(), nil
 }
 
 // FromObject constructs a radix key tree from a Resource at write-time.
@@ -69,11 +69,18 @@ func (r *Restoration) Apply(res *pbresource.Resource) error {
 func (r *Restoration) Commit() {
 	r.tx.Commit()
 
+	// Watches can't be resumed across the restoration, as the events before it
+	// don't lead to the restored state. eventLock is taken before mu, as in
+	// writes.
+	r.s.eventLock.Lock()
+	defer r.s.eventLock.Unlock()
+
 	r.s.mu.Lock()
 	defer r.s.mu.Unlock()
 
 	r.s.db = r.db
 	r.s.pub.RefreshTopic(eventTopic)
+	r.s.history.reset()
 }
 
 // Abort the restoration. It's safe to always call this in a defer statement
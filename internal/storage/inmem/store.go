@@ -4,6 +4,7 @@
 package inmem
 
 import (
+	"bytes"
 	"context"
 	"sync"
 	"time"
@@ -39,6 +40,10 @@ type Store struct {
 	//
 	// Without this lock, it would be possible to publish events out-of-order.
 	eventLock sync.Mutex
+
+	// history holds the most recently published events, so that watches can be
+	// resumed. It is guarded by eventLock.
+	history eventHistory
 }
 
 // NewStore creates a Store.
@@ -56,6 +61,8 @@ func NewStore() (*Store, error) {
 	}
 	s.pub.RegisterHandler(eventTopic, s.watchSnapshot, false)
 
+	s.history.reset()
+
 	return s, nil
 }
 
@@ -226,6 +233,14 @@ func listTxn(tx *memdb.Txn, q query) ([]*pbresource.Resource, error) {
 //
 // For more information, see the storage.Backend documentation.
 func (s *Store) WatchList(typ storage.UnversionedType, ten *pbresource.Tenancy, namePrefix string) (*Watch, error) {
+	s.eventLock.Lock()
+	epoch := s.history.epoch
+	s.eventLock.Unlock()
+
+	return s.watchList(typ, ten, namePrefix, epoch)
+}
+
+func (s *Store) watchList(typ storage.UnversionedType, ten *pbresource.Tenancy, namePrefix string, epoch string) (*Watch, error) {
 	// If the user specifies a wildcard, we subscribe to events for resources in
 	// all partitions, peers, and namespaces, and manually filter out irrelevant
 	// stuff (in Watch.Next).
@@ -256,6 +271,7 @@ func (s *Store) WatchList(typ storage.UnversionedType, ten *pbresource.Tenancy,
 			tenancy:      ten,
 			namePrefix:   namePrefix,
 		},
+		epoch: epoch,
 	}, nil
 }
 
@@ -278,6 +294,54 @@ func (s *Store) ListByOwner(id *pbresource.ID) ([]*pbresource.Resource, error) {
 	return res, nil
 }
 
+// ListByOwnerPage returns up to limit resources owned by the resource with the
+// given ID, starting after the owned resource with the ID after.
+//
+// For more information, see the storage.Backend documentation.
+func (s *Store) ListByOwnerPage(id, after *pbresource.ID, limit int) ([]*pbresource.Resource, error) {
+	tx := s.txn(false)
+	defer tx.Abort()
+
+	var (
+		iter memdb.ResultIterator
+		err  error
+	)
+	if after == nil {
+		iter, err = tx.Get(tableNameResources, indexNameOwner, id)
+	} else {
+		// Seeking doesn't stop at the end of the owner's resources, so they are
+		// checked as they are iterated.
+		iter, err = tx.LowerBound(tableNameResources, indexNameOwner, id, after)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var owner, afterKey []byte
+	if after != nil {
+		owner, afterKey = indexFromID(id, true), indexFromID(after, false)
+	}
+
+	var res []*pbresource.Resource
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		r := v.(*pbresource.Resource)
+		if after != nil {
+			if r.Owner == nil || !bytes.Equal(indexFromID(r.Owner, true), owner) {
+				break
+			}
+			if bytes.Equal(indexFromID(r.Id, false), afterKey) {
+				continue
+			}
+		}
+
+		res = append(res, r)
+		if limit > 0 && len(res) == limit {
+			break
+		}
+	}
+	return res, nil
+}
+
 func (s *Store) txn(write bool) *memdb.Txn {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -22,10 +22,36 @@ type Watch struct {
 	// events holds excess events when they are bundled in a stream.PayloadEvents,
 	// until Next is called again.
 	events []stream.Event
+
+	// epoch is the epoch of the store's event history when the watch was created.
+	epoch string
+
+	// index is the index through which all events have been returned, or zero
+	// while the initial snapshot is being returned.
+	index uint64
+
+	// snapshotDone is set once the subscription's snapshot has been consumed.
+	snapshotDone bool
+
+	// replay holds the missed events of a resumed watch, which are returned
+	// before those of the subscription.
+	replay []historyEvent
+
+	// resumedAt is the store's event index when the watch was resumed, or zero
+	// if it wasn't. The subscription's snapshot and events up to this index are
+	// dropped, as they were either already seen by the watcher or are replayed.
+	resumedAt uint64
 }
 
 // Next returns the next WatchEvent, blocking until one is available.
 func (w *Watch) Next(ctx context.Context) (*pbresource.WatchEvent, error) {
+	if len(w.replay) != 0 {
+		e := w.replay[0]
+		w.replay = w.replay[1:]
+		w.index = e.index
+		return e.event, nil
+	}
+
 	for {
 		e, err := w.nextEvent(ctx)
 		if err == stream.ErrSubForceClosed {
@@ -35,13 +61,41 @@ func (w *Watch) Next(ctx context.Context) (*pbresource.WatchEvent, error) {
 			return nil, err
 		}
 
+		if e.IsEndOfSnapshot() {
+			w.snapshotDone = true
+			if w.resumedAt != 0 {
+				continue
+			}
+			w.index = e.Index
+			return &pbresource.WatchEvent{Operation: pbresource.WatchEvent_OPERATION_END_OF_SNAPSHOT}, nil
+		}
+
+		if w.resumedAt != 0 && (!w.snapshotDone || e.Index <= w.resumedAt) {
+			continue
+		}
+
 		event := e.Payload.(eventPayload).event
 		if w.query.matches(event.Resource) {
+			// Events bundled together share an index, so the watch can only be
+			// resumed after the last of them.
+			if w.snapshotDone && len(w.events) == 0 {
+				w.index = e.Index
+			}
 			return event, nil
 		}
 	}
 }
 
+// ResumeToken returns a token from which the watch can be resumed with
+// WatchListFrom after the last event returned by Next. It's empty while the
+// initial snapshot is being returned.
+func (w *Watch) ResumeToken() string {
+	if w.index == 0 {
+		return ""
+	}
+	return encodeResumeToken(w.epoch, w.index)
+}
+
 func (w *Watch) nextEvent(ctx context.Context) (*stream.Event, error) {
 	if len(w.events) != 0 {
 		event := w.events[0]
@@ -56,6 +110,10 @@ func (w *Watch) nextEvent(ctx context.Context) (*stream.Event, error) {
 			return nil, err
 		}
 
+		if e.IsEndOfSnapshot() {
+			return &e, nil
+		}
+
 		if e.IsFramingEvent() {
 			continue
 		}
@@ -139,6 +197,7 @@ func (s *Store) publishEvent(idx uint64, op pbresource.WatchEvent_Operation, res
 	id := res.Id
 	resourceType := storage.UnversionedTypeFrom(id.Type)
 	event := &pbresource.WatchEvent{Operation: op, Resource: res}
+	s.history.record(idx, event)
 
 	// We publish two copies of the event: one to the tenancy-specific subject and
 	// another to a wildcard subject. Ideally, we'd be able to put the type in the
@@ -220,11 +220,25 @@ func (b *Backend) WatchList(_ context.Context, resType storage.UnversionedType,
 	return b.store.WatchList(resType, tenancy, namePrefix)
 }
 
+// WatchListFrom implements the storage.ResumableBackend interface.
+func (b *Backend) WatchListFrom(_ context.Context, resType storage.UnversionedType, tenancy *pbresource.Tenancy, namePrefix string, token string) (storage.ResumableWatch, error) {
+	watch, err := b.store.WatchListFrom(resType, tenancy, namePrefix, token)
+	if err != nil {
+		return nil, err
+	}
+	return watch, nil
+}
+
 // ListByOwner implements the storage.Backend interface.
 func (b *Backend) ListByOwner(_ context.Context, id *pbresource.ID) ([]*pbresource.Resource, error) {
 	return b.store.ListByOwner(id)
 }
 
+// ListByOwnerPage implements the storage.Backend interface.
+func (b *Backend) ListByOwnerPage(_ context.Context, id, after *pbresource.ID, limit int) ([]*pbresource.Resource, error) {
+	return b.store.ListByOwnerPage(id, after, limit)
+}
+
 // Apply is called by the FSM with the bytes of a Raft log entry, with Consul's
 // envelope (i.e. type prefix and msgpack wrapper) stripped off.
 func (b *Backend) Apply(buf []byte, idx uint64) any {
@@ -33,10 +33,19 @@ var (
 	// quorum, or when interacting with a Raft follower).
 	ErrInconsistent = errors.New("cannot satisfy consistency requirements")
 
+	// ErrNoLeader indicates that the attempted write or consistent read could not
+	// be forwarded to the leader because none is currently elected.
+	ErrNoLeader = errors.New("no leader elected")
+
 	// ErrWatchClosed is returned by Watch.Next when the watch is closed, e.g. when
 	// a snapshot is restored and the watch's events are no longer valid. Consumers
 	// should discard any materialized state and start a new watch.
 	ErrWatchClosed = errors.New("watch closed")
+
+	// ErrResumeTokenExpired is returned by ResumableBackend.WatchListFrom when the
+	// watch can't be resumed from the given token, e.g. because the events since
+	// are no longer retained or the token was issued by a different backend.
+	ErrResumeTokenExpired = errors.New("resume token has expired")
 )
 
 // ReadConsistency is used to specify the required consistency guarantees for
@@ -271,18 +280,56 @@ type Backend interface {
 	//
 	// [montonic reads]: https://jepsen.io/consistency/models/monotonic-reads
 	ListByOwner(ctx context.Context, id *pbresource.ID) ([]*pbresource.Resource, error)
+
+	// ListByOwnerPage returns up to limit of the resources owned by the resource
+	// with the given ID, or all of them when limit is zero, so that they can be
+	// paged through without listing them all.
+	//
+	// Resources are returned in order of type (ignoring GroupVersion), tenancy
+	// and name, starting after the owned resource identified by after, which
+	// needn't exist, or from the first when after is nil. Only the type's Group
+	// and Kind, the tenancy and the name of after are used.
+	//
+	// It has the same consistency guarantees as ListByOwner.
+	ListByOwnerPage(ctx context.Context, id, after *pbresource.ID, limit int) ([]*pbresource.Resource, error)
 }
 
 // Watch represents a watch on a given set of resources. Call Next to get the
 // next event (i.e. upsert or deletion) and Close when you're done watching.
 type Watch interface {
-	// Next returns the next event (i.e. upsert or deletion)
+	// Next returns the next event (i.e. upsert or deletion). Once the upsert
+	// events for the initial state-of-the-world have been returned, a single
+	// OPERATION_END_OF_SNAPSHOT event (without a resource) is returned.
 	Next(ctx context.Context) (*pbresource.WatchEvent, error)
 
 	// Close the watch and free its associated resources.
 	Close()
 }
 
+// ResumableBackend is implemented by backends whose watches can be resumed
+// after the consumer reconnects, without replaying the initial state of the
+// world.
+type ResumableBackend interface {
+	// WatchListFrom is like Backend.WatchList, but when token is non-empty it
+	// resumes the watch after the event at which the token was issued. Resumed
+	// watches don't emit upsert events for the current state of the world or an
+	// OPERATION_END_OF_SNAPSHOT event, only the events that happened since.
+	//
+	// ErrResumeTokenExpired is returned if the watch can't be resumed from the
+	// given token, in which case the consumer should start a new watch.
+	WatchListFrom(ctx context.Context, resType UnversionedType, tenancy *pbresource.Tenancy, namePrefix string, token string) (ResumableWatch, error)
+}
+
+// ResumableWatch is a Watch that can be resumed with ResumableBackend.
+type ResumableWatch interface {
+	Watch
+
+	// ResumeToken returns an opaque token from which the watch can be resumed
+	// after the last event returned by Next. It returns an empty string while
+	// the upsert events for the initial state of the world are being returned.
+	ResumeToken() string
+}
+
 // UnversionedType represents a pbresource.Type as it is stored without the
 // GroupVersion.
 type UnversionedType struct {
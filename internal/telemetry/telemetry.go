@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package telemetry provides the metrics adapter used to instrument the
+// resource service and controllers, so that the same instrumentation can be
+// emitted via go-metrics (the default) or an OpenTelemetry meter provider.
+package telemetry
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+)
+
+// Sink records metrics. Keys and labels follow the go-metrics conventions.
+type Sink interface {
+	// MeasureSince records the time since start, in milliseconds, in the
+	// histogram with the given key.
+	MeasureSince(key []string, start time.Time, labels []metrics.Label)
+
+	// IncrCounter adds val to the counter with the given key.
+	IncrCounter(key []string, val float32, labels []metrics.Label)
+
+	// SetGauge sets the gauge with the given key to val.
+	SetGauge(key []string, val float32, labels []metrics.Label)
+}
+
+// Default returns the Sink that emits metrics via the global go-metrics
+// instance.
+func Default() Sink { return goMetricsSink{} }
+
+// OrDefault returns sink, or the Default sink when sink is nil.
+func OrDefault(sink Sink) Sink {
+	if sink == nil {
+		return Default()
+	}
+	return sink
+}
+
+type goMetricsSink struct{}
+
+func (goMetricsSink) MeasureSince(key []string, start time.Time, labels []metrics.Label) {
+	metrics.MeasureSinceWithLabels(key, start, labels)
+}
+
+func (goMetricsSink) IncrCounter(key []string, val float32, labels []metrics.Label) {
+	metrics.IncrCounterWithLabels(key, val, labels)
+}
+
+func (goMetricsSink) SetGauge(key []string, val float32, labels []metrics.Label) {
+	metrics.SetGaugeWithLabels(key, val, labels)
+}
+
+// otelMeterName is the name of the meter instruments are created with.
+const otelMeterName = "github.com/hashicorp/consul/internal/telemetry"
+
+// NewOTelSink returns a Sink that emits metrics via a meter obtained from the
+// given provider. Instruments are named by joining the key with "." and
+// prefixing it with "consul.", so that their names match those emitted by
+// go-metrics with the default prefix.
+func NewOTelSink(provider otelmetric.MeterProvider) Sink {
+	return &otelSink{
+		meter:      provider.Meter(otelMeterName),
+		histograms: make(map[string]otelmetric.Float64Histogram),
+		counters:   make(map[string]otelmetric.Float64Counter),
+		gauges:     make(map[string]*otelGauge),
+	}
+}
+
+type otelSink struct {
+	meter otelmetric.Meter
+
+	mu         sync.Mutex
+	histograms map[string]otelmetric.Float64Histogram
+	counters   map[string]otelmetric.Float64Counter
+	gauges     map[string]*otelGauge
+}
+
+// otelGauge holds the last value set for each set of attributes, which are
+// observed when the meter provider collects the gauge. OpenTelemetry only
+// offers asynchronous gauges.
+type otelGauge struct {
+	mu     sync.Mutex
+	values map[attribute.Distinct]otelGaugeValue
+}
+
+type otelGaugeValue struct {
+	attrs attribute.Set
+	val   float64
+}
+
+func (g *otelGauge) observe(_ context.Context, o otelmetric.Float64Observer) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, v := range g.values {
+		o.Observe(v.val, otelmetric.WithAttributeSet(v.attrs))
+	}
+	return nil
+}
+
+func (s *otelSink) MeasureSince(key []string, start time.Time, labels []metrics.Label) {
+	name := otelName(key)
+
+	s.mu.Lock()
+	inst, ok := s.histograms[name]
+	if !ok {
+		var err error
+		inst, err = s.meter.Float64Histogram(name, otelmetric.WithUnit("ms"))
+		if err != nil {
+			s.mu.Unlock()
+			return
+		}
+		s.histograms[name] = inst
+	}
+	s.mu.Unlock()
+
+	elapsed := float64(time.Since(start)) / float64(time.Millisecond)
+	inst.Record(context.Background(), elapsed, otelmetric.WithAttributes(otelAttributes(labels)...))
+}
+
+func (s *otelSink) IncrCounter(key []string, val float32, labels []metrics.Label) {
+	name := otelName(key)
+
+	s.mu.Lock()
+	inst, ok := s.counters[name]
+	if !ok {
+		var err error
+		inst, err = s.meter.Float64Counter(name)
+		if err != nil {
+			s.mu.Unlock()
+			return
+		}
+		s.counters[name] = inst
+	}
+	s.mu.Unlock()
+
+	inst.Add(context.Background(), float64(val), otelmetric.WithAttributes(otelAttributes(labels)...))
+}
+
+func (s *otelSink) SetGauge(key []string, val float32, labels []metrics.Label) {
+	name := otelName(key)
+
+	s.mu.Lock()
+	gauge, ok := s.gauges[name]
+	if !ok {
+		gauge = &otelGauge{values: make(map[attribute.Distinct]otelGaugeValue)}
+		if _, err := s.meter.Float64ObservableGauge(name, otelmetric.WithFloat64Callback(gauge.observe)); err != nil {
+			s.mu.Unlock()
+			return
+		}
+		s.gauges[name] = gauge
+	}
+	s.mu.Unlock()
+
+	attrs := attribute.NewSet(otelAttributes(labels)...)
+	gauge.mu.Lock()
+	gauge.values[attrs.Equivalent()] = otelGaugeValue{attrs: attrs, val: float64(val)}
+	gauge.mu.Unlock()
+}
+
+func otelName(key []string) string {
+	return "consul." + strings.Join(key, ".")
+}
+
+func otelAttributes(labels []metrics.Label) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, len(labels))
+	for _, l := range labels {
+		attrs = append(attrs, attribute.String(l.Name, l.Value))
+	}
+	return attrs
+}
@@ -6,6 +6,8 @@ package bridge
 import (
 	"context"
 
+	"google.golang.org/grpc/metadata"
+
 	"github.com/hashicorp/consul/proto-public/pbresource"
 	pbtenancy "github.com/hashicorp/consul/proto-public/pbtenancy/v2beta1"
 )
@@ -41,6 +43,22 @@ func (b *V2TenancyBridge) NamespaceExists(partition, namespace string) (bool, er
 	return read != nil && read.Resource != nil, err
 }
 
+// NamespaceExistsConsistent is like NamespaceExists but reads the namespace with
+// strong consistency, so that a namespace created moments ago is always found.
+func (b *V2TenancyBridge) NamespaceExistsConsistent(partition, namespace string) (bool, error) {
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-consul-consistency-mode", "consistent")
+	read, err := b.client.Read(ctx, &pbresource.ReadRequest{
+		Id: &pbresource.ID{
+			Name: namespace,
+			Tenancy: &pbresource.Tenancy{
+				Partition: partition,
+			},
+			Type: pbtenancy.NamespaceType,
+		},
+	})
+	return read != nil && read.Resource != nil, err
+}
+
 func (b *V2TenancyBridge) IsNamespaceMarkedForDeletion(partition, namespace string) (bool, error) {
 	read, err := b.client.Read(context.Background(), &pbresource.ReadRequest{
 		Id: &pbresource.ID{
@@ -12,6 +12,12 @@ func (b *V2TenancyBridge) PartitionExists(partition string) (bool, error) {
 	return false, nil
 }
 
+// PartitionExistsConsistent is equivalent to PartitionExists in CE because
+// only the default partition exists.
+func (b *V2TenancyBridge) PartitionExistsConsistent(partition string) (bool, error) {
+	return b.PartitionExists(partition)
+}
+
 func (b *V2TenancyBridge) IsPartitionMarkedForDeletion(partition string) (bool, error) {
 	return false, nil
 }
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: pbcatalog/v2beta1/node_health_summary.proto
+
+package catalogv2beta1
+
+import (
+	_ "github.com/hashicorp/consul/proto-public/pbresource"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NodeHealthSummary counts the nodes in the cluster by health, as reported by
+// the node health controller. It is maintained by the node health summary
+// controller, so that operators can see the health of the cluster without
+// listing every node.
+type NodeHealthSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// partitions holds the counts of the nodes in each partition that has any,
+	// keyed by partition name.
+	Partitions map[string]*NodeHealthCounts `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *NodeHealthSummary) Reset() {
+	*x = NodeHealthSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbcatalog_v2beta1_node_health_summary_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeHealthSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeHealthSummary) ProtoMessage() {}
+
+func (x *NodeHealthSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pbcatalog_v2beta1_node_health_summary_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeHealthSummary.ProtoReflect.Descriptor instead.
+func (*NodeHealthSummary) Descriptor() ([]byte, []int) {
+	return file_pbcatalog_v2beta1_node_health_summary_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NodeHealthSummary) GetPartitions() map[string]*NodeHealthCounts {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+// NodeHealthCounts is the number of nodes with each health.
+type NodeHealthCounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Passing     uint32 `protobuf:"varint,1,opt,name=passing,proto3" json:"passing,omitempty"`
+	Warning     uint32 `protobuf:"varint,2,opt,name=warning,proto3" json:"warning,omitempty"`
+	Critical    uint32 `protobuf:"varint,3,opt,name=critical,proto3" json:"critical,omitempty"`
+	Maintenance uint32 `protobuf:"varint,4,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+}
+
+func (x *NodeHealthCounts) Reset() {
+	*x = NodeHealthCounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbcatalog_v2beta1_node_health_summary_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeHealthCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeHealthCounts) ProtoMessage() {}
+
+func (x *NodeHealthCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_pbcatalog_v2beta1_node_health_summary_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeHealthCounts.ProtoReflect.Descriptor instead.
+func (*NodeHealthCounts) Descriptor() ([]byte, []int) {
+	return file_pbcatalog_v2beta1_node_health_summary_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NodeHealthCounts) GetPassing() uint32 {
+	if x != nil {
+		return x.Passing
+	}
+	return 0
+}
+
+func (x *NodeHealthCounts) GetWarning() uint32 {
+	if x != nil {
+		return x.Warning
+	}
+	return 0
+}
+
+func (x *NodeHealthCounts) GetCritical() uint32 {
+	if x != nil {
+		return x.Critical
+	}
+	return 0
+}
+
+func (x *NodeHealthCounts) GetMaintenance() uint32 {
+	if x != nil {
+		return x.Maintenance
+	}
+	return 0
+}
+
+var File_pbcatalog_v2beta1_node_health_summary_proto protoreflect.FileDescriptor
+
+var file_pbcatalog_v2beta1_node_health_summary_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x70, 0x62, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2f, 0x76, 0x32, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e,
+	0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a,
+	0x1c, 0x70, 0x62, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf3, 0x01,
+	0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x63, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x63, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x2e, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x71, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x48, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e,
+	0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x06, 0xa2, 0x93, 0x04,
+	0x02, 0x08, 0x01, 0x22, 0x84, 0x01, 0x0a, 0x10, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x42, 0xac, 0x02, 0x0a, 0x24, 0x63,
+	0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6c, 0x2e, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x76, 0x32, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x42, 0x16, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x49, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x2f, 0x70, 0x62, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f,
+	0x67, 0x2f, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f,
+	0x67, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x43, 0x43, 0xaa, 0x02,
+	0x20, 0x48, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6c, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x56, 0x32, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0xca, 0x02, 0x20, 0x48, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x5c, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6c, 0x5c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x5c, 0x56, 0x32, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x2c, 0x48, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x5c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x5c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x5c,
+	0x56, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x23, 0x48, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x3a,
+	0x3a, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x3a, 0x3a, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x3a, 0x3a, 0x56, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_pbcatalog_v2beta1_node_health_summary_proto_rawDescOnce sync.Once
+	file_pbcatalog_v2beta1_node_health_summary_proto_rawDescData = file_pbcatalog_v2beta1_node_health_summary_proto_rawDesc
+)
+
+func file_pbcatalog_v2beta1_node_health_summary_proto_rawDescGZIP() []byte {
+	file_pbcatalog_v2beta1_node_health_summary_proto_rawDescOnce.Do(func() {
+		file_pbcatalog_v2beta1_node_health_summary_proto_rawDescData = protoimpl.X.CompressGZIP(file_pbcatalog_v2beta1_node_health_summary_proto_rawDescData)
+	})
+	return file_pbcatalog_v2beta1_node_health_summary_proto_rawDescData
+}
+
+var file_pbcatalog_v2beta1_node_health_summary_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pbcatalog_v2beta1_node_health_summary_proto_goTypes = []interface{}{
+	(*NodeHealthSummary)(nil), // 0: hashicorp.consul.catalog.v2beta1.NodeHealthSummary
+	(*NodeHealthCounts)(nil),  // 1: hashicorp.consul.catalog.v2beta1.NodeHealthCounts
+	nil,                       // 2: hashicorp.consul.catalog.v2beta1.NodeHealthSummary.PartitionsEntry
+}
+var file_pbcatalog_v2beta1_node_health_summary_proto_depIdxs = []int32{
+	2, // 0: hashicorp.consul.catalog.v2beta1.NodeHealthSummary.partitions:type_name -> hashicorp.consul.catalog.v2beta1.NodeHealthSummary.PartitionsEntry
+	1, // 1: hashicorp.consul.catalog.v2beta1.NodeHealthSummary.PartitionsEntry.value:type_name -> hashicorp.consul.catalog.v2beta1.NodeHealthCounts
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_pbcatalog_v2beta1_node_health_summary_proto_init() }
+func file_pbcatalog_v2beta1_node_health_summary_proto_init() {
+	if File_pbcatalog_v2beta1_node_health_summary_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pbcatalog_v2beta1_node_health_summary_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeHealthSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbcatalog_v2beta1_node_health_summary_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeHealthCounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pbcatalog_v2beta1_node_health_summary_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pbcatalog_v2beta1_node_health_summary_proto_goTypes,
+		DependencyIndexes: file_pbcatalog_v2beta1_node_health_summary_proto_depIdxs,
+		MessageInfos:      file_pbcatalog_v2beta1_node_health_summary_proto_msgTypes,
+	}.Build()
+	File_pbcatalog_v2beta1_node_health_summary_proto = out.File
+	file_pbcatalog_v2beta1_node_health_summary_proto_rawDesc = nil
+	file_pbcatalog_v2beta1_node_health_summary_proto_goTypes = nil
+	file_pbcatalog_v2beta1_node_health_summary_proto_depIdxs = nil
+}
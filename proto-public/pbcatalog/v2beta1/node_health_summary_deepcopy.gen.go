@@ -0,0 +1,48 @@
+// Code generated by protoc-gen-deepcopy. DO NOT EDIT.
+package catalogv2beta1
+
+import (
+	proto "google.golang.org/protobuf/proto"
+)
+
+// DeepCopyInto supports using NodeHealthSummary within kubernetes types, where deepcopy-gen is used.
+func (in *NodeHealthSummary) DeepCopyInto(out *NodeHealthSummary) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealthSummary. Required by controller-gen.
+func (in *NodeHealthSummary) DeepCopy() *NodeHealthSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeHealthSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealthSummary. Required by controller-gen.
+func (in *NodeHealthSummary) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using NodeHealthCounts within kubernetes types, where deepcopy-gen is used.
+func (in *NodeHealthCounts) DeepCopyInto(out *NodeHealthCounts) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealthCounts. Required by controller-gen.
+func (in *NodeHealthCounts) DeepCopy() *NodeHealthCounts {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeHealthCounts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealthCounts. Required by controller-gen.
+func (in *NodeHealthCounts) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
@@ -0,0 +1,33 @@
+// Code generated by protoc-json-shim. DO NOT EDIT.
+package catalogv2beta1
+
+import (
+	protojson "google.golang.org/protobuf/encoding/protojson"
+)
+
+// MarshalJSON is a custom marshaler for NodeHealthSummary
+func (this *NodeHealthSummary) MarshalJSON() ([]byte, error) {
+	str, err := NodeHealthSummaryMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for NodeHealthSummary
+func (this *NodeHealthSummary) UnmarshalJSON(b []byte) error {
+	return NodeHealthSummaryUnmarshaler.Unmarshal(b, this)
+}
+
+// MarshalJSON is a custom marshaler for NodeHealthCounts
+func (this *NodeHealthCounts) MarshalJSON() ([]byte, error) {
+	str, err := NodeHealthSummaryMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for NodeHealthCounts
+func (this *NodeHealthCounts) UnmarshalJSON(b []byte) error {
+	return NodeHealthSummaryUnmarshaler.Unmarshal(b, this)
+}
+
+var (
+	NodeHealthSummaryMarshaler   = &protojson.MarshalOptions{}
+	NodeHealthSummaryUnmarshaler = &protojson.UnmarshalOptions{DiscardUnknown: false}
+)
@@ -0,0 +1,48 @@
+// Code generated by protoc-gen-deepcopy. DO NOT EDIT.
+package catalogv2beta1
+
+import (
+	proto "google.golang.org/protobuf/proto"
+)
+
+// DeepCopyInto supports using NodeSetHealth within kubernetes types, where deepcopy-gen is used.
+func (in *NodeSetHealth) DeepCopyInto(out *NodeSetHealth) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSetHealth. Required by controller-gen.
+func (in *NodeSetHealth) DeepCopy() *NodeSetHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSetHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new NodeSetHealth. Required by controller-gen.
+func (in *NodeSetHealth) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using NodeSelector within kubernetes types, where deepcopy-gen is used.
+func (in *NodeSelector) DeepCopyInto(out *NodeSelector) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSelector. Required by controller-gen.
+func (in *NodeSelector) DeepCopy() *NodeSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new NodeSelector. Required by controller-gen.
+func (in *NodeSelector) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
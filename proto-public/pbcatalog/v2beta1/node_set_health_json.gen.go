@@ -0,0 +1,33 @@
+// Code generated by protoc-json-shim. DO NOT EDIT.
+package catalogv2beta1
+
+import (
+	protojson "google.golang.org/protobuf/encoding/protojson"
+)
+
+// MarshalJSON is a custom marshaler for NodeSetHealth
+func (this *NodeSetHealth) MarshalJSON() ([]byte, error) {
+	str, err := NodeSetHealthMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for NodeSetHealth
+func (this *NodeSetHealth) UnmarshalJSON(b []byte) error {
+	return NodeSetHealthUnmarshaler.Unmarshal(b, this)
+}
+
+// MarshalJSON is a custom marshaler for NodeSelector
+func (this *NodeSelector) MarshalJSON() ([]byte, error) {
+	str, err := NodeSetHealthMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for NodeSelector
+func (this *NodeSelector) UnmarshalJSON(b []byte) error {
+	return NodeSetHealthUnmarshaler.Unmarshal(b, this)
+}
+
+var (
+	NodeSetHealthMarshaler   = &protojson.MarshalOptions{}
+	NodeSetHealthUnmarshaler = &protojson.UnmarshalOptions{DiscardUnknown: false}
+)
@@ -10,15 +10,17 @@ const (
 	GroupName = "catalog"
 	Version   = "v2beta1"
 
-	DNSPolicyKind        = "DNSPolicy"
-	FailoverPolicyKind   = "FailoverPolicy"
-	HealthChecksKind     = "HealthChecks"
-	HealthStatusKind     = "HealthStatus"
-	NodeKind             = "Node"
-	ServiceKind          = "Service"
-	ServiceEndpointsKind = "ServiceEndpoints"
-	VirtualIPsKind       = "VirtualIPs"
-	WorkloadKind         = "Workload"
+	DNSPolicyKind         = "DNSPolicy"
+	FailoverPolicyKind    = "FailoverPolicy"
+	HealthChecksKind      = "HealthChecks"
+	HealthStatusKind      = "HealthStatus"
+	NodeKind              = "Node"
+	NodeHealthSummaryKind = "NodeHealthSummary"
+	NodeSetHealthKind     = "NodeSetHealth"
+	ServiceKind           = "Service"
+	ServiceEndpointsKind  = "ServiceEndpoints"
+	VirtualIPsKind        = "VirtualIPs"
+	WorkloadKind          = "Workload"
 )
 
 var (
@@ -52,6 +54,18 @@ var (
 		Kind:         NodeKind,
 	}
 
+	NodeHealthSummaryType = &pbresource.Type{
+		Group:        GroupName,
+		GroupVersion: Version,
+		Kind:         NodeHealthSummaryKind,
+	}
+
+	NodeSetHealthType = &pbresource.Type{
+		Group:        GroupName,
+		GroupVersion: Version,
+		Kind:         NodeSetHealthKind,
+	}
+
 	ServiceType = &pbresource.Type{
 		Group:        GroupName,
 		GroupVersion: Version,
@@ -0,0 +1,48 @@
+// Code generated by protoc-gen-deepcopy. DO NOT EDIT.
+package pbresource
+
+import (
+	proto "google.golang.org/protobuf/proto"
+)
+
+// DeepCopyInto supports using AdmitRequest within kubernetes types, where deepcopy-gen is used.
+func (in *AdmitRequest) DeepCopyInto(out *AdmitRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmitRequest. Required by controller-gen.
+func (in *AdmitRequest) DeepCopy() *AdmitRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmitRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new AdmitRequest. Required by controller-gen.
+func (in *AdmitRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using AdmitResponse within kubernetes types, where deepcopy-gen is used.
+func (in *AdmitResponse) DeepCopyInto(out *AdmitResponse) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmitResponse. Required by controller-gen.
+func (in *AdmitResponse) DeepCopy() *AdmitResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmitResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new AdmitResponse. Required by controller-gen.
+func (in *AdmitResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: pbresource/admission.proto
+
+package pbresource
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AdmissionServiceClient is the client API for AdmissionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdmissionServiceClient interface {
+	// Admit is called with a resource being written, after it has passed the
+	// resource service's own validation. It allows the write as-is, denies it,
+	// or allows it with a mutated resource.
+	Admit(ctx context.Context, in *AdmitRequest, opts ...grpc.CallOption) (*AdmitResponse, error)
+}
+
+type admissionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdmissionServiceClient(cc grpc.ClientConnInterface) AdmissionServiceClient {
+	return &admissionServiceClient{cc}
+}
+
+func (c *admissionServiceClient) Admit(ctx context.Context, in *AdmitRequest, opts ...grpc.CallOption) (*AdmitResponse, error) {
+	out := new(AdmitResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.resource.AdmissionService/Admit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdmissionServiceServer is the server API for AdmissionService service.
+// All implementations should embed UnimplementedAdmissionServiceServer
+// for forward compatibility
+type AdmissionServiceServer interface {
+	// Admit is called with a resource being written, after it has passed the
+	// resource service's own validation. It allows the write as-is, denies it,
+	// or allows it with a mutated resource.
+	Admit(context.Context, *AdmitRequest) (*AdmitResponse, error)
+}
+
+// UnimplementedAdmissionServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedAdmissionServiceServer struct {
+}
+
+func (UnimplementedAdmissionServiceServer) Admit(context.Context, *AdmitRequest) (*AdmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Admit not implemented")
+}
+
+// UnsafeAdmissionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdmissionServiceServer will
+// result in compilation errors.
+type UnsafeAdmissionServiceServer interface {
+	mustEmbedUnimplementedAdmissionServiceServer()
+}
+
+func RegisterAdmissionServiceServer(s grpc.ServiceRegistrar, srv AdmissionServiceServer) {
+	s.RegisterService(&AdmissionService_ServiceDesc, srv)
+}
+
+func _AdmissionService_Admit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdmissionServiceServer).Admit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.resource.AdmissionService/Admit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdmissionServiceServer).Admit(ctx, req.(*AdmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdmissionService_ServiceDesc is the grpc.ServiceDesc for AdmissionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdmissionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hashicorp.consul.resource.AdmissionService",
+	HandlerType: (*AdmissionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Admit",
+			Handler:    _AdmissionService_Admit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pbresource/admission.proto",
+}
@@ -0,0 +1,33 @@
+// Code generated by protoc-json-shim. DO NOT EDIT.
+package pbresource
+
+import (
+	protojson "google.golang.org/protobuf/encoding/protojson"
+)
+
+// MarshalJSON is a custom marshaler for AdmitRequest
+func (this *AdmitRequest) MarshalJSON() ([]byte, error) {
+	str, err := AdmissionMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for AdmitRequest
+func (this *AdmitRequest) UnmarshalJSON(b []byte) error {
+	return AdmissionUnmarshaler.Unmarshal(b, this)
+}
+
+// MarshalJSON is a custom marshaler for AdmitResponse
+func (this *AdmitResponse) MarshalJSON() ([]byte, error) {
+	str, err := AdmissionMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for AdmitResponse
+func (this *AdmitResponse) UnmarshalJSON(b []byte) error {
+	return AdmissionUnmarshaler.Unmarshal(b, this)
+}
+
+var (
+	AdmissionMarshaler   = &protojson.MarshalOptions{}
+	AdmissionUnmarshaler = &protojson.UnmarshalOptions{DiscardUnknown: false}
+)
@@ -110,6 +110,27 @@ func (in *Status) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using ReconcileError within kubernetes types, where deepcopy-gen is used.
+func (in *ReconcileError) DeepCopyInto(out *ReconcileError) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileError. Required by controller-gen.
+func (in *ReconcileError) DeepCopy() *ReconcileError {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileError. Required by controller-gen.
+func (in *ReconcileError) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using Condition within kubernetes types, where deepcopy-gen is used.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	proto.Reset(out)
@@ -215,6 +236,90 @@ func (in *ReadResponse) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using LiveNodeHealth within kubernetes types, where deepcopy-gen is used.
+func (in *LiveNodeHealth) DeepCopyInto(out *LiveNodeHealth) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiveNodeHealth. Required by controller-gen.
+func (in *LiveNodeHealth) DeepCopy() *LiveNodeHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(LiveNodeHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new LiveNodeHealth. Required by controller-gen.
+func (in *LiveNodeHealth) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using DeletedResource within kubernetes types, where deepcopy-gen is used.
+func (in *DeletedResource) DeepCopyInto(out *DeletedResource) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletedResource. Required by controller-gen.
+func (in *DeletedResource) DeepCopy() *DeletedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new DeletedResource. Required by controller-gen.
+func (in *DeletedResource) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using StatusRollup within kubernetes types, where deepcopy-gen is used.
+func (in *StatusRollup) DeepCopyInto(out *StatusRollup) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusRollup. Required by controller-gen.
+func (in *StatusRollup) DeepCopy() *StatusRollup {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusRollup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new StatusRollup. Required by controller-gen.
+func (in *StatusRollup) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using AuthorizationExplanation within kubernetes types, where deepcopy-gen is used.
+func (in *AuthorizationExplanation) DeepCopyInto(out *AuthorizationExplanation) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationExplanation. Required by controller-gen.
+func (in *AuthorizationExplanation) DeepCopy() *AuthorizationExplanation {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationExplanation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationExplanation. Required by controller-gen.
+func (in *AuthorizationExplanation) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using ListRequest within kubernetes types, where deepcopy-gen is used.
 func (in *ListRequest) DeepCopyInto(out *ListRequest) {
 	proto.Reset(out)
@@ -257,6 +362,27 @@ func (in *ListResponse) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using ListPagination within kubernetes types, where deepcopy-gen is used.
+func (in *ListPagination) DeepCopyInto(out *ListPagination) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListPagination. Required by controller-gen.
+func (in *ListPagination) DeepCopy() *ListPagination {
+	if in == nil {
+		return nil
+	}
+	out := new(ListPagination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ListPagination. Required by controller-gen.
+func (in *ListPagination) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using ListByOwnerRequest within kubernetes types, where deepcopy-gen is used.
 func (in *ListByOwnerRequest) DeepCopyInto(out *ListByOwnerRequest) {
 	proto.Reset(out)
@@ -383,6 +509,69 @@ func (in *WriteStatusResponse) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using BatchWriteStatusRequest within kubernetes types, where deepcopy-gen is used.
+func (in *BatchWriteStatusRequest) DeepCopyInto(out *BatchWriteStatusRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchWriteStatusRequest. Required by controller-gen.
+func (in *BatchWriteStatusRequest) DeepCopy() *BatchWriteStatusRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchWriteStatusRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new BatchWriteStatusRequest. Required by controller-gen.
+func (in *BatchWriteStatusRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using BatchWriteStatusResponse within kubernetes types, where deepcopy-gen is used.
+func (in *BatchWriteStatusResponse) DeepCopyInto(out *BatchWriteStatusResponse) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchWriteStatusResponse. Required by controller-gen.
+func (in *BatchWriteStatusResponse) DeepCopy() *BatchWriteStatusResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchWriteStatusResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new BatchWriteStatusResponse. Required by controller-gen.
+func (in *BatchWriteStatusResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using BatchWriteStatusResult within kubernetes types, where deepcopy-gen is used.
+func (in *BatchWriteStatusResult) DeepCopyInto(out *BatchWriteStatusResult) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchWriteStatusResult. Required by controller-gen.
+func (in *BatchWriteStatusResult) DeepCopy() *BatchWriteStatusResult {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchWriteStatusResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new BatchWriteStatusResult. Required by controller-gen.
+func (in *BatchWriteStatusResult) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using DeleteRequest within kubernetes types, where deepcopy-gen is used.
 func (in *DeleteRequest) DeepCopyInto(out *DeleteRequest) {
 	proto.Reset(out)
@@ -404,6 +593,27 @@ func (in *DeleteRequest) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using DeletePrecondition within kubernetes types, where deepcopy-gen is used.
+func (in *DeletePrecondition) DeepCopyInto(out *DeletePrecondition) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletePrecondition. Required by controller-gen.
+func (in *DeletePrecondition) DeepCopy() *DeletePrecondition {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletePrecondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new DeletePrecondition. Required by controller-gen.
+func (in *DeletePrecondition) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using DeleteResponse within kubernetes types, where deepcopy-gen is used.
 func (in *DeleteResponse) DeepCopyInto(out *DeleteResponse) {
 	proto.Reset(out)
@@ -446,6 +656,153 @@ func (in *WatchListRequest) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using WatchListByOwnerRequest within kubernetes types, where deepcopy-gen is used.
+func (in *WatchListByOwnerRequest) DeepCopyInto(out *WatchListByOwnerRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatchListByOwnerRequest. Required by controller-gen.
+func (in *WatchListByOwnerRequest) DeepCopy() *WatchListByOwnerRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(WatchListByOwnerRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new WatchListByOwnerRequest. Required by controller-gen.
+func (in *WatchListByOwnerRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using BatchReadRequest within kubernetes types, where deepcopy-gen is used.
+func (in *BatchReadRequest) DeepCopyInto(out *BatchReadRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchReadRequest. Required by controller-gen.
+func (in *BatchReadRequest) DeepCopy() *BatchReadRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchReadRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new BatchReadRequest. Required by controller-gen.
+func (in *BatchReadRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using BatchReadResponse within kubernetes types, where deepcopy-gen is used.
+func (in *BatchReadResponse) DeepCopyInto(out *BatchReadResponse) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchReadResponse. Required by controller-gen.
+func (in *BatchReadResponse) DeepCopy() *BatchReadResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchReadResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new BatchReadResponse. Required by controller-gen.
+func (in *BatchReadResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ReadAncestryRequest within kubernetes types, where deepcopy-gen is used.
+func (in *ReadAncestryRequest) DeepCopyInto(out *ReadAncestryRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadAncestryRequest. Required by controller-gen.
+func (in *ReadAncestryRequest) DeepCopy() *ReadAncestryRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ReadAncestryRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ReadAncestryRequest. Required by controller-gen.
+func (in *ReadAncestryRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ReadAncestryResponse within kubernetes types, where deepcopy-gen is used.
+func (in *ReadAncestryResponse) DeepCopyInto(out *ReadAncestryResponse) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadAncestryResponse. Required by controller-gen.
+func (in *ReadAncestryResponse) DeepCopy() *ReadAncestryResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ReadAncestryResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ReadAncestryResponse. Required by controller-gen.
+func (in *ReadAncestryResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using BatchReadResult within kubernetes types, where deepcopy-gen is used.
+func (in *BatchReadResult) DeepCopyInto(out *BatchReadResult) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchReadResult. Required by controller-gen.
+func (in *BatchReadResult) DeepCopy() *BatchReadResult {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchReadResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new BatchReadResult. Required by controller-gen.
+func (in *BatchReadResult) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ReadAndWatchRequest within kubernetes types, where deepcopy-gen is used.
+func (in *ReadAndWatchRequest) DeepCopyInto(out *ReadAndWatchRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadAndWatchRequest. Required by controller-gen.
+func (in *ReadAndWatchRequest) DeepCopy() *ReadAndWatchRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ReadAndWatchRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ReadAndWatchRequest. Required by controller-gen.
+func (in *ReadAndWatchRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using WatchEvent within kubernetes types, where deepcopy-gen is used.
 func (in *WatchEvent) DeepCopyInto(out *WatchEvent) {
 	proto.Reset(out)
@@ -466,3 +823,360 @@ func (in *WatchEvent) DeepCopy() *WatchEvent {
 func (in *WatchEvent) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
+
+// DeepCopyInto supports using DeleteByTenancyRequest within kubernetes types, where deepcopy-gen is used.
+func (in *DeleteByTenancyRequest) DeepCopyInto(out *DeleteByTenancyRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteByTenancyRequest. Required by controller-gen.
+func (in *DeleteByTenancyRequest) DeepCopy() *DeleteByTenancyRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(DeleteByTenancyRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new DeleteByTenancyRequest. Required by controller-gen.
+func (in *DeleteByTenancyRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using DeleteByTenancyResponse within kubernetes types, where deepcopy-gen is used.
+func (in *DeleteByTenancyResponse) DeepCopyInto(out *DeleteByTenancyResponse) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteByTenancyResponse. Required by controller-gen.
+func (in *DeleteByTenancyResponse) DeepCopy() *DeleteByTenancyResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(DeleteByTenancyResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new DeleteByTenancyResponse. Required by controller-gen.
+func (in *DeleteByTenancyResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using DeleteByOwnerRequest within kubernetes types, where deepcopy-gen is used.
+func (in *DeleteByOwnerRequest) DeepCopyInto(out *DeleteByOwnerRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteByOwnerRequest. Required by controller-gen.
+func (in *DeleteByOwnerRequest) DeepCopy() *DeleteByOwnerRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(DeleteByOwnerRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new DeleteByOwnerRequest. Required by controller-gen.
+func (in *DeleteByOwnerRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using DeleteByOwnerResponse within kubernetes types, where deepcopy-gen is used.
+func (in *DeleteByOwnerResponse) DeepCopyInto(out *DeleteByOwnerResponse) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteByOwnerResponse. Required by controller-gen.
+func (in *DeleteByOwnerResponse) DeepCopy() *DeleteByOwnerResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(DeleteByOwnerResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new DeleteByOwnerResponse. Required by controller-gen.
+func (in *DeleteByOwnerResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ReadinessRequest within kubernetes types, where deepcopy-gen is used.
+func (in *ReadinessRequest) DeepCopyInto(out *ReadinessRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadinessRequest. Required by controller-gen.
+func (in *ReadinessRequest) DeepCopy() *ReadinessRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ReadinessRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ReadinessRequest. Required by controller-gen.
+func (in *ReadinessRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ReadinessResponse within kubernetes types, where deepcopy-gen is used.
+func (in *ReadinessResponse) DeepCopyInto(out *ReadinessResponse) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadinessResponse. Required by controller-gen.
+func (in *ReadinessResponse) DeepCopy() *ReadinessResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ReadinessResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ReadinessResponse. Required by controller-gen.
+func (in *ReadinessResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using SubsystemStatus within kubernetes types, where deepcopy-gen is used.
+func (in *SubsystemStatus) DeepCopyInto(out *SubsystemStatus) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubsystemStatus. Required by controller-gen.
+func (in *SubsystemStatus) DeepCopy() *SubsystemStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubsystemStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new SubsystemStatus. Required by controller-gen.
+func (in *SubsystemStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ListTypesRequest within kubernetes types, where deepcopy-gen is used.
+func (in *ListTypesRequest) DeepCopyInto(out *ListTypesRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListTypesRequest. Required by controller-gen.
+func (in *ListTypesRequest) DeepCopy() *ListTypesRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ListTypesRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ListTypesRequest. Required by controller-gen.
+func (in *ListTypesRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ListTypesResponse within kubernetes types, where deepcopy-gen is used.
+func (in *ListTypesResponse) DeepCopyInto(out *ListTypesResponse) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListTypesResponse. Required by controller-gen.
+func (in *ListTypesResponse) DeepCopy() *ListTypesResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ListTypesResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ListTypesResponse. Required by controller-gen.
+func (in *ListTypesResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using RegisteredType within kubernetes types, where deepcopy-gen is used.
+func (in *RegisteredType) DeepCopyInto(out *RegisteredType) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegisteredType. Required by controller-gen.
+func (in *RegisteredType) DeepCopy() *RegisteredType {
+	if in == nil {
+		return nil
+	}
+	out := new(RegisteredType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new RegisteredType. Required by controller-gen.
+func (in *RegisteredType) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using WatchReconcileEventsRequest within kubernetes types, where deepcopy-gen is used.
+func (in *WatchReconcileEventsRequest) DeepCopyInto(out *WatchReconcileEventsRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatchReconcileEventsRequest. Required by controller-gen.
+func (in *WatchReconcileEventsRequest) DeepCopy() *WatchReconcileEventsRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(WatchReconcileEventsRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new WatchReconcileEventsRequest. Required by controller-gen.
+func (in *WatchReconcileEventsRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ReconcileEvent within kubernetes types, where deepcopy-gen is used.
+func (in *ReconcileEvent) DeepCopyInto(out *ReconcileEvent) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileEvent. Required by controller-gen.
+func (in *ReconcileEvent) DeepCopy() *ReconcileEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileEvent. Required by controller-gen.
+func (in *ReconcileEvent) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using BulkNodeHealthRequest within kubernetes types, where deepcopy-gen is used.
+func (in *BulkNodeHealthRequest) DeepCopyInto(out *BulkNodeHealthRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BulkNodeHealthRequest. Required by controller-gen.
+func (in *BulkNodeHealthRequest) DeepCopy() *BulkNodeHealthRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(BulkNodeHealthRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new BulkNodeHealthRequest. Required by controller-gen.
+func (in *BulkNodeHealthRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using BulkNodeHealthResponse within kubernetes types, where deepcopy-gen is used.
+func (in *BulkNodeHealthResponse) DeepCopyInto(out *BulkNodeHealthResponse) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BulkNodeHealthResponse. Required by controller-gen.
+func (in *BulkNodeHealthResponse) DeepCopy() *BulkNodeHealthResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(BulkNodeHealthResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new BulkNodeHealthResponse. Required by controller-gen.
+func (in *BulkNodeHealthResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using NodeHealth within kubernetes types, where deepcopy-gen is used.
+func (in *NodeHealth) DeepCopyInto(out *NodeHealth) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealth. Required by controller-gen.
+func (in *NodeHealth) DeepCopy() *NodeHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealth. Required by controller-gen.
+func (in *NodeHealth) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using NodeGroupHealthRequest within kubernetes types, where deepcopy-gen is used.
+func (in *NodeGroupHealthRequest) DeepCopyInto(out *NodeGroupHealthRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupHealthRequest. Required by controller-gen.
+func (in *NodeGroupHealthRequest) DeepCopy() *NodeGroupHealthRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupHealthRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupHealthRequest. Required by controller-gen.
+func (in *NodeGroupHealthRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using NodeGroupHealthResponse within kubernetes types, where deepcopy-gen is used.
+func (in *NodeGroupHealthResponse) DeepCopyInto(out *NodeGroupHealthResponse) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupHealthResponse. Required by controller-gen.
+func (in *NodeGroupHealthResponse) DeepCopy() *NodeGroupHealthResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupHealthResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupHealthResponse. Required by controller-gen.
+func (in *NodeGroupHealthResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
@@ -36,6 +36,23 @@ type ResourceServiceClient interface {
 	// Errors with PermissionDenied if the caller is not authorized to read
 	// the resource.
 	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
+	// BatchRead reads several resources by ID in a single round trip.
+	//
+	// Each ID is read as if by Read, including its validation, ACL and tenancy
+	// checks. Failures are reported per entry rather than failing the batch, so
+	// that e.g. a NotFound for one ID doesn't prevent the others from being read.
+	//
+	// Errors with InvalidArgument if no IDs, or more than 1000, are given.
+	BatchRead(ctx context.Context, in *BatchReadRequest, opts ...grpc.CallOption) (*BatchReadResponse, error)
+	// ReadAncestry reads a resource along with its chain of owners, up to the
+	// first resource that has no owner, e.g. to find the Node a HealthStatus
+	// belongs to.
+	//
+	// Each resource is read as if by Read, including its validation, ACL and
+	// tenancy checks, and the first failure is returned.
+	//
+	// Errors with Internal if the chain of owners contains a cycle.
+	ReadAncestry(ctx context.Context, in *ReadAncestryRequest, opts ...grpc.CallOption) (*ReadAncestryResponse, error)
 	// Write a resource.
 	//
 	// To perform a CAS (Compare-And-Swap) write, provide the current resource
@@ -70,6 +87,15 @@ type ResourceServiceClient interface {
 	// been deleted and recreated. If the given Uid doesn't match what is stored,
 	// a FailedPrecondition error code will be returned.
 	WriteStatus(ctx context.Context, in *WriteStatusRequest, opts ...grpc.CallOption) (*WriteStatusResponse, error)
+	// BatchWriteStatus writes many statuses in one call, e.g. for a controller
+	// that buffers the status writes of its reconciles.
+	//
+	// Each request is written as if by WriteStatus, including its validation, ACL
+	// and tenancy checks. Each status is written separately, so the batch is not
+	// atomic: failures are reported per entry rather than failing the batch.
+	//
+	// Errors with InvalidArgument if no requests, or more than 1000, are given.
+	BatchWriteStatus(ctx context.Context, in *BatchWriteStatusRequest, opts ...grpc.CallOption) (*BatchWriteStatusResponse, error)
 	// List resources of a given type, tenancy, and optionally name prefix.
 	//
 	// To list resources across all tenancy units, provide the wildcard "*" value.
@@ -107,6 +133,106 @@ type ResourceServiceClient interface {
 	//
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	WatchList(ctx context.Context, in *WatchListRequest, opts ...grpc.CallOption) (ResourceService_WatchListClient, error)
+	// WatchListByOwner watches resources of the given type owned by the given
+	// resource. Like WatchList, it returns results for the current
+	// state-of-the-world at the start of the stream, and delta events whenever
+	// owned resources are written or deleted.
+	//
+	// A resource whose owner is changed away from the given resource is emitted
+	// as a delete event. Results are filtered by the read ACLs of each resource.
+	WatchListByOwner(ctx context.Context, in *WatchListByOwnerRequest, opts ...grpc.CallOption) (ResourceService_WatchListByOwnerClient, error)
+	// ReadAndWatch reads a resource and watches it for changes. The first event
+	// on the stream is an upsert containing the resource's current state, after
+	// which an event is emitted whenever the resource is written or deleted.
+	//
+	// The read and the watch are performed as of the same index, so no change
+	// made between them can be missed, unlike a Read followed by a WatchList. If
+	// the resource is deleted and later re-created, the stream carries on with an
+	// upsert of the new resource.
+	//
+	// ACLs are re-evaluated for each event, so the stream ends with
+	// PermissionDenied once the caller loses access to the resource. Events are
+	// skipped instead when access depends on the resource's data.
+	//
+	// Errors with NotFound if the resource is not found.
+	//
+	// Errors with InvalidArgument if the request fails validation or the resource
+	// is stored as a type with a different GroupVersion than was requested.
+	//
+	// Errors with PermissionDenied if the caller is not authorized to read
+	// the resource.
+	//
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ReadAndWatch(ctx context.Context, in *ReadAndWatchRequest, opts ...grpc.CallOption) (ResourceService_ReadAndWatchClient, error)
+	// DeleteByTenancy deletes all resources of the given types within a
+	// namespace that is being deleted. As a guard against accidents, the request
+	// must set Confirmation to the name of the namespace.
+	//
+	// Errors with FailedPrecondition if the namespace is not marked for deletion.
+	//
+	// Errors with PermissionDenied if the caller is not authorized to delete all
+	// of the resources.
+	DeleteByTenancy(ctx context.Context, in *DeleteByTenancyRequest, opts ...grpc.CallOption) (*DeleteByTenancyResponse, error)
+	// DeleteByOwner deletes all resources owned by the given resource, optionally
+	// only those of the given types, e.g. to clean up the children of a deleted
+	// resource in one call. The owner itself isn't deleted and need not exist.
+	//
+	// Errors with PermissionDenied if the caller is not authorized to delete all
+	// of the resources, in which case nothing is deleted.
+	//
+	// The resources are otherwise deleted one at a time, not in a transaction.
+	// If deleting one fails (e.g. with Aborted because it was modified
+	// concurrently), those deleted before it stay deleted and the rest are left
+	// in place. Retrying the call deletes the remaining resources.
+	DeleteByOwner(ctx context.Context, in *DeleteByOwnerRequest, opts ...grpc.CallOption) (*DeleteByOwnerResponse, error)
+	// WatchReconcileEvents streams an event for each reconcile run by the named
+	// controller on this server, for debugging controllers. Events may be dropped
+	// if the client doesn't keep up.
+	//
+	// Errors with PermissionDenied if the caller's token doesn't have
+	// operator:read permission.
+	//
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	WatchReconcileEvents(ctx context.Context, in *WatchReconcileEventsRequest, opts ...grpc.CallOption) (ResourceService_WatchReconcileEventsClient, error)
+	// Readiness reports whether the resource service is ready to serve requests,
+	// along with the status of each of the subsystems it depends on. It is
+	// intended to gate controller startup and load balancer registration.
+	Readiness(ctx context.Context, in *ReadinessRequest, opts ...grpc.CallOption) (*ReadinessResponse, error)
+	// ListTypes lists the resource types registered with the resource service,
+	// so that tooling can discover the kinds of resources it supports.
+	//
+	// Errors with PermissionDenied if the caller's token doesn't have
+	// operator:read permission.
+	ListTypes(ctx context.Context, in *ListTypesRequest, opts ...grpc.CallOption) (*ListTypesResponse, error)
+	// BulkNodeHealth computes the health of each of the given catalog nodes in
+	// the same way the node health controller does, e.g. to render a table of
+	// nodes with a single call.
+	//
+	// Each node and its HealthStatus resources are read as if by Read and
+	// ListByOwner, so ACLs are enforced per node: a node the caller is not
+	// authorized to read is reported with a PermissionDenied status rather than
+	// failing the call.
+	//
+	// Errors with InvalidArgument if no IDs, or more than 1000, are given, or if
+	// any of them isn't the ID of a node.
+	//
+	// Errors with Unimplemented if node health isn't available on this server,
+	// e.g. because the v2 catalog isn't enabled.
+	BulkNodeHealth(ctx context.Context, in *BulkNodeHealthRequest, opts ...grpc.CallOption) (*BulkNodeHealthResponse, error)
+	// NodeGroupHealth aggregates the health of the catalog nodes in a tenancy
+	// whose metadata has a label set to a value, such as all nodes with rack=R1,
+	// e.g. to tell whether a rack is healthy without fanning out from the client.
+	// Each node's health is computed in the same way the node health controller
+	// does, and the group's health is aggregated from them in the same way.
+	//
+	// Nodes and HealthStatus resources are listed as if by List and ListByOwner,
+	// so those the caller is not authorized to read are excluded.
+	//
+	// Errors with InvalidArgument if no label is given.
+	//
+	// Errors with Unimplemented if node health isn't available on this server,
+	// e.g. because the v2 catalog isn't enabled.
+	NodeGroupHealth(ctx context.Context, in *NodeGroupHealthRequest, opts ...grpc.CallOption) (*NodeGroupHealthResponse, error)
 }
 
 type resourceServiceClient struct {
@@ -126,6 +252,24 @@ func (c *resourceServiceClient) Read(ctx context.Context, in *ReadRequest, opts
 	return out, nil
 }
 
+func (c *resourceServiceClient) BatchRead(ctx context.Context, in *BatchReadRequest, opts ...grpc.CallOption) (*BatchReadResponse, error) {
+	out := new(BatchReadResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.resource.ResourceService/BatchRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ReadAncestry(ctx context.Context, in *ReadAncestryRequest, opts ...grpc.CallOption) (*ReadAncestryResponse, error) {
+	out := new(ReadAncestryResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.resource.ResourceService/ReadAncestry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourceServiceClient) Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
 	out := new(WriteResponse)
 	err := c.cc.Invoke(ctx, "/hashicorp.consul.resource.ResourceService/Write", in, out, opts...)
@@ -144,6 +288,15 @@ func (c *resourceServiceClient) WriteStatus(ctx context.Context, in *WriteStatus
 	return out, nil
 }
 
+func (c *resourceServiceClient) BatchWriteStatus(ctx context.Context, in *BatchWriteStatusRequest, opts ...grpc.CallOption) (*BatchWriteStatusResponse, error) {
+	out := new(BatchWriteStatusResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.resource.ResourceService/BatchWriteStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourceServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
 	out := new(ListResponse)
 	err := c.cc.Invoke(ctx, "/hashicorp.consul.resource.ResourceService/List", in, out, opts...)
@@ -203,6 +356,156 @@ func (x *resourceServiceWatchListClient) Recv() (*WatchEvent, error) {
 	return m, nil
 }
 
+func (c *resourceServiceClient) WatchListByOwner(ctx context.Context, in *WatchListByOwnerRequest, opts ...grpc.CallOption) (ResourceService_WatchListByOwnerClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ResourceService_ServiceDesc.Streams[1], "/hashicorp.consul.resource.ResourceService/WatchListByOwner", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &resourceServiceWatchListByOwnerClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ResourceService_WatchListByOwnerClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type resourceServiceWatchListByOwnerClient struct {
+	grpc.ClientStream
+}
+
+func (x *resourceServiceWatchListByOwnerClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *resourceServiceClient) ReadAndWatch(ctx context.Context, in *ReadAndWatchRequest, opts ...grpc.CallOption) (ResourceService_ReadAndWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ResourceService_ServiceDesc.Streams[2], "/hashicorp.consul.resource.ResourceService/ReadAndWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &resourceServiceReadAndWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ResourceService_ReadAndWatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type resourceServiceReadAndWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *resourceServiceReadAndWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *resourceServiceClient) DeleteByTenancy(ctx context.Context, in *DeleteByTenancyRequest, opts ...grpc.CallOption) (*DeleteByTenancyResponse, error) {
+	out := new(DeleteByTenancyResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.resource.ResourceService/DeleteByTenancy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) DeleteByOwner(ctx context.Context, in *DeleteByOwnerRequest, opts ...grpc.CallOption) (*DeleteByOwnerResponse, error) {
+	out := new(DeleteByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.resource.ResourceService/DeleteByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) WatchReconcileEvents(ctx context.Context, in *WatchReconcileEventsRequest, opts ...grpc.CallOption) (ResourceService_WatchReconcileEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ResourceService_ServiceDesc.Streams[3], "/hashicorp.consul.resource.ResourceService/WatchReconcileEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &resourceServiceWatchReconcileEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ResourceService_WatchReconcileEventsClient interface {
+	Recv() (*ReconcileEvent, error)
+	grpc.ClientStream
+}
+
+type resourceServiceWatchReconcileEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *resourceServiceWatchReconcileEventsClient) Recv() (*ReconcileEvent, error) {
+	m := new(ReconcileEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *resourceServiceClient) Readiness(ctx context.Context, in *ReadinessRequest, opts ...grpc.CallOption) (*ReadinessResponse, error) {
+	out := new(ReadinessResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.resource.ResourceService/Readiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ListTypes(ctx context.Context, in *ListTypesRequest, opts ...grpc.CallOption) (*ListTypesResponse, error) {
+	out := new(ListTypesResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.resource.ResourceService/ListTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) BulkNodeHealth(ctx context.Context, in *BulkNodeHealthRequest, opts ...grpc.CallOption) (*BulkNodeHealthResponse, error) {
+	out := new(BulkNodeHealthResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.resource.ResourceService/BulkNodeHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) NodeGroupHealth(ctx context.Context, in *NodeGroupHealthRequest, opts ...grpc.CallOption) (*NodeGroupHealthResponse, error) {
+	out := new(NodeGroupHealthResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.resource.ResourceService/NodeGroupHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ResourceServiceServer is the server API for ResourceService service.
 // All implementations should embed UnimplementedResourceServiceServer
 // for forward compatibility
@@ -221,6 +524,23 @@ type ResourceServiceServer interface {
 	// Errors with PermissionDenied if the caller is not authorized to read
 	// the resource.
 	Read(context.Context, *ReadRequest) (*ReadResponse, error)
+	// BatchRead reads several resources by ID in a single round trip.
+	//
+	// Each ID is read as if by Read, including its validation, ACL and tenancy
+	// checks. Failures are reported per entry rather than failing the batch, so
+	// that e.g. a NotFound for one ID doesn't prevent the others from being read.
+	//
+	// Errors with InvalidArgument if no IDs, or more than 1000, are given.
+	BatchRead(context.Context, *BatchReadRequest) (*BatchReadResponse, error)
+	// ReadAncestry reads a resource along with its chain of owners, up to the
+	// first resource that has no owner, e.g. to find the Node a HealthStatus
+	// belongs to.
+	//
+	// Each resource is read as if by Read, including its validation, ACL and
+	// tenancy checks, and the first failure is returned.
+	//
+	// Errors with Internal if the chain of owners contains a cycle.
+	ReadAncestry(context.Context, *ReadAncestryRequest) (*ReadAncestryResponse, error)
 	// Write a resource.
 	//
 	// To perform a CAS (Compare-And-Swap) write, provide the current resource
@@ -255,6 +575,15 @@ type ResourceServiceServer interface {
 	// been deleted and recreated. If the given Uid doesn't match what is stored,
 	// a FailedPrecondition error code will be returned.
 	WriteStatus(context.Context, *WriteStatusRequest) (*WriteStatusResponse, error)
+	// BatchWriteStatus writes many statuses in one call, e.g. for a controller
+	// that buffers the status writes of its reconciles.
+	//
+	// Each request is written as if by WriteStatus, including its validation, ACL
+	// and tenancy checks. Each status is written separately, so the batch is not
+	// atomic: failures are reported per entry rather than failing the batch.
+	//
+	// Errors with InvalidArgument if no requests, or more than 1000, are given.
+	BatchWriteStatus(context.Context, *BatchWriteStatusRequest) (*BatchWriteStatusResponse, error)
 	// List resources of a given type, tenancy, and optionally name prefix.
 	//
 	// To list resources across all tenancy units, provide the wildcard "*" value.
@@ -292,6 +621,106 @@ type ResourceServiceServer interface {
 	//
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	WatchList(*WatchListRequest, ResourceService_WatchListServer) error
+	// WatchListByOwner watches resources of the given type owned by the given
+	// resource. Like WatchList, it returns results for the current
+	// state-of-the-world at the start of the stream, and delta events whenever
+	// owned resources are written or deleted.
+	//
+	// A resource whose owner is changed away from the given resource is emitted
+	// as a delete event. Results are filtered by the read ACLs of each resource.
+	WatchListByOwner(*WatchListByOwnerRequest, ResourceService_WatchListByOwnerServer) error
+	// ReadAndWatch reads a resource and watches it for changes. The first event
+	// on the stream is an upsert containing the resource's current state, after
+	// which an event is emitted whenever the resource is written or deleted.
+	//
+	// The read and the watch are performed as of the same index, so no change
+	// made between them can be missed, unlike a Read followed by a WatchList. If
+	// the resource is deleted and later re-created, the stream carries on with an
+	// upsert of the new resource.
+	//
+	// ACLs are re-evaluated for each event, so the stream ends with
+	// PermissionDenied once the caller loses access to the resource. Events are
+	// skipped instead when access depends on the resource's data.
+	//
+	// Errors with NotFound if the resource is not found.
+	//
+	// Errors with InvalidArgument if the request fails validation or the resource
+	// is stored as a type with a different GroupVersion than was requested.
+	//
+	// Errors with PermissionDenied if the caller is not authorized to read
+	// the resource.
+	//
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ReadAndWatch(*ReadAndWatchRequest, ResourceService_ReadAndWatchServer) error
+	// DeleteByTenancy deletes all resources of the given types within a
+	// namespace that is being deleted. As a guard against accidents, the request
+	// must set Confirmation to the name of the namespace.
+	//
+	// Errors with FailedPrecondition if the namespace is not marked for deletion.
+	//
+	// Errors with PermissionDenied if the caller is not authorized to delete all
+	// of the resources.
+	DeleteByTenancy(context.Context, *DeleteByTenancyRequest) (*DeleteByTenancyResponse, error)
+	// DeleteByOwner deletes all resources owned by the given resource, optionally
+	// only those of the given types, e.g. to clean up the children of a deleted
+	// resource in one call. The owner itself isn't deleted and need not exist.
+	//
+	// Errors with PermissionDenied if the caller is not authorized to delete all
+	// of the resources, in which case nothing is deleted.
+	//
+	// The resources are otherwise deleted one at a time, not in a transaction.
+	// If deleting one fails (e.g. with Aborted because it was modified
+	// concurrently), those deleted before it stay deleted and the rest are left
+	// in place. Retrying the call deletes the remaining resources.
+	DeleteByOwner(context.Context, *DeleteByOwnerRequest) (*DeleteByOwnerResponse, error)
+	// WatchReconcileEvents streams an event for each reconcile run by the named
+	// controller on this server, for debugging controllers. Events may be dropped
+	// if the client doesn't keep up.
+	//
+	// Errors with PermissionDenied if the caller's token doesn't have
+	// operator:read permission.
+	//
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	WatchReconcileEvents(*WatchReconcileEventsRequest, ResourceService_WatchReconcileEventsServer) error
+	// Readiness reports whether the resource service is ready to serve requests,
+	// along with the status of each of the subsystems it depends on. It is
+	// intended to gate controller startup and load balancer registration.
+	Readiness(context.Context, *ReadinessRequest) (*ReadinessResponse, error)
+	// ListTypes lists the resource types registered with the resource service,
+	// so that tooling can discover the kinds of resources it supports.
+	//
+	// Errors with PermissionDenied if the caller's token doesn't have
+	// operator:read permission.
+	ListTypes(context.Context, *ListTypesRequest) (*ListTypesResponse, error)
+	// BulkNodeHealth computes the health of each of the given catalog nodes in
+	// the same way the node health controller does, e.g. to render a table of
+	// nodes with a single call.
+	//
+	// Each node and its HealthStatus resources are read as if by Read and
+	// ListByOwner, so ACLs are enforced per node: a node the caller is not
+	// authorized to read is reported with a PermissionDenied status rather than
+	// failing the call.
+	//
+	// Errors with InvalidArgument if no IDs, or more than 1000, are given, or if
+	// any of them isn't the ID of a node.
+	//
+	// Errors with Unimplemented if node health isn't available on this server,
+	// e.g. because the v2 catalog isn't enabled.
+	BulkNodeHealth(context.Context, *BulkNodeHealthRequest) (*BulkNodeHealthResponse, error)
+	// NodeGroupHealth aggregates the health of the catalog nodes in a tenancy
+	// whose metadata has a label set to a value, such as all nodes with rack=R1,
+	// e.g. to tell whether a rack is healthy without fanning out from the client.
+	// Each node's health is computed in the same way the node health controller
+	// does, and the group's health is aggregated from them in the same way.
+	//
+	// Nodes and HealthStatus resources are listed as if by List and ListByOwner,
+	// so those the caller is not authorized to read are excluded.
+	//
+	// Errors with InvalidArgument if no label is given.
+	//
+	// Errors with Unimplemented if node health isn't available on this server,
+	// e.g. because the v2 catalog isn't enabled.
+	NodeGroupHealth(context.Context, *NodeGroupHealthRequest) (*NodeGroupHealthResponse, error)
 }
 
 // UnimplementedResourceServiceServer should be embedded to have forward compatible implementations.
@@ -301,12 +730,21 @@ type UnimplementedResourceServiceServer struct {
 func (UnimplementedResourceServiceServer) Read(context.Context, *ReadRequest) (*ReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
+func (UnimplementedResourceServiceServer) BatchRead(context.Context, *BatchReadRequest) (*BatchReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchRead not implemented")
+}
+func (UnimplementedResourceServiceServer) ReadAncestry(context.Context, *ReadAncestryRequest) (*ReadAncestryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAncestry not implemented")
+}
 func (UnimplementedResourceServiceServer) Write(context.Context, *WriteRequest) (*WriteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
 }
 func (UnimplementedResourceServiceServer) WriteStatus(context.Context, *WriteStatusRequest) (*WriteStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WriteStatus not implemented")
 }
+func (UnimplementedResourceServiceServer) BatchWriteStatus(context.Context, *BatchWriteStatusRequest) (*BatchWriteStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchWriteStatus not implemented")
+}
 func (UnimplementedResourceServiceServer) List(context.Context, *ListRequest) (*ListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
@@ -319,6 +757,33 @@ func (UnimplementedResourceServiceServer) Delete(context.Context, *DeleteRequest
 func (UnimplementedResourceServiceServer) WatchList(*WatchListRequest, ResourceService_WatchListServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchList not implemented")
 }
+func (UnimplementedResourceServiceServer) WatchListByOwner(*WatchListByOwnerRequest, ResourceService_WatchListByOwnerServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchListByOwner not implemented")
+}
+func (UnimplementedResourceServiceServer) ReadAndWatch(*ReadAndWatchRequest, ResourceService_ReadAndWatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadAndWatch not implemented")
+}
+func (UnimplementedResourceServiceServer) DeleteByTenancy(context.Context, *DeleteByTenancyRequest) (*DeleteByTenancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteByTenancy not implemented")
+}
+func (UnimplementedResourceServiceServer) DeleteByOwner(context.Context, *DeleteByOwnerRequest) (*DeleteByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteByOwner not implemented")
+}
+func (UnimplementedResourceServiceServer) WatchReconcileEvents(*WatchReconcileEventsRequest, ResourceService_WatchReconcileEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchReconcileEvents not implemented")
+}
+func (UnimplementedResourceServiceServer) Readiness(context.Context, *ReadinessRequest) (*ReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Readiness not implemented")
+}
+func (UnimplementedResourceServiceServer) ListTypes(context.Context, *ListTypesRequest) (*ListTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTypes not implemented")
+}
+func (UnimplementedResourceServiceServer) BulkNodeHealth(context.Context, *BulkNodeHealthRequest) (*BulkNodeHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkNodeHealth not implemented")
+}
+func (UnimplementedResourceServiceServer) NodeGroupHealth(context.Context, *NodeGroupHealthRequest) (*NodeGroupHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeGroupHealth not implemented")
+}
 
 // UnsafeResourceServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ResourceServiceServer will
@@ -349,6 +814,42 @@ func _ResourceService_Read_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceService_BatchRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).BatchRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.resource.ResourceService/BatchRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).BatchRead(ctx, req.(*BatchReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ReadAncestry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAncestryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ReadAncestry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.resource.ResourceService/ReadAncestry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ReadAncestry(ctx, req.(*ReadAncestryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResourceService_Write_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WriteRequest)
 	if err := dec(in); err != nil {
@@ -385,6 +886,24 @@ func _ResourceService_WriteStatus_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceService_BatchWriteStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchWriteStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).BatchWriteStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.resource.ResourceService/BatchWriteStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).BatchWriteStatus(ctx, req.(*BatchWriteStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResourceService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListRequest)
 	if err := dec(in); err != nil {
@@ -460,6 +979,177 @@ func (x *resourceServiceWatchListServer) Send(m *WatchEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ResourceService_WatchListByOwner_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchListByOwnerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceServiceServer).WatchListByOwner(m, &resourceServiceWatchListByOwnerServer{stream})
+}
+
+type ResourceService_WatchListByOwnerServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type resourceServiceWatchListByOwnerServer struct {
+	grpc.ServerStream
+}
+
+func (x *resourceServiceWatchListByOwnerServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ResourceService_ReadAndWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadAndWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceServiceServer).ReadAndWatch(m, &resourceServiceReadAndWatchServer{stream})
+}
+
+type ResourceService_ReadAndWatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type resourceServiceReadAndWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *resourceServiceReadAndWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ResourceService_DeleteByTenancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteByTenancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).DeleteByTenancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.resource.ResourceService/DeleteByTenancy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).DeleteByTenancy(ctx, req.(*DeleteByTenancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_DeleteByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).DeleteByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.resource.ResourceService/DeleteByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).DeleteByOwner(ctx, req.(*DeleteByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_WatchReconcileEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReconcileEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceServiceServer).WatchReconcileEvents(m, &resourceServiceWatchReconcileEventsServer{stream})
+}
+
+type ResourceService_WatchReconcileEventsServer interface {
+	Send(*ReconcileEvent) error
+	grpc.ServerStream
+}
+
+type resourceServiceWatchReconcileEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *resourceServiceWatchReconcileEventsServer) Send(m *ReconcileEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ResourceService_Readiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).Readiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.resource.ResourceService/Readiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).Readiness(ctx, req.(*ReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ListTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ListTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.resource.ResourceService/ListTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ListTypes(ctx, req.(*ListTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_BulkNodeHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkNodeHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).BulkNodeHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.resource.ResourceService/BulkNodeHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).BulkNodeHealth(ctx, req.(*BulkNodeHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_NodeGroupHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeGroupHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).NodeGroupHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.resource.ResourceService/NodeGroupHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).NodeGroupHealth(ctx, req.(*NodeGroupHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ResourceService_ServiceDesc is the grpc.ServiceDesc for ResourceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -471,6 +1161,14 @@ var ResourceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Read",
 			Handler:    _ResourceService_Read_Handler,
 		},
+		{
+			MethodName: "BatchRead",
+			Handler:    _ResourceService_BatchRead_Handler,
+		},
+		{
+			MethodName: "ReadAncestry",
+			Handler:    _ResourceService_ReadAncestry_Handler,
+		},
 		{
 			MethodName: "Write",
 			Handler:    _ResourceService_Write_Handler,
@@ -479,6 +1177,10 @@ var ResourceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WriteStatus",
 			Handler:    _ResourceService_WriteStatus_Handler,
 		},
+		{
+			MethodName: "BatchWriteStatus",
+			Handler:    _ResourceService_BatchWriteStatus_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _ResourceService_List_Handler,
@@ -491,6 +1193,30 @@ var ResourceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ResourceService_Delete_Handler,
 		},
+		{
+			MethodName: "DeleteByTenancy",
+			Handler:    _ResourceService_DeleteByTenancy_Handler,
+		},
+		{
+			MethodName: "DeleteByOwner",
+			Handler:    _ResourceService_DeleteByOwner_Handler,
+		},
+		{
+			MethodName: "Readiness",
+			Handler:    _ResourceService_Readiness_Handler,
+		},
+		{
+			MethodName: "ListTypes",
+			Handler:    _ResourceService_ListTypes_Handler,
+		},
+		{
+			MethodName: "BulkNodeHealth",
+			Handler:    _ResourceService_BulkNodeHealth_Handler,
+		},
+		{
+			MethodName: "NodeGroupHealth",
+			Handler:    _ResourceService_NodeGroupHealth_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -498,6 +1224,21 @@ var ResourceService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ResourceService_WatchList_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchListByOwner",
+			Handler:       _ResourceService_WatchListByOwner_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReadAndWatch",
+			Handler:       _ResourceService_ReadAndWatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchReconcileEvents",
+			Handler:       _ResourceService_WatchReconcileEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "pbresource/resource.proto",
 }